@@ -137,6 +137,9 @@ func bidOnLot(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canBid.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canBid.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -103,6 +103,8 @@ func claimFromLot(c *cli.Context) error {
 		gasInfo = canResponse.GasInfo
 		totalGas += canResponse.GasInfo.EstGasLimit
 		totalSafeGas += canResponse.GasInfo.SafeGasLimit
+		fmt.Printf("Lot %d:\n", lot.Details.Index)
+		cliutils.PrintTransactionSimulation(canResponse.Simulation)
 	}
 	gasInfo.EstGasLimit = totalGas
 	gasInfo.SafeGasLimit = totalSafeGas
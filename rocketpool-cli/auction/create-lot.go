@@ -35,6 +35,9 @@ func createLot(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canCreate.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canCreate.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
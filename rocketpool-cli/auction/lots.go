@@ -9,6 +9,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -27,6 +28,11 @@ func getLots(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, lots)
+	}
+
 	// Get lots by status
 	openLots := []api.LotDetails{}
 	clearedLots := []api.LotDetails{}
@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
 
@@ -25,6 +26,11 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, status)
+	}
+
 	// Print & return
 	fmt.Printf(
 		"A total of %.6f RPL is up for auction, with %.6f RPL currently allotted and %.6f RPL remaining.\n",
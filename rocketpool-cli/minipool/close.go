@@ -202,6 +202,12 @@ func closeMinipools(c *cli.Context) error {
 		continue
 	}
 
+	// Print the simulation results
+	for _, minipool := range selectedMinipools {
+		fmt.Printf("Minipool %s:\n", minipool.Address.Hex())
+		cliutils.PrintTransactionSimulation(minipool.Simulation)
+	}
+
 	// Get the total gas limit estimate
 	var gasInfo rocketpoolapi.GasInfo
 	for _, minipool := range selectedMinipools {
@@ -8,6 +8,8 @@ import (
 
 // Register commands
 func RegisterCommands(app *cli.App, name string, aliases []string) {
+	cliutils.RegisterStructuredOutput(name, "status")
+	cliutils.RegisterFleetTable(name+" status", printFleetStatus)
 	app.Commands = append(app.Commands, cli.Command{
 		Name:    name,
 		Aliases: aliases,
@@ -18,12 +20,20 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "status",
 				Aliases:   []string{"s"},
 				Usage:     "Get a list of the node's minipools",
-				UsageText: "rocketpool minipool status",
+				UsageText: "rocketpool minipool status [options]",
 				Flags: []cli.Flag{
 					cli.BoolFlag{
 						Name:  "include-finalized, f",
 						Usage: "Include finalized minipools in the list (default is to hide them).",
 					},
+					cli.Uint64Flag{
+						Name:  "at-slot",
+						Usage: "Show the minipools from the latest saved state snapshot at or before this slot",
+					},
+					cli.Uint64Flag{
+						Name:  "at-block",
+						Usage: "Show the minipools from the latest saved state snapshot at or before this block",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -33,6 +43,9 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Run
+					if c.IsSet("at-slot") || c.IsSet("at-block") {
+						return getSnapshotStatus(c)
+					}
 					return getStatus(c)
 
 				},
@@ -269,6 +282,10 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						Name:  "threshold, t",
 						Usage: "Filter on a minimum amount of ETH that can be distributed - minipools below this amount won't be shown",
 					},
+					cli.BoolFlag{
+						Name:  "batch, b",
+						Usage: "Distribute the minipools in a single transaction through the network's multicall contract, saving the base fee of each separate transaction. Only minipools with less than 8 ETH can be batched; the rest will still get their own transactions.",
+					},
 				},
 				Action: func(c *cli.Context) error {
 
@@ -93,6 +93,8 @@ func delegateUpgradeMinipools(c *cli.Context) error {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
 			totalSafeGas += canResponse.GasInfo.SafeGasLimit
+			fmt.Printf("Minipool %s:\n", minipool.Hex())
+			cliutils.PrintTransactionSimulation(canResponse.Simulation)
 		}
 	}
 	gasInfo.EstGasLimit = totalGas
@@ -199,6 +201,8 @@ func delegateRollbackMinipools(c *cli.Context) error {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
 			totalSafeGas += canResponse.GasInfo.SafeGasLimit
+			fmt.Printf("Minipool %s:\n", minipool.Hex())
+			cliutils.PrintTransactionSimulation(canResponse.Simulation)
 		}
 	}
 	gasInfo.EstGasLimit = totalGas
@@ -325,6 +329,8 @@ func setUseLatestDelegateMinipools(c *cli.Context, setting bool) error {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
 			totalSafeGas += canResponse.GasInfo.SafeGasLimit
+			fmt.Printf("Minipool %s:\n", minipool.Hex())
+			cliutils.PrintTransactionSimulation(canResponse.Simulation)
 		}
 	}
 	gasInfo.EstGasLimit = totalGas
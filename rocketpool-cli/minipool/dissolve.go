@@ -98,6 +98,8 @@ func dissolveMinipools(c *cli.Context) error {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
 			totalSafeGas += canResponse.GasInfo.SafeGasLimit
+			fmt.Printf("Minipool %s:\n", minipool.Address.Hex())
+			cliutils.PrintTransactionSimulation(canResponse.Simulation)
 		}
 	}
 	gasInfo.EstGasLimit = totalGas
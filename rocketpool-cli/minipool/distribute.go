@@ -197,14 +197,36 @@ func distributeBalance(c *cli.Context) error {
 
 	}
 
+	// Bundle the minipools into batch transactions where possible
+	var batches []api.MinipoolDistributeBatch
+	batchedCount := 0
+	if c.Bool("batch") {
+		batches, selectedMinipools, err = getDistributeBatches(rp, selectedMinipools)
+		if err != nil {
+			return err
+		}
+		for _, batch := range batches {
+			batchedCount += len(batch.Minipools)
+		}
+	}
+
 	// Get the total gas limit estimate
 	var totalGas uint64 = 0
 	var totalSafeGas uint64 = 0
 	var gasInfo rocketpoolapi.GasInfo
+	for _, batch := range batches {
+		gasInfo = batch.GasInfo
+		totalGas += gasInfo.EstGasLimit
+		totalSafeGas += gasInfo.SafeGasLimit
+		fmt.Printf("Batch of %d minipools:\n", len(batch.Minipools))
+		cliutils.PrintTransactionSimulation(batch.Simulation)
+	}
 	for _, minipool := range selectedMinipools {
 		gasInfo = minipool.GasInfo
 		totalGas += gasInfo.EstGasLimit
 		totalSafeGas += gasInfo.SafeGasLimit
+		fmt.Printf("Minipool %s:\n", minipool.Address.Hex())
+		cliutils.PrintTransactionSimulation(minipool.Simulation)
 	}
 	gasInfo.EstGasLimit = totalGas
 	gasInfo.SafeGasLimit = totalSafeGas
@@ -216,11 +238,29 @@ func distributeBalance(c *cli.Context) error {
 	}
 
 	// Prompt for confirmation
-	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to distribute the ETH balance of %d minipools?", len(selectedMinipools)))) {
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to distribute the ETH balance of %d minipools?", len(selectedMinipools)+batchedCount))) {
 		fmt.Println("Cancelled.")
 		return nil
 	}
 
+	// Distribute the batches
+	for _, batch := range batches {
+
+		response, err := rp.BatchDistributeBalance(batch.Minipools)
+		if err != nil {
+			fmt.Printf("Could not distribute the ETH balances of %d minipools in a batch: %s.\n", len(batch.Minipools), err.Error())
+			continue
+		}
+
+		fmt.Printf("Distributing balances of %d minipools in a single transaction...\n", len(batch.Minipools))
+		cliutils.PrintTransactionHash(rp, response.TxHash)
+		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+			fmt.Printf("Could not distribute the ETH balances of %d minipools in a batch: %s.\n", len(batch.Minipools), err.Error())
+		} else {
+			fmt.Printf("Successfully distributed the ETH balances of %d minipools.\n", len(batch.Minipools))
+		}
+	}
+
 	// Distribute minipool balances
 	for _, minipool := range selectedMinipools {
 
@@ -243,3 +283,46 @@ func distributeBalance(c *cli.Context) error {
 	return nil
 
 }
+
+// Bundle the minipools that anyone can distribute into batch transactions.
+// Returns the batches and the minipools that still need their own transactions.
+func getDistributeBatches(rp *rocketpool.Client, minipools []api.MinipoolBalanceDistributionDetails) ([]api.MinipoolDistributeBatch, []api.MinipoolBalanceDistributionDetails, error) {
+
+	// Nothing to bundle with a single minipool
+	if len(minipools) < 2 {
+		return nil, minipools, nil
+	}
+
+	// Check the minipools
+	addresses := make([]common.Address, len(minipools))
+	for i, minipool := range minipools {
+		addresses[i] = minipool.Address
+	}
+	response, err := rp.CanBatchDistributeBalance(addresses)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Get the minipools that need their own transactions
+	batched := map[common.Address]bool{}
+	for _, batch := range response.Batches {
+		for _, address := range batch.Minipools {
+			batched[address] = true
+		}
+	}
+	remaining := []api.MinipoolBalanceDistributionDetails{}
+	for _, minipool := range minipools {
+		if !batched[minipool.Address] {
+			remaining = append(remaining, minipool)
+		}
+	}
+	if len(remaining) > 0 {
+		fmt.Printf("%sNOTE: The following minipools can only be distributed by your node account (for example, because they're dissolved), so they will be sent as separate transactions:\n", colorYellow)
+		for _, minipool := range remaining {
+			fmt.Printf("\t%s\n", minipool.Address.Hex())
+		}
+		fmt.Printf("%s\n", colorReset)
+	}
+	return response.Batches, remaining, nil
+
+}
@@ -0,0 +1,86 @@
+package minipool
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+// Print the minipools of several nodes as one table
+func printFleetStatus(c *cli.Context, responses []cliutils.FleetResponse) error {
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tMINIPOOL\tSTATUS\tBOND\tFEE\tVALIDATOR\tCL BALANCE\tEL BALANCE")
+
+	totalMinipools := 0
+	hiddenMinipools := 0
+	failedResponses := []cliutils.FleetResponse{}
+	for _, response := range responses {
+		if response.Error != "" {
+			failedResponses = append(failedResponses, response)
+			continue
+		}
+		status, ok := response.Response.(api.MinipoolStatusResponse)
+		if !ok {
+			continue
+		}
+		if len(status.Minipools) == 0 {
+			fmt.Fprintf(w, "%s\t(no minipools)\t\t\t\t\t\t\n", response.Node)
+			continue
+		}
+		for _, minipool := range status.Minipools {
+			if minipool.Finalised && !c.Bool("include-finalized") {
+				hiddenMinipools++
+				continue
+			}
+			totalMinipools++
+
+			statusName := minipool.Status.Status.String()
+			if minipool.Finalised {
+				statusName = "Finalized"
+			}
+			validator := "-"
+			beaconBalance := "-"
+			if minipool.Validator.Exists {
+				validator = minipool.Validator.Index
+				if !minipool.Validator.Active {
+					validator += " (inactive)"
+				}
+				beaconBalance = fmt.Sprintf("%.6f", math.RoundDown(eth.WeiToEth(minipool.Validator.Balance), 6))
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%.0f ETH\t%.2f%%\t%s\t%s\t%.6f\n",
+				response.Node,
+				minipool.Address.Hex(),
+				statusName,
+				eth.WeiToEth(minipool.Node.DepositBalance),
+				minipool.Node.Fee*100,
+				validator,
+				beaconBalance,
+				math.RoundDown(eth.WeiToEth(minipool.Balances.ETH), 6),
+			)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d minipool(s) across %d node(s)", totalMinipools, len(responses))
+	if hiddenMinipools > 0 {
+		fmt.Printf(", %d finalized minipool(s) hidden (use `-f` to show them)", hiddenMinipools)
+	}
+	fmt.Println(".")
+
+	// Print the nodes that couldn't be queried
+	for _, response := range failedResponses {
+		fmt.Printf("%sCould not get the minipools of %s: %s%s\n", colorRed, response.Node, response.Error, colorReset)
+	}
+	return nil
+
+}
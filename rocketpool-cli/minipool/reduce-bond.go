@@ -161,6 +161,8 @@ func beginReduceBondAmount(c *cli.Context) error {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
 			totalSafeGas += canResponse.GasInfo.SafeGasLimit
+			fmt.Printf("Minipool %s:\n", minipool.Address.Hex())
+			cliutils.PrintTransactionSimulation(canResponse.Simulation)
 			totalMatchRequest.Add(totalMatchRequest, canResponse.MatchRequest)
 		}
 	}
@@ -313,6 +315,8 @@ func reduceBondAmount(c *cli.Context) error {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
 			totalSafeGas += canResponse.GasInfo.SafeGasLimit
+			fmt.Printf("Minipool %s:\n", minipool.Address.Hex())
+			cliutils.PrintTransactionSimulation(canResponse.Simulation)
 		}
 	}
 	gasInfo.EstGasLimit = totalGas
@@ -374,6 +378,9 @@ func forceFeeDistribution(c *cli.Context, rp *rocketpool.Client) error {
 	fmt.Printf("\tYour withdrawal address will receive %.6f ETH.\n", canDistributeResponse.NodeShare)
 	fmt.Printf("\trETH pool stakers will receive %.6f ETH.\n\n", rEthShare)
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canDistributeResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canDistributeResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
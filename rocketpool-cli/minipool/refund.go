@@ -97,6 +97,8 @@ func refundMinipools(c *cli.Context) error {
 			gasInfo = canResponse.GasInfo
 			totalGas += canResponse.GasInfo.EstGasLimit
 			totalSafeGas += canResponse.GasInfo.SafeGasLimit
+			fmt.Printf("Minipool %s:\n", minipool.Address.Hex())
+			cliutils.PrintTransactionSimulation(canResponse.Simulation)
 		}
 	}
 	gasInfo.EstGasLimit = totalGas
@@ -197,8 +197,17 @@ func rescueDissolved(c *cli.Context) error {
 		depositAmountFloat = depositAmountEth
 	}
 
+	// Check the deposit for the chosen amount
+	canResponse, err := rp.CanRescueDissolvedMinipool(selectedMinipool.Address, depositAmount)
+	if err != nil {
+		return err
+	}
+
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fee
-	err = gas.AssignMaxFeeAndLimit(selectedMinipool.GasInfo, rp, c.Bool("yes"))
+	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,109 @@
+package minipool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/hex"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+func getSnapshotStatus(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the status from the snapshot
+	status, err := rp.NodeSnapshotStatus(c.Uint64("at-slot"), c.Uint64("at-block"))
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, status)
+	}
+
+	fmt.Printf("These minipools are from the state snapshot taken at slot %d (block %d), %s.\n\n", status.Slot, status.Block, status.Time.Format(time.RFC1123))
+
+	// Get minipools by status
+	statusMinipools := map[string][]api.SnapshotMinipoolDetails{}
+	finalisedMinipools := []api.SnapshotMinipoolDetails{}
+	for _, minipool := range status.Minipools {
+		if minipool.Finalised {
+			finalisedMinipools = append(finalisedMinipools, minipool)
+			continue
+		}
+		statusName := minipool.Status.String()
+		statusMinipools[statusName] = append(statusMinipools[statusName], minipool)
+	}
+
+	// Return if there aren't any minipools
+	if len(status.Minipools) == 0 {
+		fmt.Println("The node did not have any minipools.")
+		return nil
+	}
+
+	// Print minipool details by status
+	for _, statusName := range types.MinipoolStatuses {
+		minipools, ok := statusMinipools[statusName]
+		if !ok {
+			continue
+		}
+		fmt.Printf("%d %s minipool(s):\n\n", len(minipools), statusName)
+		for _, minipool := range minipools {
+			printSnapshotMinipoolDetails(minipool)
+		}
+		fmt.Println("")
+	}
+
+	// Handle finalized minipools
+	if c.Bool("include-finalized") {
+		fmt.Printf("%d finalized minipool(s):\n\n", len(finalisedMinipools))
+		for _, minipool := range finalisedMinipools {
+			printSnapshotMinipoolDetails(minipool)
+		}
+	} else {
+		fmt.Printf("%d finalized minipool(s) (hidden)\n", len(finalisedMinipools))
+	}
+	fmt.Println("")
+	return nil
+
+}
+
+func printSnapshotMinipoolDetails(minipool api.SnapshotMinipoolDetails) {
+
+	fmt.Printf("--------------------\n")
+	fmt.Printf("\n")
+
+	fmt.Printf("Address:               %s\n", minipool.Address.Hex())
+	fmt.Printf("Status updated:        %s\n", minipool.StatusTime.Format(TimeFormat))
+	fmt.Printf("Node fee:              %f%%\n", minipool.NodeFee*100)
+	fmt.Printf("Node deposit:          %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.NodeDepositBalance), 6))
+	fmt.Printf("RP deposit:            %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.UserDepositBalance), 6))
+	fmt.Printf("Minipool Balance (EL): %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Balance), 6))
+	fmt.Printf("Your portion:          %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.NodeShareOfBalance), 6))
+	fmt.Printf("Validator pubkey:      %s\n", hex.AddPrefix(minipool.ValidatorPubkey.Hex()))
+	if minipool.Validator.Exists {
+		fmt.Printf("Validator index:       %s\n", minipool.Validator.Index)
+		if minipool.Validator.Active {
+			fmt.Printf("Validator active:      yes\n")
+		} else {
+			fmt.Printf("Validator active:      no\n")
+		}
+		fmt.Printf("Beacon balance (CL):   %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Validator.Balance), 6))
+		fmt.Printf("Your portion:          %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Validator.NodeBalance), 6))
+	} else {
+		fmt.Printf("Validator seen:        no\n")
+	}
+	fmt.Printf("\n")
+
+}
@@ -35,6 +35,11 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, status)
+	}
+
 	// Get minipools by status
 	statusMinipools := map[string][]api.MinipoolDetails{}
 	refundableMinipools := []api.MinipoolDetails{}
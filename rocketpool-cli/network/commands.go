@@ -8,6 +8,7 @@ import (
 
 // Register commands
 func RegisterCommands(app *cli.App, name string, aliases []string) {
+	cliutils.RegisterStructuredOutput(name, "dao-proposals", "stats", "rpl-price", "timezone-map", "node-fee")
 	app.Commands = append(app.Commands, cli.Command{
 		Name:    name,
 		Aliases: aliases,
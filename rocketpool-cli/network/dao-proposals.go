@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/urfave/cli"
 )
@@ -26,6 +27,22 @@ func getActiveDAOProposals(c *cli.Context) error {
 		return fmt.Errorf("Error loading configuration: %w", err)
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		snapshotProposalsResponse, err := rp.GetActiveDAOProposals()
+		if err != nil {
+			return err
+		}
+		currentVotingDelegate, err := rp.GetCurrentVotingDelegate()
+		if err != nil {
+			return err
+		}
+		return cliutils.PrintStructuredResponse(c, api.NetworkDAOProposalsOutput{
+			Proposals:             snapshotProposalsResponse,
+			CurrentVotingDelegate: currentVotingDelegate,
+		})
+	}
+
 	// Print what network we're on
 	err = cliutils.PrintNetwork(cfg.GetNetwork(), isNew)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 func getNodeFee(c *cli.Context) error {
@@ -23,6 +24,11 @@ func getNodeFee(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
 	// Print & return
 	fmt.Printf("The current network node commission rate is %f%%.\n", response.NodeFee*100)
 	fmt.Printf("Minimum node commission rate: %f%%\n", response.MinNodeFee*100)
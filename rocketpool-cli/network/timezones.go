@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 func getTimezones(c *cli.Context) error {
@@ -24,6 +25,11 @@ func getTimezones(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
 	// Sort it by the timezone name
 	var maxNameLength int
 	timezoneNames := make([]string, 0, len(response.TimezoneCounts))
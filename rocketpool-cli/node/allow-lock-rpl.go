@@ -35,6 +35,9 @@ func setRPLLockingAllowed(c *cli.Context, allowedToLock bool) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
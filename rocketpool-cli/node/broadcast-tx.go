@@ -0,0 +1,45 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func broadcastTransaction(c *cli.Context, signedTxPath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Read the signed transaction
+	signedTx, err := os.ReadFile(signedTxPath)
+	if err != nil {
+		return fmt.Errorf("Could not read the signed transaction from %s: %w", signedTxPath, err)
+	}
+
+	// Broadcast it
+	response, err := rp.BroadcastTransaction(strings.TrimSpace(string(signedTx)))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Broadcasting the transaction signed by %s...\n", response.From.Hex())
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Println("Successfully broadcast the transaction.")
+	return nil
+
+}
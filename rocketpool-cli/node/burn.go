@@ -40,6 +40,9 @@ func nodeBurn(c *cli.Context, amount float64, token string) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canBurn.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canBurn.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -208,6 +208,9 @@ func nodeClaimRewards(c *cli.Context) error {
 			return err
 		}
 
+		// Print the simulation results
+		cliutils.PrintTransactionSimulation(canClaim.Simulation)
+
 		// Assign max fees
 		err = gas.AssignMaxFeeAndLimit(canClaim.GasInfo, rp, c.Bool("yes"))
 		if err != nil {
@@ -219,6 +222,9 @@ func nodeClaimRewards(c *cli.Context) error {
 			return err
 		}
 
+		// Print the simulation results
+		cliutils.PrintTransactionSimulation(canClaim.Simulation)
+
 		// Assign max fees
 		err = gas.AssignMaxFeeAndLimit(canClaim.GasInfo, rp, c.Bool("yes"))
 		if err != nil {
@@ -0,0 +1,92 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+func getCollateral(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the target ratio
+	targetRatio := c.Float64("target-ratio")
+	if targetRatio < 0 || targetRatio > 100 {
+		return fmt.Errorf("Invalid target ratio '%f' - must be a number between 0 and 100", targetRatio)
+	}
+
+	// Get the projection
+	response, err := rp.GetCollateralProjection(targetRatio)
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
+	if response.BorrowedEth.Sign() == 0 {
+		fmt.Println("The node doesn't have any borrowed ETH, so it has no collateral requirements.")
+		return nil
+	}
+
+	// Print the current state
+	rplPrice := eth.WeiToEth(response.RplPrice)
+	fmt.Printf("%s=== Current Collateral ===%s\n", colorGreen, colorReset)
+	fmt.Printf("The node has %.6f RPL staked, worth %.6f ETH at the current price of %.6f ETH per RPL.\n", math.RoundDown(eth.WeiToEth(response.RplStake), 6), eth.WeiToEth(response.RplStake)*rplPrice, rplPrice)
+	fmt.Printf("It has %.6f ETH borrowed and %.6f ETH bonded, including pending bond reductions.\n", math.RoundDown(eth.WeiToEth(response.BorrowedEth), 6), math.RoundDown(eth.WeiToEth(response.BondedEth), 6))
+	fmt.Printf("Its collateral is %.2f%% of its borrowed ETH and %.2f%% of its bonded ETH.\n", response.BorrowedCollateralRatio, response.BondedCollateralRatio)
+	fmt.Printf("RPL earns rewards between %.0f%% of borrowed ETH and %.0f%% of bonded ETH.\n\n", response.MinimumCollateralPercent, response.MaximumCollateralPercent)
+
+	// Print the price thresholds
+	fmt.Printf("%s=== RPL Price Thresholds ===%s\n", colorGreen, colorReset)
+	if response.MinimumRplPrice == nil {
+		fmt.Printf("%sThe node has no RPL staked, so it is below the minimum at any RPL price.%s\n", colorRed, colorReset)
+	} else {
+		minPrice := eth.WeiToEth(response.MinimumRplPrice)
+		if rplPrice < minPrice {
+			fmt.Printf("%sThe node is below the minimum collateral and is not earning RPL rewards. It would need an RPL price of %.6f ETH to reach the minimum.%s\n", colorRed, minPrice, colorReset)
+		} else {
+			drop := (1 - minPrice/rplPrice) * 100
+			color := colorGreen
+			if response.BorrowedCollateralRatio < response.TargetRatio {
+				color = colorYellow
+			}
+			fmt.Printf("%sThe node will fall below the minimum collateral if the RPL price drops below %.6f ETH (a %.2f%% drop).%s\n", color, minPrice, drop, colorReset)
+		}
+	}
+	if response.MaximumRplPrice != nil {
+		maxPrice := eth.WeiToEth(response.MaximumRplPrice)
+		if rplPrice > maxPrice {
+			fmt.Printf("The node is above the maximum collateral; only part of its RPL is earning rewards until the RPL price drops below %.6f ETH.\n", maxPrice)
+		} else {
+			rise := (maxPrice/rplPrice - 1) * 100
+			fmt.Printf("The node will exceed the maximum collateral if the RPL price rises above %.6f ETH (a %.2f%% rise).\n", maxPrice, rise)
+		}
+	}
+	fmt.Println()
+
+	// Print the target
+	fmt.Printf("%s=== Target Ratio ===%s\n", colorGreen, colorReset)
+	if response.TargetRatio == 0 {
+		fmt.Println("No target ratio is set. You can set one with `--target-ratio` or the Collateral Target Ratio setting in `rocketpool service config`.")
+	} else if response.RplNeededForTarget.Sign() == 0 {
+		fmt.Printf("The node is at or above its target ratio of %.2f%% of borrowed ETH.\n", response.TargetRatio)
+	} else {
+		fmt.Printf("%sThe node needs to stake %.6f more RPL to reach its target ratio of %.2f%% of borrowed ETH.%s\n", colorYellow, math.RoundUp(eth.WeiToEth(response.RplNeededForTarget), 6), response.TargetRatio, colorReset)
+	}
+	return nil
+
+}
@@ -8,6 +8,7 @@ import (
 
 // Register commands
 func RegisterCommands(app *cli.App, name string, aliases []string) {
+	cliutils.RegisterStructuredOutput(name, "status", "sync", "snapshots", "rewards", "collateral", "pending-tx", "deferred-tx")
 	app.Commands = append(app.Commands, cli.Command{
 		Name:    name,
 		Aliases: aliases,
@@ -18,7 +19,17 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "status",
 				Aliases:   []string{"s"},
 				Usage:     "Get the node's status",
-				UsageText: "rocketpool node status",
+				UsageText: "rocketpool node status [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "at-slot",
+						Usage: "Show the node's status from the latest saved state snapshot at or before this slot",
+					},
+					cli.Uint64Flag{
+						Name:  "at-block",
+						Usage: "Show the node's status from the latest saved state snapshot at or before this block",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
@@ -27,11 +38,31 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					}
 
 					// Run
+					if c.IsSet("at-slot") || c.IsSet("at-block") {
+						return getSnapshotStatus(c)
+					}
 					return getStatus(c)
 
 				},
 			},
 
+			{
+				Name:      "snapshots",
+				Usage:     "List the network state snapshots saved by the node daemon, which can be queried with `--at-slot` or `--at-block`",
+				UsageText: "rocketpool node snapshots",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getSnapshots(c)
+
+				},
+			},
+
 			{
 				Name:      "sync",
 				Aliases:   []string{"y"},
@@ -99,6 +130,61 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "collateral",
+				Usage:     "Show the node's collateral ratios, the RPL prices at which it would leave the rewardable range, and the RPL needed to reach a target ratio",
+				UsageText: "rocketpool node collateral [options]",
+				Flags: []cli.Flag{
+					cli.Float64Flag{
+						Name:  "target-ratio, t",
+						Usage: "The target collateral `ratio` as a percentage of borrowed ETH (defaults to the Collateral Target Ratio setting)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getCollateral(c)
+
+				},
+			},
+
+			{
+				Name:      "rewards-ledger",
+				Usage:     "Export the node's history of rewards, claims, minipool distributions and fee distributor distributions for tax or accounting purposes",
+				UsageText: "rocketpool node rewards-ledger [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "format",
+						Usage: "The file `format` to export: 'csv' or 'json'",
+						Value: "csv",
+					},
+					cli.IntFlag{
+						Name:  "year",
+						Usage: "Only include entries from this calendar `year` (in UTC)",
+					},
+					cli.StringFlag{
+						Name:  "file, f",
+						Usage: "The `path` to write the ledger to (defaults to rewards-ledger[-year].csv or .json in the current directory)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return exportRewardsLedger(c)
+
+				},
+			},
+
 			{
 				Name:      "set-primary-withdrawal-address",
 				Aliases:   []string{"w"},
@@ -828,6 +914,77 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "broadcast-tx",
+				Usage:     "Submit a transaction that was signed offline with `rocketpool wallet sign-tx`",
+				UsageText: "rocketpool node broadcast-tx signed-tx-file",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcastTransaction(c, c.Args().Get(0))
+
+				},
+			},
+
+			{
+				Name:      "pending-tx",
+				Usage:     "List the transactions the node and watchtower daemons have sent that haven't been included in a block yet, or cancel one of them",
+				UsageText: "rocketpool node pending-tx [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "cancel",
+						Usage: "The `nonce` of a pending transaction to cancel by replacing it with an empty transfer",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the cancellation",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getPendingTransactions(c)
+
+				},
+			},
+
+			{
+				Name:      "deferred-tx",
+				Usage:     "List the transactions queued with --when-gas-below or --not-after for the node daemon to send later, or remove one from the queue",
+				UsageText: "rocketpool node deferred-tx [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "cancel",
+						Usage: "The `id` of a queued transaction to remove",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm the removal",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getDeferredTransactions(c)
+
+				},
+			},
 		},
 	})
 }
@@ -201,6 +201,9 @@ func createVacantMinipool(c *cli.Context, pubkey types.ValidatorPubkey) error {
 		}
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canDeposit.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canDeposit.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
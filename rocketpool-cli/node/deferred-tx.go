@@ -0,0 +1,86 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func getDeferredTransactions(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Cancel one if requested
+	if c.IsSet("cancel") {
+		id := c.Uint64("cancel")
+		if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to remove deferred transaction %d from the queue?", id))) {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+		if _, err := rp.CancelDeferredTransaction(id); err != nil {
+			return err
+		}
+		fmt.Printf("Removed deferred transaction %d from the queue.\n", id)
+		return nil
+	}
+
+	// Get the deferred transactions
+	response, err := rp.GetDeferredTransactions()
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
+	if len(response.Transactions) == 0 {
+		fmt.Println("There are no deferred transactions. You can queue one by running a command with `--when-gas-below` and/or `--not-after`, e.g. `rocketpool --when-gas-below 10 node claim-rewards`.")
+		return nil
+	}
+
+	// Print them
+	for _, tx := range response.Transactions {
+		statusColor := colorYellow
+		switch tx.Status {
+		case txmanager.DeferredTransactionStatus_Completed:
+			statusColor = colorGreen
+		case txmanager.DeferredTransactionStatus_Failed:
+			statusColor = colorRed
+		}
+		fmt.Printf("%s#%d: %s%s\n", statusColor, tx.ID, tx.Status, colorReset)
+		fmt.Printf("\tCommand:   %s\n", tx.Description)
+		fmt.Printf("\tQueued:    %s\n", tx.QueuedTime.Format(time.RFC1123))
+		if tx.WhenGasBelowGwei > 0 {
+			fmt.Printf("\tGas below: %.2f gwei\n", tx.WhenGasBelowGwei)
+		}
+		if !tx.NotAfter.IsZero() {
+			fmt.Printf("\tNot after: %s\n", tx.NotAfter.Format(time.RFC1123))
+		}
+		if tx.Status != txmanager.DeferredTransactionStatus_Queued {
+			fmt.Printf("\tSent:      %s\n", tx.SentTime.Format(time.RFC1123))
+			if tx.TxHash != (common.Hash{}) {
+				fmt.Printf("\tHash:      %s\n", tx.TxHash.Hex())
+			}
+		}
+		if tx.Error != "" {
+			fmt.Printf("\tError:     %s\n", tx.Error)
+		}
+		fmt.Println()
+	}
+	fmt.Println("To remove a queued transaction, run `rocketpool node deferred-tx --cancel <id>`.")
+	return nil
+
+}
@@ -228,6 +228,9 @@ func nodeDeposit(c *cli.Context) error {
 		}
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canDeposit.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canDeposit.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -38,6 +38,9 @@ func initializeFeeDistributor(c *cli.Context) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(gasResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(gasResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -107,6 +110,9 @@ func distribute(c *cli.Context) error {
 	fmt.Printf("\tYour withdrawal address will receive %.6f ETH.\n", canDistributeResponse.NodeShare)
 	fmt.Printf("\trETH pool stakers will receive %.6f ETH.\n\n", rEthShare)
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canDistributeResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canDistributeResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
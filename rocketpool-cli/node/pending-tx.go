@@ -0,0 +1,124 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func getPendingTransactions(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the pending transactions
+	response, err := rp.GetPendingTransactions()
+	if err != nil {
+		return err
+	}
+
+	// Cancel one if requested
+	if c.IsSet("cancel") {
+		return cancelPendingTransaction(c, rp, response)
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
+	// Print them
+	if len(response.Transactions) == 0 {
+		fmt.Println("The node and watchtower daemons don't have any pending transactions.")
+	}
+	for _, tx := range response.Transactions {
+		printPendingTransaction(tx)
+	}
+
+	// Print any pending transactions the daemons didn't send
+	for _, account := range response.Accounts {
+		if len(account.UntrackedNonces) == 0 {
+			continue
+		}
+		fmt.Printf("%sAccount %s also has %d pending transaction(s) that weren't sent by the daemons, with nonces %v. They will need to be included or cancelled by whatever sent them before any later transactions can go through.%s\n\n",
+			colorYellow, account.Address.Hex(), len(account.UntrackedNonces), account.UntrackedNonces, colorReset)
+	}
+
+	if len(response.Transactions) > 0 {
+		fmt.Println("The daemons will replace these with higher fees automatically, up to the fee bump ceiling in the Smartnode settings. To cancel one instead, run `rocketpool node pending-tx --cancel <nonce>`.")
+	}
+	return nil
+
+}
+
+// Replace a pending transaction with an empty transfer
+func cancelPendingTransaction(c *cli.Context, rp *rocketpool.Client, response api.PendingTransactionsResponse) error {
+
+	// Find the transaction
+	nonce := c.Uint64("cancel")
+	var pendingTx *api.PendingTransaction
+	for i, tx := range response.Transactions {
+		if tx.Nonce == nonce {
+			pendingTx = &response.Transactions[i]
+			break
+		}
+	}
+	if pendingTx == nil {
+		fmt.Printf("The daemons don't have a pending transaction with nonce %d.\n", nonce)
+		return nil
+	}
+	printPendingTransaction(*pendingTx)
+
+	// Prompt for confirmation
+	if pendingTx.CancelMaxFeeWei != nil {
+		fmt.Printf("Cancelling it will send an empty transfer from %s to itself with the same nonce, a max fee of %.6f Gwei and a priority fee of %.6f Gwei.\n",
+			pendingTx.From.Hex(), eth.WeiToGwei(pendingTx.CancelMaxFeeWei), eth.WeiToGwei(pendingTx.CancelPriorityFeeWei))
+	}
+	fmt.Printf("%sNOTE: If the original transaction is included first, the cancellation will fail and the original's action will still happen.%s\n", colorYellow, colorReset)
+	if !(c.Bool("yes") || cliutils.Confirm(fmt.Sprintf("Are you sure you want to cancel the transaction with nonce %d?", nonce))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Cancel it
+	cancelResponse, err := rp.CancelPendingTransaction(nonce)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Cancelling transaction...\n")
+	cliutils.PrintTransactionHash(rp, cancelResponse.TxHash)
+	if _, err = rp.WaitForTransaction(cancelResponse.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Printf("Successfully cancelled the transaction with nonce %d.\n", nonce)
+	return nil
+
+}
+
+// Print the details of a pending transaction
+func printPendingTransaction(tx api.PendingTransaction) {
+	fmt.Printf("%sNonce %d%s (sent by the %s daemon from %s)\n", colorGreen, tx.Nonce, colorReset, tx.Daemon, tx.From.Hex())
+	fmt.Printf("\tHash:         %s\n", tx.TxHash.Hex())
+	if tx.To != nil {
+		fmt.Printf("\tTo:           %s\n", tx.To.Hex())
+	}
+	if tx.MaxFeeWei != nil {
+		fmt.Printf("\tMax fee:      %.6f Gwei (priority fee %.6f Gwei)\n", eth.WeiToGwei(tx.MaxFeeWei), eth.WeiToGwei(tx.MaxPriorityFeeWei))
+	}
+	fmt.Printf("\tReplacements: %d\n", tx.Replacements)
+	fmt.Printf("\tFirst sent:   %s (%s ago)\n", tx.FirstSent.Format(time.RFC1123), time.Since(tx.FirstSent).Round(time.Second))
+	fmt.Printf("\tLast sent:    %s\n\n", tx.LastSent.Format(time.RFC1123))
+}
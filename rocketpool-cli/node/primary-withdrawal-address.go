@@ -78,6 +78,9 @@ func setPrimaryWithdrawalAddress(c *cli.Context, withdrawalAddressOrENS string)
 				return err
 			}
 
+			// Print the simulation results
+			cliutils.PrintTransactionSimulation(canSendResponse.Simulation)
+
 			// Assign max fees
 			err = gas.AssignMaxFeeAndLimit(canSendResponse.GasInfo, rp, c.Bool("yes"))
 			if err != nil {
@@ -104,6 +107,9 @@ func setPrimaryWithdrawalAddress(c *cli.Context, withdrawalAddressOrENS string)
 		}
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -164,6 +170,9 @@ func confirmPrimaryWithdrawalAddress(c *cli.Context) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
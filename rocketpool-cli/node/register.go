@@ -43,6 +43,9 @@ func registerNode(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canRegister.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canRegister.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -0,0 +1,147 @@
+package node
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Config
+const ledgerFileMode os.FileMode = 0644
+
+func exportRewardsLedger(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check the format
+	format := strings.ToLower(c.String("format"))
+	if format != "csv" && format != "json" {
+		return fmt.Errorf("Invalid format '%s' - valid formats are 'csv' and 'json'", c.String("format"))
+	}
+
+	// Build the ledger
+	fmt.Println("Building the rewards ledger from your node's history. This can take several minutes...")
+	ledger, err := rp.NodeRewardsLedger()
+	if err != nil {
+		return err
+	}
+
+	// Download any rewards tree files the ledger needs
+	if len(ledger.MissingIntervals) > 0 {
+		fmt.Println()
+		for _, interval := range ledger.MissingIntervals {
+			fmt.Printf("You are missing the rewards tree file for interval %d (or your local copy doesn't match the canonical one).\n", interval)
+		}
+		fmt.Printf("%sNOTE: Rewards from these intervals can't be included in the ledger without their tree files. If you would like to regenerate them manually, please answer `n` to the prompt below and run `rocketpool network generate-rewards-tree`.%s\n", colorBlue, colorReset)
+		if !cliutils.Confirm("Would you like to download all missing rewards tree files now?") {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+		for _, interval := range ledger.MissingIntervals {
+			fmt.Printf("Downloading interval %d file... ", interval)
+			_, err := rp.DownloadRewardsFile(interval)
+			if err != nil {
+				return fmt.Errorf("error downloading rewards file for interval %d: %w", interval, err)
+			}
+			fmt.Println("done!")
+		}
+		fmt.Println()
+
+		// Rebuild the ledger now that the files are in place
+		ledger, err = rp.NodeRewardsLedger()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Filter by year
+	events := ledger.Events
+	year := c.Int("year")
+	if year != 0 {
+		events = []rprewards.LedgerEvent{}
+		for _, event := range ledger.Events {
+			if event.Time.UTC().Year() == year {
+				events = append(events, event)
+			}
+		}
+	}
+
+	// Serialize it
+	var buffer bytes.Buffer
+	if format == "json" {
+		encoded, err := json.MarshalIndent(events, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error serializing rewards ledger: %w", err)
+		}
+		buffer.Write(encoded)
+	} else {
+		if err := rprewards.WriteLedgerCsv(&buffer, events); err != nil {
+			return fmt.Errorf("error serializing rewards ledger: %w", err)
+		}
+	}
+
+	// Write it
+	path := c.String("file")
+	if path == "" {
+		if year != 0 {
+			path = fmt.Sprintf("rewards-ledger-%d.%s", year, format)
+		} else {
+			path = fmt.Sprintf("rewards-ledger.%s", format)
+		}
+	}
+	if err := os.WriteFile(path, buffer.Bytes(), ledgerFileMode); err != nil {
+		return fmt.Errorf("error writing rewards ledger to %s: %w", path, err)
+	}
+
+	// Print a summary
+	ethTotals := map[rprewards.LedgerEventType]*big.Int{}
+	rplTotals := map[rprewards.LedgerEventType]*big.Int{}
+	counts := map[rprewards.LedgerEventType]int{}
+	bondTotal := big.NewInt(0)
+	for _, event := range events {
+		if _, exists := ethTotals[event.Type]; !exists {
+			ethTotals[event.Type] = big.NewInt(0)
+			rplTotals[event.Type] = big.NewInt(0)
+		}
+		ethTotals[event.Type].Add(ethTotals[event.Type], &event.EthAmount.Int)
+		rplTotals[event.Type].Add(rplTotals[event.Type], &event.RplAmount.Int)
+		counts[event.Type]++
+		if event.BondAmount != nil {
+			bondTotal.Add(bondTotal, &event.BondAmount.Int)
+		}
+	}
+	fmt.Printf("Wrote %d ledger entries to %s.\n\n", len(events), path)
+	for _, eventType := range []rprewards.LedgerEventType{
+		rprewards.LedgerEventType_IntervalRewards,
+		rprewards.LedgerEventType_RewardsClaim,
+		rprewards.LedgerEventType_MinipoolDistribution,
+		rprewards.LedgerEventType_MinipoolExit,
+		rprewards.LedgerEventType_FeeDistribution,
+	} {
+		if counts[eventType] == 0 {
+			continue
+		}
+		fmt.Printf("%-22s %4d entries, %.6f ETH, %.6f RPL\n", eventType+":", counts[eventType], eth.WeiToEth(ethTotals[eventType]), eth.WeiToEth(rplTotals[eventType]))
+	}
+	if bondTotal.Sign() > 0 {
+		fmt.Printf("\nExited minipools also returned %.6f ETH of bonds, which isn't included in the totals above.\n", eth.WeiToEth(bondTotal))
+	}
+	fmt.Printf("\n%sNOTE: Interval rewards are the amounts assigned to your node when each rewards interval ended; claims are the same rewards arriving in your wallet, so don't count both as income.%s\n", colorYellow, colorReset)
+	return nil
+
+}
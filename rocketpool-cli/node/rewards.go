@@ -8,6 +8,7 @@ import (
 
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -26,6 +27,21 @@ func getRewards(c *cli.Context) error {
 		return fmt.Errorf("error getting rewards info: %w", err)
 	}
 
+	// Print the structured response if requested; missing rewards trees are reported in the rewards info instead of being downloaded
+	if cliutils.IsStructuredOutput(c) {
+		output := api.NodeRewardsOutput{
+			RewardsInfo: rewardsInfoResponse,
+		}
+		if rewardsInfoResponse.Registered {
+			rewards, err := rp.NodeRewards()
+			if err != nil {
+				return err
+			}
+			output.Rewards = &rewards
+		}
+		return cliutils.PrintStructuredResponse(c, output)
+	}
+
 	if !rewardsInfoResponse.Registered {
 		fmt.Printf("This node is not currently registered.\n")
 		return nil
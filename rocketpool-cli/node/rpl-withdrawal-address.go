@@ -99,6 +99,9 @@ func setRPLWithdrawalAddress(c *cli.Context, withdrawalAddressOrENS string) erro
 				return err
 			}
 
+			// Print the simulation results
+			cliutils.PrintTransactionSimulation(canSendResponse.Simulation)
+
 			// Assign max fees
 			err = gas.AssignMaxFeeAndLimit(canSendResponse.GasInfo, rp, c.Bool("yes"))
 			if err != nil {
@@ -125,6 +128,9 @@ func setRPLWithdrawalAddress(c *cli.Context, withdrawalAddressOrENS string) erro
 		}
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -204,6 +210,9 @@ func confirmRPLWithdrawalAddress(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -44,6 +44,9 @@ func sendMessage(c *cli.Context, toAddressOrENS string, message []byte) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canSend.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canSend.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
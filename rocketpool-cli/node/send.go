@@ -83,6 +83,9 @@ func nodeSend(c *cli.Context, amount float64, token string, toAddressOrENS strin
 		}
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canSend.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canSend.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
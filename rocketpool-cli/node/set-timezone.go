@@ -33,6 +33,9 @@ func setTimezoneLocation(c *cli.Context) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -0,0 +1,122 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/math"
+)
+
+func getSnapshotStatus(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the status from the snapshot
+	status, err := rp.NodeSnapshotStatus(c.Uint64("at-slot"), c.Uint64("at-block"))
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, status)
+	}
+
+	// Snapshot details
+	fmt.Printf("%s=== State Snapshot ===%s\n", colorGreen, colorReset)
+	fmt.Printf("This status is from the snapshot taken at slot %d (block %d), %s.\n\n", status.Slot, status.Block, status.Time.Format(time.RFC1123))
+
+	// Account details
+	fmt.Printf("%s=== Account and Balances ===%s\n", colorGreen, colorReset)
+	fmt.Printf("The node %s%s%s had a balance of %.6f ETH and %.6f RPL.\n", colorBlue, status.AccountAddress.Hex(), colorReset, math.RoundDown(eth.WeiToEth(status.AccountBalances.ETH), 6), math.RoundDown(eth.WeiToEth(status.AccountBalances.RPL), 6))
+	if !status.Registered {
+		fmt.Println("The node was not registered with Rocket Pool.")
+		return nil
+	}
+	fmt.Printf("The node was registered with Rocket Pool with a timezone location of %s.\n", status.TimezoneLocation)
+	fmt.Printf("Its primary withdrawal address was %s%s%s.\n", colorBlue, status.WithdrawalAddress.Hex(), colorReset)
+	if status.DepositCreditBalance != nil && status.DepositCreditBalance.Sign() > 0 {
+		fmt.Printf("It had %.6f ETH in deposit credit.\n", math.RoundDown(eth.WeiToEth(status.DepositCreditBalance), 6))
+	}
+	fmt.Println()
+
+	// Smoothing pool and fee distributor
+	fmt.Printf("%s=== Fee Distributor and Smoothing Pool ===%s\n", colorGreen, colorReset)
+	if status.SmoothingPoolRegistered {
+		fmt.Println("The node was opted into the Smoothing Pool.")
+	} else {
+		fmt.Println("The node was not opted into the Smoothing Pool.")
+	}
+	fmt.Printf("Its fee distributor %s%s%s had a balance of %.6f ETH.\n\n", colorBlue, status.FeeDistributorAddress.Hex(), colorReset, math.RoundDown(eth.WeiToEth(status.FeeDistributorBalance), 6))
+
+	// RPL stake
+	fmt.Printf("%s=== RPL Stake ===%s\n", colorGreen, colorReset)
+	fmt.Printf("The node had a total stake of %.6f RPL and an effective stake of %.6f RPL, at an RPL price of %.6f ETH.\n", math.RoundDown(eth.WeiToEth(status.RplStake), 6), math.RoundDown(eth.WeiToEth(status.EffectiveRplStake), 6), eth.WeiToEth(status.RplPrice))
+	fmt.Printf("Its collateral was %.2f%% of its borrowed ETH and %.2f%% of its bonded ETH.\n", status.BorrowedCollateralRatio*100, status.BondedCollateralRatio*100)
+	fmt.Printf("It needed at least %.6f RPL staked to earn rewards, and at most %.6f RPL was rewardable.\n", math.RoundUp(eth.WeiToEth(status.MinimumRplStake), 6), math.RoundUp(eth.WeiToEth(status.MaximumRplStake), 6))
+	fmt.Printf("It could match %.6f more ETH in new minipools.\n\n", math.RoundDown(eth.WeiToEth(status.EthMatchedLimit)-eth.WeiToEth(status.EthMatched), 6))
+
+	// Minipools
+	fmt.Printf("%s=== Minipools ===%s\n", colorGreen, colorReset)
+	statusCounts := map[string]int{}
+	finalised := 0
+	for _, minipool := range status.Minipools {
+		if minipool.Finalised {
+			finalised++
+		} else {
+			statusCounts[minipool.Status.String()]++
+		}
+	}
+	if len(status.Minipools) == 0 {
+		fmt.Println("The node had no minipools.")
+	} else {
+		fmt.Printf("The node had a total of %d minipool(s):\n", len(status.Minipools))
+		for _, statusName := range types.MinipoolStatuses {
+			if count := statusCounts[statusName]; count > 0 {
+				fmt.Printf("- %d %s\n", count, statusName)
+			}
+		}
+		if finalised > 0 {
+			fmt.Printf("- %d finalized\n", finalised)
+		}
+	}
+	return nil
+
+}
+
+func getSnapshots(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the snapshots
+	response, err := rp.NodeSnapshots()
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
+	if len(response.Snapshots) == 0 {
+		fmt.Println("There are no state snapshots yet. You can enable them with the State Snapshot Interval setting in `rocketpool service config`.")
+		return nil
+	}
+	fmt.Printf("There are %d state snapshot(s):\n", len(response.Snapshots))
+	for _, snapshot := range response.Snapshots {
+		fmt.Printf("- slot %d (block %d)\n", snapshot.Slot, snapshot.Block)
+	}
+	return nil
+
+}
@@ -44,6 +44,9 @@ func addAddressToStakeRplWhitelist(c *cli.Context, addressOrENS string) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -105,6 +108,9 @@ func removeAddressFromStakeRplWhitelist(c *cli.Context, addressOrENS string) err
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -66,6 +66,9 @@ func nodeStakeRpl(c *cli.Context) error {
 				if err != nil {
 					return err
 				}
+				// Print the simulation results
+				cliutils.PrintTransactionSimulation(approvalGas.Simulation)
+
 				// Assign max fees
 				err = gas.AssignMaxFeeAndLimit(approvalGas.GasInfo, rp, c.Bool("yes"))
 				if err != nil {
@@ -110,6 +113,9 @@ func nodeStakeRpl(c *cli.Context) error {
 				return nil
 			}
 			fmt.Println("RPL Swap Gas Info:")
+			// Print the simulation results
+			cliutils.PrintTransactionSimulation(canSwap.Simulation)
+
 			// Assign max fees
 			err = gas.AssignMaxFeeAndLimit(canSwap.GasInfo, rp, c.Bool("yes"))
 			if err != nil {
@@ -248,6 +254,9 @@ func nodeStakeRpl(c *cli.Context) error {
 		if err != nil {
 			return err
 		}
+		// Print the simulation results
+		cliutils.PrintTransactionSimulation(approvalGas.Simulation)
+
 		// Assign max fees
 		err = gas.AssignMaxFeeAndLimit(approvalGas.GasInfo, rp, c.Bool("yes"))
 		if err != nil {
@@ -292,6 +301,9 @@ func nodeStakeRpl(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canStake.Simulation)
+
 	fmt.Println("RPL Stake Gas Info:")
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canStake.GasInfo, rp, c.Bool("yes"))
@@ -44,13 +44,25 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		if !walletStatus.WalletInitialized && !walletStatus.WatchOnly {
+			return errors.New("The node wallet is not initialized.")
+		}
+		status, err := rp.NodeStatus()
+		if err != nil {
+			return err
+		}
+		return cliutils.PrintStructuredResponse(c, status)
+	}
+
 	// Rescue Node Plugin - ensure that we print the rescue node stuff even
 	// when the eth1 node is syncing by deferring it here.
 	//
 	// Since we collected all the data we need for this message, we can safely
 	// defer it and let it execute even if we fail further down, eg because
 	// the EC is still syncing.
-	if walletStatus.WalletInitialized {
+	if walletStatus.WalletInitialized || walletStatus.WatchOnly {
 		defer func() {
 			if cfg.RescueNode.GetEnabledParameter().Value.(bool) {
 				fmt.Println()
@@ -67,7 +79,7 @@ func getStatus(c *cli.Context) error {
 	}
 
 	// rp.NodeStatus() will fail with an error, but we can short-circuit it here.
-	if !walletStatus.WalletInitialized {
+	if !walletStatus.WalletInitialized && !walletStatus.WatchOnly {
 		return errors.New("The node wallet is not initialized.")
 	}
 
@@ -94,6 +94,9 @@ func nodeSwapRpl(c *cli.Context) error {
 		if err != nil {
 			return err
 		}
+		// Print the simulation results
+		cliutils.PrintTransactionSimulation(approvalGas.Simulation)
+
 		// Assign max fees
 		err = gas.AssignMaxFeeAndLimit(approvalGas.GasInfo, rp, c.Bool("yes"))
 		if err != nil {
@@ -137,6 +140,10 @@ func nodeSwapRpl(c *cli.Context) error {
 		}
 		return nil
 	}
+
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canSwap.Simulation)
+
 	fmt.Println("RPL Swap Gas Info:")
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canSwap.GasInfo, rp, c.Bool("yes"))
@@ -59,6 +59,22 @@ func getSyncProgress(c *cli.Context) error {
 		return fmt.Errorf("Error loading configuration: %w", err)
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		depositContractInfo, err := rp.DepositContractInfo()
+		if err != nil {
+			return err
+		}
+		status, err := rp.NodeSync()
+		if err != nil {
+			return err
+		}
+		return cliutils.PrintStructuredResponse(c, api.NodeSyncStatusOutput{
+			DepositContractInfo: depositContractInfo,
+			SyncProgress:        status,
+		})
+	}
+
 	// Print what network we're on
 	err = cliutils.PrintNetwork(cfg.GetNetwork(), isNew)
 	if err != nil {
@@ -43,6 +43,9 @@ func nodeSetVotingDelegate(c *cli.Context, nameOrAddress string) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(gasEstimate.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(gasEstimate.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -88,6 +91,9 @@ func nodeClearVotingDelegate(c *cli.Context) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(gasEstimate.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(gasEstimate.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -97,6 +97,9 @@ func nodeWithdrawEth(c *cli.Context) error {
 		}
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canWithdraw.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canWithdraw.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
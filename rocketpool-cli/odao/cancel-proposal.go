@@ -89,6 +89,9 @@ func cancelProposal(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -64,6 +64,9 @@ func join(c *cli.Context) error {
 				if err != nil {
 					return err
 				}
+				// Print the simulation results
+				cliutils.PrintTransactionSimulation(approvalGas.Simulation)
+
 				// Assign max fees
 				err = gas.AssignMaxFeeAndLimit(approvalGas.GasInfo, rp, c.Bool("yes"))
 				if err != nil {
@@ -108,6 +111,9 @@ func join(c *cli.Context) error {
 				return nil
 			}
 			fmt.Println("RPL Swap Gas Info:")
+			// Print the simulation results
+			cliutils.PrintTransactionSimulation(canSwap.Simulation)
+
 			// Assign max fees
 			err = gas.AssignMaxFeeAndLimit(canSwap.GasInfo, rp, c.Bool("yes"))
 			if err != nil {
@@ -162,6 +168,9 @@ func join(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canJoin.Simulation)
+
 	// Display gas estimate
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canJoin.GasInfo, rp, c.Bool("yes"))
@@ -73,6 +73,9 @@ func leave(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canLeave.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canLeave.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
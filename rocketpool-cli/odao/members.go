@@ -26,6 +26,11 @@ func getMembers(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, members)
+	}
+
 	// Print & return
 	if len(members.Members) > 0 {
 		fmt.Printf("The oracle DAO has %d members:\n", len(members.Members))
@@ -36,6 +36,9 @@ func proposeInvite(c *cli.Context, memberAddress common.Address, memberId, membe
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -104,6 +104,9 @@ func proposeKick(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -34,6 +34,9 @@ func proposeSettingMembersQuorum(c *cli.Context, quorumPercent float64) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -86,6 +89,9 @@ func proposeSettingMembersRplBond(c *cli.Context, bondAmountEth float64) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -138,6 +144,9 @@ func proposeSettingMinipoolUnbondedMax(c *cli.Context, unbondedMinipoolMax uint6
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -197,6 +206,9 @@ func proposeSettingProposalCooldown(c *cli.Context, proposalCooldownTimespan str
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -256,6 +268,9 @@ func proposeSettingProposalVoteTimespan(c *cli.Context, proposalVoteTimespan str
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -315,6 +330,9 @@ func proposeSettingProposalVoteDelayTimespan(c *cli.Context, proposalDelayTimesp
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -374,6 +392,9 @@ func proposeSettingProposalExecuteTimespan(c *cli.Context, proposalExecuteTimesp
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -433,6 +454,9 @@ func proposeSettingProposalActionTimespan(c *cli.Context, proposalActionTimespan
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -492,6 +516,9 @@ func proposeSettingScrubPeriod(c *cli.Context, scrubPeriod string) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -551,6 +578,9 @@ func proposeSettingPromotionScrubPeriod(c *cli.Context, scrubPeriod string) erro
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -603,6 +633,9 @@ func proposeSettingScrubPenaltyEnabled(c *cli.Context, enabled bool) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -662,6 +695,9 @@ func proposeSettingBondReductionWindowStart(c *cli.Context, windowStart string)
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -721,6 +757,9 @@ func proposeSettingBondReductionWindowLength(c *cli.Context, windowLength string
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
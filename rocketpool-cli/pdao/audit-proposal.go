@@ -0,0 +1,162 @@
+package pdao
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Config
+const proofBundleFileMode os.FileMode = 0644
+
+// Colors for the audit results
+const (
+	colorRed    string = "\033[31m"
+	colorYellow string = "\033[33m"
+)
+
+// Descriptions of the challenge states of a tree node
+var challengeStates = map[types.ChallengeState]string{
+	types.ChallengeState_Unchallenged: "unchallenged",
+	types.ChallengeState_Challenged:   "challenged",
+	types.ChallengeState_Responded:    "responded",
+	types.ChallengeState_Paid:         "paid",
+}
+
+func auditProposal(c *cli.Context, proposalID uint64) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check for Houston
+	houston, err := rp.IsHoustonDeployed()
+	if err != nil {
+		return fmt.Errorf("error checking if Houston has been deployed: %w", err)
+	}
+	if !houston.IsHoustonDeployed {
+		fmt.Println("This command cannot be used until Houston has been deployed.")
+		return nil
+	}
+
+	// Run the audit
+	fmt.Println("Rebuilding the voting trees for the proposal and checking its root submissions... this may take a while.")
+	response, err := rp.PDAOAuditProposal(proposalID)
+	if err != nil {
+		return err
+	}
+
+	// Write the proof bundle if requested
+	bundlePath := c.String("proof-bundle")
+	if bundlePath != "" {
+		bundle, err := json.MarshalIndent(response, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error serializing proof bundle: %w", err)
+		}
+		if err := os.WriteFile(bundlePath, bundle, proofBundleFileMode); err != nil {
+			return fmt.Errorf("error writing proof bundle to %s: %w", bundlePath, err)
+		}
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+	fmt.Println()
+
+	// Print the proposal
+	fmt.Printf("%s== Protocol DAO Proposal %d ==%s\n", colorGreen, response.ProposalID, colorReset)
+	fmt.Printf("Message:        %s\n", response.Message)
+	fmt.Printf("State:          %s\n", types.ProtocolDaoProposalStates[response.State])
+	fmt.Printf("Proposed by:    %s\n", response.Proposer.Hex())
+	fmt.Printf("Target block:   %d\n", response.TargetBlock)
+	fmt.Printf("On-chain root:  %s\n", formatTreeNode(response.OnchainRoot))
+	fmt.Printf("Local root:     %s\n", formatTreeNode(response.LocalRoot))
+	if response.RootMatches {
+		fmt.Printf("%sThe proposal's root matches the locally generated network tree.%s\n", colorGreen, colorReset)
+	} else {
+		fmt.Printf("%sThe proposal's root does NOT match the locally generated network tree.%s\n", colorRed, colorReset)
+	}
+	fmt.Println()
+
+	// Print the root submissions
+	fmt.Printf("%s== Root Submissions ==%s\n", colorGreen, colorReset)
+	fmt.Printf("Scanned blocks %d to %d and found %d root submission(s).\n\n", response.StartBlock, response.EndBlock, len(response.Submissions))
+	mismatches := 0
+	failures := 0
+	for _, submission := range response.Submissions {
+		printRootSubmissionAudit(submission)
+		if submission.Error != "" {
+			failures++
+		} else if !submission.Matches {
+			mismatches++
+		}
+	}
+
+	// Print the summary
+	fmt.Printf("%s== Summary ==%s\n", colorGreen, colorReset)
+	switch {
+	case mismatches > 0:
+		fmt.Printf("%s%d of %d root submission(s) do not match the local voting trees.%s\n", colorRed, mismatches, len(response.Submissions), colorReset)
+	case failures > 0:
+		fmt.Printf("%s%d of %d root submission(s) could not be checked.%s\n", colorYellow, failures, len(response.Submissions), colorReset)
+	case !response.RootMatches:
+		fmt.Printf("%sThe proposal's root does not match the local network tree.%s\n", colorRed, colorReset)
+	default:
+		fmt.Printf("%sEvery root submitted for this proposal matches the local voting trees.%s\n", colorGreen, colorReset)
+	}
+	fmt.Println("This command does not submit any challenges. To have your node challenge invalid proposals automatically, enable the PDAO Proposal Checker in `rocketpool service config`.")
+	if bundlePath != "" {
+		fmt.Printf("The proof bundle was saved to %s.\n", bundlePath)
+	}
+	return nil
+
+}
+
+// Print the audit of a single root submission
+func printRootSubmissionAudit(submission api.PDAORootSubmissionAudit) {
+	fmt.Printf("Index %d, submitted by %s at %s\n", submission.Index, submission.Submitter.Hex(), submission.Timestamp.Format(time.RFC822))
+	fmt.Printf("    Challenge state:  %s\n", challengeStates[submission.ChallengeState])
+	fmt.Printf("    Submitted root:   %s\n", formatTreeNode(submission.SubmittedRoot))
+	if submission.LocalRoot != nil {
+		fmt.Printf("    Local root:       %s\n", formatTreeNode(*submission.LocalRoot))
+	}
+	if submission.Error != "" {
+		fmt.Printf("    %sCould not check this submission: %s%s\n\n", colorYellow, submission.Error, colorReset)
+		return
+	}
+	if submission.Matches {
+		fmt.Printf("    %sThe submitted pollard matches the local tree.%s\n\n", colorGreen, colorReset)
+		return
+	}
+
+	fmt.Printf("    %sThe submitted pollard does NOT match the local tree.%s\n", colorRed, colorReset)
+	if submission.MismatchIndex != 0 {
+		fmt.Printf("    First mismatch:   index %d\n", submission.MismatchIndex)
+		fmt.Printf("    Submitted node:   %s\n", formatTreeNode(*submission.SubmittedNode))
+		fmt.Printf("    Local node:       %s\n", formatTreeNode(*submission.LocalNode))
+		if proposals.VerifyMerkleProof(*submission.SubmittedNode, submission.MismatchIndex, submission.Proof, submission.SubmittedRoot, submission.Index) {
+			fmt.Printf("    Merkle proof:     %d nodes, proves the submitted node is part of the submitted root\n", len(submission.Proof))
+		} else {
+			fmt.Printf("    Merkle proof:     %d nodes, %sdoes not verify against the submitted root%s\n", len(submission.Proof), colorYellow, colorReset)
+		}
+	}
+	fmt.Println()
+}
+
+// Format a voting tree node as its voting power sum and hash
+func formatTreeNode(node types.VotingTreeNode) string {
+	return fmt.Sprintf("sum %s, hash %s", node.Sum.String(), node.Hash.Hex())
+}
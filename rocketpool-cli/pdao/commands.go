@@ -22,6 +22,8 @@ const (
 
 // Register commands
 func RegisterCommands(app *cli.App, name string, aliases []string) {
+	cliutils.RegisterStructuredOutput(name, "voting-power", "settings", "simulate-voting-power", "audit-proposal", "delegation-graph", "voting-policy")
+	cliutils.RegisterStructuredOutput(name+" proposals", "list", "details")
 	app.Commands = append(app.Commands, cli.Command{
 		Name:    name,
 		Aliases: aliases,
@@ -54,6 +56,121 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "simulate-voting-power",
+				Aliases:   []string{"svp"},
+				Usage:     "Show how staking more RPL, adding minipools, reducing bonds or receiving delegations would change your node's voting power",
+				UsageText: "rocketpool pdao simulate-voting-power [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "block",
+						Usage: "The `block` to simulate from (defaults to the latest block)",
+					},
+					cli.Float64Flag{
+						Name:  "stake-rpl, r",
+						Usage: "The `amount` of additional RPL to stake",
+					},
+					cli.Uint64Flag{
+						Name:  "add-minipools, m",
+						Usage: "The `number` of new minipools to create",
+					},
+					cli.Float64Flag{
+						Name:  "bond, b",
+						Usage: "The bond `amount` of the new minipools in ETH (8 or 16)",
+						Value: 8,
+					},
+					cli.Uint64Flag{
+						Name:  "reduce-bonds",
+						Usage: "The `number` of the node's staking 16-ETH minipools to reduce to an 8-ETH bond",
+					},
+					cli.StringFlag{
+						Name:  "delegators, d",
+						Usage: "A comma-separated list of node `addresses` that would delegate their voting power to your node",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return simulateVotingPower(c)
+
+				},
+			},
+
+			{
+				Name:      "delegation-graph",
+				Aliases:   []string{"dg"},
+				Usage:     "Show which nodes delegate their voting power to your node, and which node your node delegates to",
+				UsageText: "rocketpool pdao delegation-graph [options]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "block",
+						Usage: "The `block` to show the delegations at (defaults to the latest block)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getDelegationGraph(c)
+
+				},
+			},
+
+			{
+				Name:      "voting-policy",
+				Aliases:   []string{"vpol"},
+				Usage:     "Show how your voting policy would vote on the proposals that haven't finished voting",
+				UsageText: "rocketpool pdao voting-policy",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getVotingPolicy(c)
+
+				},
+			},
+
+			{
+				Name:      "audit-proposal",
+				Aliases:   []string{"ap"},
+				Usage:     "Rebuild the voting trees for a proposal and check every root submitted for it, without submitting any challenges",
+				UsageText: "rocketpool pdao audit-proposal proposal-id [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "proof-bundle, b",
+						Usage: "Save the audit, including the pollards and Merkle proofs of any mismatches, as a JSON file at this path so others can check it",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					id, err := cliutils.ValidatePositiveUint("proposal-id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					return auditProposal(c, id)
+
+				},
+			},
+
 			{
 				Name:      "rewards-percentages",
 				Aliases:   []string{"rp"},
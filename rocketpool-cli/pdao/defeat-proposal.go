@@ -50,6 +50,9 @@ func defeatProposal(c *cli.Context, proposalID uint64, challengedIndex uint64) e
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -0,0 +1,70 @@
+package pdao
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func getDelegationGraph(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check for Houston
+	houston, err := rp.IsHoustonDeployed()
+	if err != nil {
+		return fmt.Errorf("error checking if Houston has been deployed: %w", err)
+	}
+	if !houston.IsHoustonDeployed {
+		fmt.Println("This command cannot be used until Houston has been deployed.")
+		return nil
+	}
+
+	// Get the graph
+	blockNumber := c.Uint64("block")
+	if blockNumber > math.MaxUint32 {
+		return fmt.Errorf("Invalid block number '%d'", blockNumber)
+	}
+	response, err := rp.PDAODelegationGraph(uint32(blockNumber))
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
+	// Print the node's delegate
+	fmt.Printf("%s== Delegation at Block %d ==%s\n", colorGreen, response.BlockNumber, colorReset)
+	fmt.Printf("Node voting power: %.10f (%s of the network)\n", eth.WeiToEth(response.VotingPower), formatVotingPowerShare(response.VotingPower, response.TotalVotingPower))
+	if response.Delegate == response.NodeAddress {
+		fmt.Println("The node doesn't delegate its voting power, so it votes for itself.")
+	} else {
+		fmt.Printf("The node delegates its voting power to %s%s%s, which has %.10f delegated voting power (%s of the network).\n", colorBlue, response.DelegateFormatted, colorReset, eth.WeiToEth(response.DelegateVotingPower), formatVotingPowerShare(response.DelegateVotingPower, response.TotalVotingPower))
+	}
+	fmt.Println()
+
+	// Print the nodes delegating to it
+	fmt.Printf("%s== Nodes Delegating to This Node ==%s\n", colorGreen, colorReset)
+	if len(response.Delegators) == 0 {
+		fmt.Println("No other nodes delegate their voting power to this node.")
+	}
+	for _, delegator := range response.Delegators {
+		fmt.Printf("%s%s%s: %.10f\n", colorBlue, delegator.AddressFormatted, colorReset, eth.WeiToEth(delegator.VotingPower))
+	}
+	fmt.Println()
+	fmt.Printf("Total voting power this node votes with: %.10f (%s of the network)\n", eth.WeiToEth(response.DelegatedVotingPower), formatVotingPowerShare(response.DelegatedVotingPower, response.TotalVotingPower))
+	return nil
+
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 func getSettings(c *cli.Context) error {
@@ -33,6 +34,11 @@ func getSettings(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
 	// Auction
 	fmt.Println("== Auction Settings ==")
 	fmt.Printf("\tCreating New Lot Enabled: %t\n", response.Auction.IsCreateLotEnabled)
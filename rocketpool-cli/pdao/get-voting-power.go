@@ -7,6 +7,8 @@ import (
 
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 const (
@@ -44,6 +46,15 @@ func getVotePower(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, api.PDAOVotingPowerOutput{
+			VotingPower:           response,
+			IsVotingInitialized:   status.IsVotingInitialized,
+			OnchainVotingDelegate: status.OnchainVotingDelegate,
+		})
+	}
+
 	// Print Results
 	fmt.Printf("%s== Node Voting Power ==%s\n", colorGreen, colorReset)
 	if status.IsVotingInitialized {
@@ -37,6 +37,9 @@ func initializeVoting(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(resp.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(resp.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
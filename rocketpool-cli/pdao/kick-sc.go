@@ -116,6 +116,9 @@ func proposeSecurityCouncilKick(c *cli.Context) error {
 			return nil
 		}
 
+		// Print the simulation results
+		cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 		// Assign max fee
 		err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 		if err != nil {
@@ -157,6 +160,9 @@ func proposeSecurityCouncilKick(c *cli.Context) error {
 			return err
 		}
 
+		// Print the simulation results
+		cliutils.PrintTransactionSimulation(canResponse.Simulation)
+
 		// Assign max fee
 		err = gas.AssignMaxFeeAndLimit(canResponse.GasInfo, rp, c.Bool("yes"))
 		if err != nil {
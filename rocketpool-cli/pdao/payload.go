@@ -0,0 +1,101 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+)
+
+// Uint settings at least this large are most likely ETH amounts or percentages with 18 decimals
+var decimalSettingThreshold = big.NewInt(1e12)
+
+// Get a one-line description of what a proposal does
+func getPayloadSummary(payload *proposals.ProposalPayload) string {
+	switch payload.Type {
+	case proposals.ProposalPayloadType_Settings:
+		changes := []string{}
+		for _, setting := range payload.Settings {
+			changes = append(changes, fmt.Sprintf("%s = %s", setting.Path, formatSettingValue(setting.Type, setting.Value)))
+		}
+		return fmt.Sprintf("Set %s", strings.Join(changes, ", "))
+	case proposals.ProposalPayloadType_RewardsPercentages:
+		return fmt.Sprintf("Set RPL rewards to %s", formatRewardsPercentages(payload.RewardsPercentages))
+	case proposals.ProposalPayloadType_TreasurySpend:
+		if payload.NumberOfPeriods > 0 {
+			return fmt.Sprintf("Pay %.6f RPL every %s for %d periods to %s", eth.WeiToEth(payload.AmountPerPeriod), payload.PeriodLength, payload.NumberOfPeriods, payload.Recipient.Hex())
+		}
+		return fmt.Sprintf("Pay %.6f RPL to %s", eth.WeiToEth(payload.Amount), payload.Recipient.Hex())
+	case proposals.ProposalPayloadType_SecurityCouncil:
+		return fmt.Sprintf("Security council change (%s)", payload.Method)
+	default:
+		return payload.Method
+	}
+}
+
+// Print everything a proposal changes, with the current values of anything it replaces
+func printDecodedPayload(payload *proposals.ProposalPayload) {
+	fmt.Printf("Proposal type:          %s (%s)\n", payload.Type, payload.Method)
+	switch payload.Type {
+	case proposals.ProposalPayloadType_Settings:
+		for _, setting := range payload.Settings {
+			fmt.Printf("Setting:                %s.%s\n", setting.ContractName, setting.Path)
+			if setting.CurrentValue != "" {
+				fmt.Printf("    Current value:      %s\n", formatSettingValue(setting.Type, setting.CurrentValue))
+			}
+			fmt.Printf("    New value:          %s\n", formatSettingValue(setting.Type, setting.Value))
+		}
+
+	case proposals.ProposalPayloadType_RewardsPercentages:
+		if len(payload.CurrentRewardsPercentages) > 0 {
+			fmt.Printf("Current RPL rewards:    %s\n", formatRewardsPercentages(payload.CurrentRewardsPercentages))
+		}
+		fmt.Printf("New RPL rewards:        %s\n", formatRewardsPercentages(payload.RewardsPercentages))
+
+	case proposals.ProposalPayloadType_TreasurySpend:
+		fmt.Printf("Invoice / contract:     %s\n", payload.ContractName)
+		fmt.Printf("Recipient:              %s\n", payload.Recipient.Hex())
+		if payload.NumberOfPeriods > 0 {
+			fmt.Printf("Amount per period:      %.6f RPL\n", eth.WeiToEth(payload.AmountPerPeriod))
+			fmt.Printf("Period length:          %s\n", payload.PeriodLength)
+			fmt.Printf("Number of periods:      %d\n", payload.NumberOfPeriods)
+			if !payload.StartTime.IsZero() {
+				fmt.Printf("Start time:             %s\n", payload.StartTime.Format(time.RFC822))
+			}
+		}
+		fmt.Printf("Total amount:           %.6f RPL\n", eth.WeiToEth(payload.Amount))
+
+	case proposals.ProposalPayloadType_SecurityCouncil:
+		if payload.MemberID != "" {
+			fmt.Printf("Member ID:              %s\n", payload.MemberID)
+		}
+		for _, member := range payload.Members {
+			fmt.Printf("Member:                 %s\n", member.Hex())
+		}
+	}
+}
+
+// Format a setting value, showing large integers with 18 decimals as well
+func formatSettingValue(settingType types.ProposalSettingType, value string) string {
+	if settingType != types.ProposalSettingType_Uint256 {
+		return value
+	}
+	intValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || intValue.Cmp(decimalSettingThreshold) < 0 {
+		return value
+	}
+	return fmt.Sprintf("%s (%.6f with 18 decimals)", value, eth.WeiToEth(intValue))
+}
+
+// Format the oDAO, pDAO and node operator rewards percentages
+func formatRewardsPercentages(percentages []*big.Int) string {
+	if len(percentages) != 3 {
+		return "unknown"
+	}
+	return fmt.Sprintf("%.2f%% oDAO, %.2f%% pDAO, %.2f%% node operators", eth.WeiToEth(percentages[0])*100, eth.WeiToEth(percentages[1])*100, eth.WeiToEth(percentages[2])*100)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
 func filterProposalState(state string, stateFilter string) bool {
@@ -71,6 +72,18 @@ func getProposals(c *cli.Context, stateFilter string) error {
 	proposalStates := []string{"Pending", "Active (Phase 1)", "Active (Phase 2)", "Succeeded", "Executed", "Destroyed", "Vetoed", "Quorum not Met", "Defeated", "Expired"}
 	proposalStateInputs := []string{"pending", "phase1", "phase2", "succeeded", "executed", "destroyed", "vetoed", "quorum-not-met", "defeated", "expired"}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		filteredProposals := []api.PDAOProposalWithNodeVoteDirection{}
+		for i, stateName := range proposalStates {
+			if !filterProposalState(proposalStateInputs[i], stateFilter) {
+				filteredProposals = append(filteredProposals, stateProposals[stateName]...)
+			}
+		}
+		allProposals.Proposals = filteredProposals
+		return cliutils.PrintStructuredResponse(c, allProposals)
+	}
+
 	// Print & return
 	count := 0
 	for i, stateName := range proposalStates {
@@ -91,6 +104,9 @@ func getProposals(c *cli.Context, stateFilter string) error {
 		// Proposals
 		for _, proposal := range proposals {
 			fmt.Printf("%d: %s - Proposed by: %s\n", proposal.ID, proposal.Message, proposal.ProposerAddress)
+			if proposal.DecodedPayload != nil {
+				fmt.Printf("    %s\n", getPayloadSummary(proposal.DecodedPayload))
+			}
 		}
 
 		count += len(proposals)
@@ -143,11 +159,24 @@ func getProposal(c *cli.Context, id uint64) error {
 		return nil
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, proposal)
+	}
+
 	// Main details
 	fmt.Printf("Proposal ID:            %d\n", proposal.ID)
 	fmt.Printf("Message:                %s\n", proposal.Message)
 	fmt.Printf("Payload:                %s\n", proposal.PayloadStr)
 	fmt.Printf("Payload (bytes):        %s\n", hex.EncodeToString(proposal.Payload))
+	if proposal.DecodedPayload != nil {
+		printDecodedPayload(proposal.DecodedPayload)
+		if proposal.CurrentValueError != "" {
+			fmt.Printf("%sSome current values could not be loaded: %s%s\n", colorYellow, proposal.CurrentValueError, colorReset)
+		}
+	} else if proposal.PayloadError != "" {
+		fmt.Printf("Payload could not be decoded: %s\n", proposal.PayloadError)
+	}
 	fmt.Printf("Proposed by:            %s\n", proposal.ProposerAddress.Hex())
 	fmt.Printf("Created at:             %s\n", proposal.CreatedTime.Format(time.RFC822))
 	fmt.Printf("State:                  %s\n", types.ProtocolDaoProposalStates[proposal.State])
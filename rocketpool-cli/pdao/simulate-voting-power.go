@@ -0,0 +1,123 @@
+package pdao
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func simulateVotingPower(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check for Houston
+	houston, err := rp.IsHoustonDeployed()
+	if err != nil {
+		return fmt.Errorf("error checking if Houston has been deployed: %w", err)
+	}
+	if !houston.IsHoustonDeployed {
+		fmt.Println("This command cannot be used until Houston has been deployed.")
+		return nil
+	}
+
+	// Get the changes
+	blockNumber := c.Uint64("block")
+	if blockNumber > math.MaxUint32 {
+		return fmt.Errorf("Invalid block number '%d'", blockNumber)
+	}
+	stakeRpl := c.Float64("stake-rpl")
+	if stakeRpl < 0 {
+		return fmt.Errorf("Invalid RPL amount '%f' - must be 0 or more", stakeRpl)
+	}
+	bond := c.Float64("bond")
+	if bond != 8 && bond != 16 {
+		return fmt.Errorf("Invalid bond '%f' - new minipools must have a bond of 8 or 16 ETH", bond)
+	}
+	var newDelegators []common.Address
+	if c.String("delegators") != "" {
+		newDelegators, err = cliutils.ValidateAddresses("delegators", c.String("delegators"))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Run the simulation
+	response, err := rp.PDAOSimulateVotingPower(uint32(blockNumber), eth.EthToWei(stakeRpl), c.Uint64("add-minipools"), eth.EthToWei(bond), c.Uint64("reduce-bonds"), newDelegators)
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
+	// Print the current voting power
+	fmt.Printf("%s== Voting Power at Block %d ==%s\n", colorGreen, response.BlockNumber, colorReset)
+	fmt.Printf("RPL staked:            %.6f RPL\n", eth.WeiToEth(response.RplStake))
+	fmt.Printf("ETH matched:           %.6f ETH\n", eth.WeiToEth(response.EthMatched))
+	fmt.Printf("RPL price:             %.6f ETH\n", eth.WeiToEth(response.RplPrice))
+	fmt.Printf("Maximum counted stake: %.0f%% of matched ETH\n", eth.WeiToEth(response.MaxStakeFraction)*100)
+	fmt.Printf("Voting power:          %.10f\n", eth.WeiToEth(response.OnchainVotingPower))
+	if response.VotingPower.Cmp(response.OnchainVotingPower) != 0 {
+		fmt.Printf("%sThe calculated voting power (%.10f) doesn't match the voting power on-chain. If the node hasn't initialized voting yet, its on-chain voting power is 0 until it runs `rocketpool pdao initialize-voting`.%s\n", colorYellow, eth.WeiToEth(response.VotingPower), colorReset)
+	}
+	fmt.Println()
+
+	// Print the simulated voting power
+	fmt.Printf("%s== Simulated Voting Power ==%s\n", colorGreen, colorReset)
+	fmt.Printf("RPL staked:            %.6f RPL\n", eth.WeiToEth(response.SimulatedRplStake))
+	fmt.Printf("ETH matched:           %.6f ETH\n", eth.WeiToEth(response.SimulatedEthMatched))
+	fmt.Printf("Voting power:          %.10f (%s)\n", eth.WeiToEth(response.SimulatedVotingPower), formatVotingPowerChange(response.VotingPower, response.SimulatedVotingPower))
+	maxStake := big.NewInt(0)
+	if response.RplPrice.Sign() > 0 {
+		maxStake.Mul(response.SimulatedEthMatched, response.MaxStakeFraction)
+		maxStake.Div(maxStake, response.RplPrice)
+	}
+	if response.SimulatedRplStake.Cmp(maxStake) > 0 {
+		fmt.Printf("%sOnly %.6f RPL of the stake counts towards voting power; the rest is over the maximum for the node's matched ETH.%s\n", colorYellow, eth.WeiToEth(maxStake), colorReset)
+	}
+	fmt.Println()
+
+	// Print the delegated voting power
+	fmt.Printf("%s== Delegated Voting Power ==%s\n", colorGreen, colorReset)
+	for _, delegator := range response.NewDelegators {
+		fmt.Printf("New delegator:         %s%s%s (%.10f)\n", colorBlue, delegator.AddressFormatted, colorReset, eth.WeiToEth(delegator.VotingPower))
+	}
+	if !response.IsSelfDelegated {
+		fmt.Println("The node delegates its own voting power to another node, so it isn't included below.")
+	}
+	fmt.Printf("Current:               %.10f (%s of the network)\n", eth.WeiToEth(response.DelegatedVotingPower), formatVotingPowerShare(response.DelegatedVotingPower, response.TotalVotingPower))
+	fmt.Printf("Simulated:             %.10f (%s of the network)\n", eth.WeiToEth(response.SimulatedDelegatedVotingPower), formatVotingPowerShare(response.SimulatedDelegatedVotingPower, response.SimulatedTotalVotingPower))
+	return nil
+
+}
+
+// Format the change between two voting powers
+func formatVotingPowerChange(before *big.Int, after *big.Int) string {
+	change := eth.WeiToEth(after) - eth.WeiToEth(before)
+	if before.Sign() == 0 {
+		return fmt.Sprintf("%+.10f", change)
+	}
+	return fmt.Sprintf("%+.10f, %+.2f%%", change, change/eth.WeiToEth(before)*100)
+}
+
+// Format voting power as a percentage of the network's total
+func formatVotingPowerShare(votingPower *big.Int, total *big.Int) string {
+	if total.Sign() == 0 {
+		return "0.00%"
+	}
+	return fmt.Sprintf("%.4f%%", eth.WeiToEth(votingPower)/eth.WeiToEth(total)*100)
+}
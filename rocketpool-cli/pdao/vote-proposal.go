@@ -162,6 +162,9 @@ func voteOnProposal(c *cli.Context) error {
 	// Print the voting power
 	fmt.Printf("\n\nYour current voting power: %d\n\n", canVote.VotingPower)
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canVote.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canVote.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
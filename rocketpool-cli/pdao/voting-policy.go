@@ -0,0 +1,116 @@
+package pdao
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+func getVotingPolicy(c *cli.Context) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the config
+	cfg, _, err := rp.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("Error loading configuration: %w", err)
+	}
+
+	// Check for Houston
+	houston, err := rp.IsHoustonDeployed()
+	if err != nil {
+		return fmt.Errorf("error checking if Houston has been deployed: %w", err)
+	}
+	if !houston.IsHoustonDeployed {
+		fmt.Println("This command cannot be used until Houston has been deployed.")
+		return nil
+	}
+
+	// Evaluate the policy
+	response, err := rp.PDAOVotingPolicy()
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, response)
+	}
+
+	// Print the policy
+	policyPath := filepath.Join(cfg.Smartnode.DataPath.Value.(string), config.PdaoVotingPolicyFilename)
+	fmt.Printf("%s== Protocol DAO Voting Policy ==%s\n", colorGreen, colorReset)
+	modeName := fmt.Sprint(cfg.Smartnode.PdaoAutoVoteMode.Value)
+	for _, option := range cfg.Smartnode.PdaoAutoVoteMode.Options {
+		if option.Value == cfg.Smartnode.PdaoAutoVoteMode.Value {
+			modeName = option.Name
+		}
+	}
+	fmt.Printf("Mode:         %s (change it in `rocketpool service config`)\n", modeName)
+	fmt.Printf("Policy file:  %s\n", policyPath)
+	if !response.PolicyExists {
+		fmt.Println()
+		fmt.Println("You don't have a voting policy yet. Create the policy file with a default vote and a list of rules; the first rule that matches a proposal decides the vote. For example:")
+		fmt.Println()
+		fmt.Println("delegate: \"0x...\"")
+		fmt.Println("default: delegate")
+		fmt.Println("rules:")
+		fmt.Println("  - name: large treasury spends")
+		fmt.Println("    type: treasury-spend")
+		fmt.Println("    amountAbove: 10000")
+		fmt.Println("    vote: abstain")
+		fmt.Println("  - name: deposit settings")
+		fmt.Println("    type: settings")
+		fmt.Println("    contract: rocketDAOProtocolSettingsDeposit")
+		fmt.Println("    vote: against")
+		fmt.Println()
+		fmt.Printf("Rule types are %s, %s, %s, %s, %s, or any. Votes are for, against, abstain, veto, none, or delegate (copy the delegate's vote).\n",
+			proposals.ProposalPayloadType_Settings, proposals.ProposalPayloadType_TreasurySpend, proposals.ProposalPayloadType_RewardsPercentages, proposals.ProposalPayloadType_SecurityCouncil, proposals.ProposalPayloadType_Unknown)
+		return nil
+	}
+	if response.Policy.Delegate != "" {
+		fmt.Printf("Delegate:     %s\n", response.Policy.Delegate)
+	}
+	defaultVote := response.Policy.Default
+	if defaultVote == "" {
+		defaultVote = proposals.PolicyVote_None
+	}
+	fmt.Printf("Default vote: %s\n", defaultVote)
+	fmt.Printf("Rules:        %d\n", len(response.Policy.Rules))
+	fmt.Println()
+
+	// Print the decisions
+	fmt.Printf("%s== Proposals ==%s\n", colorGreen, colorReset)
+	if len(response.Proposals) == 0 {
+		fmt.Println("There are no proposals waiting for votes.")
+		return nil
+	}
+	for _, prop := range response.Proposals {
+		fmt.Printf("%d: %s - %s\n", prop.ID, prop.Message, types.ProtocolDaoProposalStates[prop.State])
+		if prop.Payload != nil {
+			fmt.Printf("    Type:        %s\n", prop.Payload.Type)
+			fmt.Printf("    Change:      %s\n", getPayloadSummary(prop.Payload))
+		} else {
+			fmt.Printf("    Type:        %s (%s)\n", proposals.ProposalPayloadType_Unknown, prop.PayloadError)
+		}
+		fmt.Printf("    Policy vote: %s%s%s (%s)\n", colorBlue, types.VoteDirections[prop.Decision.Vote], colorReset, prop.Decision.Reason)
+		if prop.NodeVoteDirection != types.VoteDirection_NoVote {
+			fmt.Printf("    The node has already voted %s.\n", types.VoteDirections[prop.NodeVoteDirection])
+		}
+		fmt.Println()
+	}
+	return nil
+
+}
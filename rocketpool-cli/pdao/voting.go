@@ -53,6 +53,9 @@ func pdaoSetVotingDelegate(c *cli.Context, nameOrAddress string) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(gasEstimate.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(gasEstimate.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -0,0 +1,88 @@
+package profile
+
+import (
+	"github.com/urfave/cli"
+
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Register commands
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	cliutils.RegisterStructuredOutput(name, "list")
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Manage the remote nodes this CLI can run commands against with --node",
+		Subcommands: []cli.Command{
+
+			{
+				Name:      "list",
+				Aliases:   []string{"l"},
+				Usage:     "List the saved node profiles",
+				UsageText: "rocketpool profile list",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return listProfiles(c)
+
+				},
+			},
+
+			{
+				Name:      "add",
+				Aliases:   []string{"a"},
+				Usage:     "Save a remote node that can be reached over SSH as a named profile",
+				UsageText: "rocketpool profile add [options] name user@host[:port]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "key, k",
+						Usage: "The `path` of the SSH private key to log in with (defaults to the keys in ssh-agent)",
+					},
+					cli.StringFlag{
+						Name:  "remote-config-path",
+						Usage: "The Rocket Pool config `path` on the remote node",
+						Value: "~/.rocketpool",
+					},
+					cli.StringFlag{
+						Name:  "remote-daemon-path",
+						Usage: "The `path` of the Rocket Pool daemon on the remote node, if it runs outside of docker",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+
+					// Run
+					return addProfile(c, c.Args().Get(0), c.Args().Get(1))
+
+				},
+			},
+
+			{
+				Name:      "remove",
+				Aliases:   []string{"r"},
+				Usage:     "Remove a saved node profile",
+				UsageText: "rocketpool profile remove name",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return removeProfile(c, c.Args().Get(0))
+
+				},
+			},
+		},
+	})
+}
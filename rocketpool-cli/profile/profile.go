@@ -0,0 +1,122 @@
+package profile
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Config
+const (
+	colorReset string = "\033[0m"
+	colorGreen string = "\033[32m"
+	colorRed   string = "\033[31m"
+)
+
+func listProfiles(c *cli.Context) error {
+
+	// Load the profiles
+	profiles, err := rocketpool.LoadNodeProfiles(os.ExpandEnv(c.GlobalString("config-path")))
+	if err != nil {
+		return err
+	}
+
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, profiles)
+	}
+
+	if len(profiles) == 0 {
+		fmt.Println("There are no node profiles yet. You can add one with `rocketpool profile add <name> <user@host>`.")
+		return nil
+	}
+
+	// Print them
+	for _, profile := range profiles {
+		fmt.Printf("%s%s%s\n", colorGreen, profile.Name, colorReset)
+		fmt.Printf("\tHost:        %s\n", profile.Host)
+		if profile.KeyPath != "" {
+			fmt.Printf("\tKey:         %s\n", profile.KeyPath)
+		}
+		if profile.ConfigPath != "" {
+			fmt.Printf("\tConfig path: %s\n", profile.ConfigPath)
+		}
+		if profile.DaemonPath != "" {
+			fmt.Printf("\tDaemon path: %s\n", profile.DaemonPath)
+		}
+	}
+	fmt.Println()
+	fmt.Println("Run a command against one of them with `rocketpool --node <name> ...`, or against all of them with `rocketpool --node all ...`.")
+	return nil
+
+}
+
+func addProfile(c *cli.Context, name string, host string) error {
+
+	// Check the name
+	if name == rocketpool.AllNodeProfiles || strings.ContainsAny(name, ", ") {
+		return fmt.Errorf("'%s' can't be used as a profile name; names can't be '%s' or contain commas or spaces", name, rocketpool.AllNodeProfiles)
+	}
+	if !strings.Contains(host, "@") {
+		return fmt.Errorf("host '%s' must be in the format user@host[:port]", host)
+	}
+
+	// Load the profiles
+	configPath := os.ExpandEnv(c.GlobalString("config-path"))
+	profiles, err := rocketpool.LoadNodeProfiles(configPath)
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		if profile.Name == name {
+			return fmt.Errorf("a node profile named '%s' already exists; remove it first if you want to replace it", name)
+		}
+	}
+
+	// Save the new one
+	profiles = append(profiles, rocketpool.NodeProfile{
+		Name:       name,
+		Host:       host,
+		KeyPath:    c.String("key"),
+		ConfigPath: c.String("remote-config-path"),
+		DaemonPath: c.String("remote-daemon-path"),
+	})
+	if err := rocketpool.SaveNodeProfiles(configPath, profiles); err != nil {
+		return err
+	}
+	fmt.Printf("Added node profile '%s'. Make sure %s is in your known_hosts file by connecting to it with ssh once.\n", name, host)
+	return nil
+
+}
+
+func removeProfile(c *cli.Context, name string) error {
+
+	// Load the profiles
+	configPath := os.ExpandEnv(c.GlobalString("config-path"))
+	profiles, err := rocketpool.LoadNodeProfiles(configPath)
+	if err != nil {
+		return err
+	}
+
+	// Remove the matching one
+	remaining := []rocketpool.NodeProfile{}
+	for _, profile := range profiles {
+		if profile.Name != name {
+			remaining = append(remaining, profile)
+		}
+	}
+	if len(remaining) == len(profiles) {
+		return fmt.Errorf("there is no node profile named '%s'", name)
+	}
+	if err := rocketpool.SaveNodeProfiles(configPath, remaining); err != nil {
+		return err
+	}
+	fmt.Printf("Removed node profile '%s'.\n", name)
+	return nil
+
+}
@@ -0,0 +1,92 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Wrap every command's action so it runs once for each node profile selected with --node
+func WrapCommands(commands []cli.Command) {
+	for i := range commands {
+		command := &commands[i]
+		if action, ok := command.Action.(func(*cli.Context) error); ok {
+			command.Action = wrapAction(action)
+		}
+		WrapCommands(command.Subcommands)
+	}
+}
+
+// Wrap a command's action so it runs against the selected node profiles
+func wrapAction(action func(*cli.Context) error) func(*cli.Context) error {
+	return func(c *cli.Context) error {
+		profiles := rocketpool.GetSelectedNodeProfiles(c.App.Metadata)
+		if len(profiles) == 0 {
+			return action(c)
+		}
+		defer rocketpool.SetActiveNodeProfile(c.App.Metadata, nil)
+		if len(profiles) == 1 {
+			rocketpool.SetActiveNodeProfile(c.App.Metadata, &profiles[0])
+			return action(c)
+		}
+		return runOnNodes(c, profiles, action)
+	}
+}
+
+// Run a command against several nodes and combine their output
+func runOnNodes(c *cli.Context, profiles []rocketpool.NodeProfile, action func(*cli.Context) error) error {
+
+	// Structured responses and commands with a combined table are collected instead of printed per node
+	structured := cliutils.IsStructuredOutput(c)
+	printTable, hasTable := cliutils.GetFleetTable(c.Command.FullName())
+	collect := structured || hasTable
+
+	// Run the command on each node
+	responses := []cliutils.FleetResponse{}
+	failed := 0
+	for i := range profiles {
+		profile := profiles[i]
+		rocketpool.SetActiveNodeProfile(c.App.Metadata, &profile)
+
+		if collect {
+			cliutils.StartCollectingFleetResponse(c)
+			err := action(c)
+			response := cliutils.FleetResponse{
+				Node:     profile.Name,
+				Response: cliutils.StopCollectingFleetResponse(c),
+			}
+			if err != nil {
+				response.Error = err.Error()
+				failed++
+			}
+			responses = append(responses, response)
+			continue
+		}
+
+		fmt.Printf("%s=== %s (%s) ===%s\n", colorGreen, profile.Name, profile.Host, colorReset)
+		if err := action(c); err != nil {
+			fmt.Printf("%sError on %s: %s%s\n", colorRed, profile.Name, err.Error(), colorReset)
+			failed++
+		}
+		fmt.Println()
+	}
+	rocketpool.SetActiveNodeProfile(c.App.Metadata, nil)
+
+	// Print the combined output; per-node errors are part of a structured response
+	if structured {
+		return cliutils.PrintStructuredResponse(c, responses)
+	}
+	if hasTable {
+		if err := printTable(c, responses); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("the command failed on %d of %d nodes", failed, len(profiles))
+	}
+	return nil
+
+}
@@ -32,6 +32,9 @@ func processQueue(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canProcess.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canProcess.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
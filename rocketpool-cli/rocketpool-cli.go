@@ -13,11 +13,14 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool-cli/node"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/odao"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/pdao"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/profile"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/queue"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/security"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/service"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/wallet"
 	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -96,10 +99,31 @@ ______           _        _    ______           _
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.StringFlag{
+			Name:  "offline-export",
+			Usage: "Build the transaction without signing or sending it, and write it to this `file` so it can be signed offline with `rocketpool wallet sign-tx`. The node wallet isn't needed on this machine if its watch-only address is set to the node address.",
+		},
+		cli.Float64Flag{
+			Name:  "when-gas-below",
+			Usage: "Don't send the transaction now; queue it for the node daemon to send once the network's base fee drops below this many `gwei`",
+		},
+		cli.StringFlag{
+			Name:  "not-after",
+			Usage: "Don't send the transaction now; queue it for the node daemon to send at this `time` (RFC 3339 or local 'YYYY-MM-DD HH:MM'), or by then at the latest if --when-gas-below is also set",
+		},
+		cli.StringFlag{
+			Name:  "node",
+			Usage: "Run the command against a saved node profile over SSH instead of the local node; use a `name`, a comma-separated list of names, or 'all'",
+		},
 		cli.BoolFlag{
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
 		},
+		cli.StringFlag{
+			Name:  "output, o",
+			Usage: "The `format` to print command output in: 'text' (default), 'json', or 'yaml'. Structured formats are supported by the status and query commands; other commands fail if one is selected.",
+			Value: string(api.OutputFormat_Text),
+		},
 		cli.BoolFlag{
 			Name: "secure-session, s",
 			Usage: "Some commands may print sensitive information to your terminal. " +
@@ -108,16 +132,16 @@ ______           _        _    ______           _
 	}
 
 	// Register commands
-	auction.RegisterCommands(app, "auction", []string{"a"})
-	minipool.RegisterCommands(app, "minipool", []string{"m"})
-	network.RegisterCommands(app, "network", []string{"e"})
-	node.RegisterCommands(app, "node", []string{"n"})
-	odao.RegisterCommands(app, "odao", []string{"o"})
-	pdao.RegisterCommands(app, "pdao", []string{"p"})
-	queue.RegisterCommands(app, "queue", []string{"q"})
-	security.RegisterCommands(app, "security", []string{"c"})
-	service.RegisterCommands(app, "service", []string{"s"})
-	wallet.RegisterCommands(app, "wallet", []string{"w"})
+	registerCommands(app)
+
+	// Let every command run against remote node profiles
+	profile.WrapCommands(app.Commands)
+
+	// Reject structured output formats for commands that only print text
+	cliutils.RequireStructuredOutputSupport(app.Commands)
+
+	// The global context, if the command was run with a structured output format
+	var structuredOutputContext *cli.Context
 
 	app.Before = func(c *cli.Context) error {
 		// Check user ID
@@ -127,6 +151,12 @@ ______           _        _    ______           _
 			os.Exit(1)
 		}
 
+		// Validate the output format
+		if _, err := cliutils.ValidateOutputFormat("output format", c.GlobalString("output")); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
 		// If set, validate custom nonce
 		customNonce := c.GlobalString("nonce")
 		if customNonce != "" {
@@ -140,14 +170,77 @@ ______           _        _    ______           _
 			c.App.Metadata["nonce"] = nonce
 		}
 
+		// If set, validate the deferred transaction conditions
+		notAfter := c.GlobalString("not-after")
+		if notAfter != "" {
+			deadline, err := cliutils.ValidateTime("deadline", notAfter)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			c.App.Metadata["not-after"] = deadline
+		}
+		if c.GlobalFloat64("when-gas-below") < 0 {
+			fmt.Fprintln(os.Stderr, "Invalid gas target: must be a positive number of gwei")
+			os.Exit(1)
+		}
+		if (notAfter != "" || c.GlobalFloat64("when-gas-below") > 0) && c.GlobalString("offline-export") != "" {
+			fmt.Fprintln(os.Stderr, "Transactions can't be exported for offline signing and deferred at the same time.")
+			os.Exit(1)
+		}
+
+		// If set, select the node profiles to run the command against
+		nodeSelector := c.GlobalString("node")
+		if nodeSelector != "" {
+			switch c.Args().First() {
+			case "service", "s", "profile", "f":
+				fmt.Fprintln(os.Stderr, "The --node flag can't be used with service or profile commands; run service commands on the node itself.")
+				os.Exit(1)
+			}
+			profiles, err := rocketpool.LoadNodeProfiles(os.ExpandEnv(c.GlobalString("config-path")))
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			selected, err := rocketpool.SelectNodeProfiles(profiles, nodeSelector)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			rocketpool.SetSelectedNodeProfiles(c.App.Metadata, selected)
+		}
+
+		if cliutils.IsStructuredOutput(c) {
+			structuredOutputContext = c
+		}
+
 		return nil
 	}
 
 	// Run application
 	fmt.Println("")
 	if err := app.Run(os.Args); err != nil {
-		cliutils.PrettyPrintError(err)
+		if structuredOutputContext != nil {
+			cliutils.PrintStructuredError(structuredOutputContext, err)
+		} else {
+			cliutils.PrettyPrintError(err)
+		}
 	}
 	fmt.Println("")
 
 }
+
+// Register the commands of each package
+func registerCommands(app *cli.App) {
+	auction.RegisterCommands(app, "auction", []string{"a"})
+	minipool.RegisterCommands(app, "minipool", []string{"m"})
+	network.RegisterCommands(app, "network", []string{"e"})
+	node.RegisterCommands(app, "node", []string{"n"})
+	odao.RegisterCommands(app, "odao", []string{"o"})
+	pdao.RegisterCommands(app, "pdao", []string{"p"})
+	profile.RegisterCommands(app, "profile", []string{"f"})
+	queue.RegisterCommands(app, "queue", []string{"q"})
+	security.RegisterCommands(app, "security", []string{"c"})
+	service.RegisterCommands(app, "service", []string{"s"})
+	wallet.RegisterCommands(app, "wallet", []string{"w"})
+}
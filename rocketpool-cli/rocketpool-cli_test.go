@@ -0,0 +1,166 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli"
+
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Every command whose action can print a structured response must be registered for structured output, and vice versa
+func TestStructuredOutputRegistration(t *testing.T) {
+	app := cli.NewApp()
+	registerCommands(app)
+
+	for _, command := range app.Commands {
+		pkg := parsePackage(t, command.Name)
+		registerFunc, ok := pkg.funcs["RegisterCommands"]
+		if !ok {
+			t.Fatalf("package %s doesn't have a RegisterCommands function", command.Name)
+		}
+
+		// Find the top-level command in the package's RegisterCommands function
+		ast.Inspect(registerFunc.Body, func(node ast.Node) bool {
+			literal, ok := node.(*ast.CompositeLit)
+			if !ok || !isCommandType(literal.Type) {
+				return true
+			}
+			pkg.checkCommand(t, literal, "")
+			return false
+		})
+	}
+}
+
+// The top-level functions of a CLI package
+type cliPackage struct {
+	name      string
+	funcs     map[string]*ast.FuncDecl
+	reachable map[string]bool
+}
+
+// Parse the source files of a CLI package
+func parsePackage(t *testing.T, name string) *cliPackage {
+	entries, err := os.ReadDir(name)
+	if err != nil {
+		t.Fatalf("error reading package %s: %s", name, err.Error())
+	}
+	pkg := &cliPackage{
+		name:      name,
+		funcs:     map[string]*ast.FuncDecl{},
+		reachable: map[string]bool{},
+	}
+	fileSet := token.NewFileSet()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, filepath.Join(name, entry.Name()), nil, 0)
+		if err != nil {
+			t.Fatalf("error parsing %s: %s", entry.Name(), err.Error())
+		}
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+				pkg.funcs[funcDecl.Name.Name] = funcDecl
+			}
+		}
+	}
+	return pkg
+}
+
+// Check a command literal and its subcommands against the structured output registrations
+func (pkg *cliPackage) checkCommand(t *testing.T, literal *ast.CompositeLit, parentPath string) {
+	var name string
+	var action ast.Expr
+	var subcommands *ast.CompositeLit
+	for _, element := range literal.Elts {
+		field, ok := element.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		switch field.Key.(*ast.Ident).Name {
+		case "Name":
+			if value, ok := field.Value.(*ast.BasicLit); ok {
+				name, _ = strconv.Unquote(value.Value)
+			}
+		case "Action":
+			action = field.Value
+		case "Subcommands":
+			subcommands, _ = field.Value.(*ast.CompositeLit)
+		}
+	}
+
+	// The top-level command is named by the caller of RegisterCommands
+	path := pkg.name
+	if parentPath != "" {
+		path = parentPath + " " + name
+	}
+
+	if action != nil {
+		printsStructured := pkg.reaches(action, map[string]bool{})
+		registered := cliutils.SupportsStructuredOutput(path)
+		if printsStructured && !registered {
+			t.Errorf("'%s' can print a structured response but isn't registered for structured output", path)
+		}
+		if !printsStructured && registered {
+			t.Errorf("'%s' is registered for structured output but never prints a structured response", path)
+		}
+	}
+
+	if subcommands != nil {
+		for _, element := range subcommands.Elts {
+			if subcommand, ok := element.(*ast.CompositeLit); ok {
+				pkg.checkCommand(t, subcommand, path)
+			}
+		}
+	}
+}
+
+// Check if a node calls IsStructuredOutput, directly or through the package's other functions
+func (pkg *cliPackage) reaches(node ast.Node, visited map[string]bool) bool {
+	found := false
+	ast.Inspect(node, func(node ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := node.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok && x.Name == "cliutils" && n.Sel.Name == "IsStructuredOutput" {
+				found = true
+			}
+			return false
+		case *ast.Ident:
+			if reachable, ok := pkg.reachable[n.Name]; ok {
+				found = reachable
+				return false
+			}
+			funcDecl, ok := pkg.funcs[n.Name]
+			if !ok || visited[n.Name] {
+				return false
+			}
+			visited[n.Name] = true
+			found = pkg.reaches(funcDecl.Body, visited)
+			pkg.reachable[n.Name] = found
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// Check if a type expression is cli.Command
+func isCommandType(expr ast.Expr) bool {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	x, ok := selector.X.(*ast.Ident)
+	return ok && x.Name == "cli" && selector.Sel.Name == "Command"
+}
@@ -15,6 +15,8 @@ const (
 
 // Register commands
 func RegisterCommands(app *cli.App, name string, aliases []string) {
+	cliutils.RegisterStructuredOutput(name, "status", "members")
+	cliutils.RegisterStructuredOutput(name+" proposals", "list", "details")
 	app.Commands = append(app.Commands, cli.Command{
 		Name:    name,
 		Aliases: aliases,
@@ -45,6 +45,9 @@ func join(c *cli.Context) error {
 		return nil
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canJoin.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canJoin.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
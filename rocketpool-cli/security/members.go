@@ -34,6 +34,11 @@ func getMembers(c *cli.Context) error {
 		return err
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, members)
+	}
+
 	// Print & return
 	if len(members.Members) > 0 {
 		fmt.Printf("The security council has %d members:\n", len(members.Members))
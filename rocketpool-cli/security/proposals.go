@@ -77,6 +77,18 @@ func getProposals(c *cli.Context, stateFilter string) error {
 	proposalStates := []string{"Pending", "Active", "Succeeded", "Executed", "Cancelled", "Defeated", "Expired"}
 	proposalStateInputs := []string{"pending", "active", "succeeded", "executed", "cancelled", "defeated", "expired"}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		filteredProposals := []dao.ProposalDetails{}
+		for i, stateName := range proposalStates {
+			if !filterProposalState(proposalStateInputs[i], stateFilter) {
+				filteredProposals = append(filteredProposals, stateProposals[stateName]...)
+			}
+		}
+		allProposals.Proposals = filteredProposals
+		return cliutils.PrintStructuredResponse(c, allProposals)
+	}
+
 	// Print & return
 	count := 0
 	for i, stateName := range proposalStates {
@@ -172,6 +184,11 @@ func getProposal(c *cli.Context, id uint64) error {
 		return nil
 	}
 
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, proposal)
+	}
+
 	// Main details
 	fmt.Printf("Proposal ID:          %d\n", proposal.ID)
 	fmt.Printf("Message:              %s\n", proposal.Message)
@@ -41,6 +41,9 @@ func proposeLeave(c *cli.Context) error {
 		}
 		return nil
 	}
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -101,6 +101,9 @@ func proposeSetting(c *cli.Context, contract string, setting string, value strin
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(canPropose.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(canPropose.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
@@ -19,6 +19,9 @@ var alertingParametersNativeMode map[string]interface{} = map[string]interface{}
 	"alertEnabled_MinipoolStaked":              nil,
 	"alertEnabled_ExecutionClientSyncComplete": nil,
 	"alertEnabled_BeaconClientSyncComplete":    nil,
+	"alertEnabled_MissedAttestation":           nil,
+	"alertEnabled_MissedProposal":              nil,
+	"alertEnabled_ValidatorBalanceDecreased":   nil,
 }
 
 var alertingParametersDockerMode map[string]interface{} = map[string]interface{}{
@@ -27,6 +30,27 @@ var alertingParametersDockerMode map[string]interface{} = map[string]interface{}
 	"openPort":                                 nil,
 	"containerTag":                             nil,
 	"discordWebhookURL":                        nil,
+	"discordMinSeverity":                       nil,
+	"telegramBotToken":                         nil,
+	"telegramChatID":                           nil,
+	"telegramMinSeverity":                      nil,
+	"slackWebhookURL":                          nil,
+	"slackChannel":                             nil,
+	"slackMinSeverity":                         nil,
+	"webhookURL":                               nil,
+	"webhookMinSeverity":                       nil,
+	"emailTo":                                  nil,
+	"emailFrom":                                nil,
+	"emailSmarthost":                           nil,
+	"emailUsername":                            nil,
+	"emailPassword":                            nil,
+	"emailMinSeverity":                         nil,
+	"pushoverUserKey":                          nil,
+	"pushoverToken":                            nil,
+	"pushoverMinSeverity":                      nil,
+	"ntfyServerURL":                            nil,
+	"ntfyTopic":                                nil,
+	"ntfyMinSeverity":                          nil,
 	"alertEnabled_ClientSyncStatusBeacon":      nil,
 	"alertEnabled_UpcomingSyncCommittee":       nil,
 	"alertEnabled_ActiveSyncCommittee":         nil,
@@ -43,6 +67,9 @@ var alertingParametersDockerMode map[string]interface{} = map[string]interface{}
 	"alertEnabled_MinipoolStaked":              nil,
 	"alertEnabled_ExecutionClientSyncComplete": nil,
 	"alertEnabled_BeaconClientSyncComplete":    nil,
+	"alertEnabled_MissedAttestation":           nil,
+	"alertEnabled_MissedProposal":              nil,
+	"alertEnabled_ValidatorBalanceDecreased":   nil,
 }
 
 // The page wrapper for the alerting config
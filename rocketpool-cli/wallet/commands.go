@@ -10,6 +10,7 @@ import (
 
 // Register commands
 func RegisterCommands(app *cli.App, name string, aliases []string) {
+	cliutils.RegisterStructuredOutput(name, "status")
 	app.Commands = append(app.Commands, cli.Command{
 		Name:    name,
 		Aliases: aliases,
@@ -213,6 +214,32 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "sign-tx",
+				Usage:     "Sign a transaction that was exported with the `--offline-export` flag, so it can be broadcast from another machine",
+				UsageText: "rocketpool wallet sign-tx [options] exported-tx-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "signed-tx-file, f",
+						Usage: "The `file` to write the signed transaction to (defaults to the exported transaction file with a .signed suffix)",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the transaction",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return signTransaction(c, c.Args().Get(0))
+
+				},
+			},
 			{
 				Name:      "set-ens-name",
 				Aliases:   []string{"ens"},
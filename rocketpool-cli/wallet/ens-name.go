@@ -26,6 +26,9 @@ func setEnsName(c *cli.Context, name string) error {
 		return err
 	}
 
+	// Print the simulation results
+	cliutils.PrintTransactionSimulation(estimateGasSetName.Simulation)
+
 	// Assign max fees
 	err = gas.AssignMaxFeeAndLimit(estimateGasSetName.GasInfo, rp, c.Bool("yes"))
 	if err != nil {
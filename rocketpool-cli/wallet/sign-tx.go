@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+)
+
+// Config
+const signedTxFileMode os.FileMode = 0644
+
+func signTransaction(c *cli.Context, exportedTxPath string) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Read the exported transaction
+	exportedTx, err := os.ReadFile(exportedTxPath)
+	if err != nil {
+		return fmt.Errorf("Could not read the exported transaction from %s: %w", exportedTxPath, err)
+	}
+	var offlineTx api.OfflineTransaction
+	if err := json.Unmarshal(exportedTx, &offlineTx); err != nil {
+		return fmt.Errorf("Could not decode the exported transaction: %w", err)
+	}
+	tx := offlineTx.Transaction
+	if tx == nil {
+		return fmt.Errorf("%s doesn't contain a transaction", exportedTxPath)
+	}
+
+	// Print the transaction details
+	to := "<contract creation>"
+	if tx.To() != nil {
+		to = tx.To().Hex()
+	}
+	fmt.Println("Transaction details:")
+	fmt.Printf("\tFrom:                %s\n", offlineTx.From.Hex())
+	fmt.Printf("\tTo:                  %s\n", to)
+	fmt.Printf("\tValue:               %.6f ETH\n", eth.WeiToEth(tx.Value()))
+	fmt.Printf("\tNonce:               %d\n", tx.Nonce())
+	fmt.Printf("\tGas limit:           %d\n", tx.Gas())
+	fmt.Printf("\tMax fee:             %.2f gwei\n", eth.WeiToGwei(tx.GasFeeCap()))
+	fmt.Printf("\tMax priority fee:    %.2f gwei\n", eth.WeiToGwei(tx.GasTipCap()))
+	fmt.Printf("\tChain ID:            %s\n", tx.ChainId().String())
+	fmt.Printf("\tData:                %d bytes\n", len(tx.Data()))
+	maxCost := new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas()))
+	fmt.Printf("\tMax transaction fee: %.6f ETH\n\n", eth.WeiToEth(maxCost))
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || cliutils.Confirm("Are you sure you want to sign this transaction?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign it
+	response, err := rp.SignTransaction(string(exportedTx))
+	if err != nil {
+		return err
+	}
+
+	// Write it
+	signedTxPath := c.String("signed-tx-file")
+	if signedTxPath == "" {
+		signedTxPath = exportedTxPath + ".signed"
+	}
+	if err := os.WriteFile(signedTxPath, []byte(response.SignedTx), signedTxFileMode); err != nil {
+		return fmt.Errorf("Could not write the signed transaction to %s: %w", signedTxPath, err)
+	}
+
+	// Log & return
+	fmt.Printf("The signed transaction (hash %s) has been written to %s.\n", response.TxHash.Hex(), signedTxPath)
+	fmt.Printf("Copy it back to your online machine and submit it with `rocketpool node broadcast-tx %s`.\n", signedTxPath)
+	return nil
+
+}
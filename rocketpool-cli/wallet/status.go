@@ -21,22 +21,34 @@ func getStatus(c *cli.Context) error {
 		return fmt.Errorf("Error loading configuration: %w", err)
 	}
 
-	// Print what network we're on
-	err = cliutils.PrintNetwork(cfg.GetNetwork(), isNew)
+	// Get wallet status
+	status, err := rp.WalletStatus()
 	if err != nil {
 		return err
 	}
 
-	// Get wallet status
-	status, err := rp.WalletStatus()
+	// Print the structured response if requested
+	if cliutils.IsStructuredOutput(c) {
+		return cliutils.PrintStructuredResponse(c, status)
+	}
+
+	// Print what network we're on
+	err = cliutils.PrintNetwork(cfg.GetNetwork(), isNew)
 	if err != nil {
 		return err
 	}
 
 	// Print status & return
-	if status.WalletInitialized {
+	if status.WatchOnly {
+		fmt.Println("The node is in watch-only mode; it has no wallet and can't sign transactions or messages.")
+		fmt.Printf("Watched node account: %s\n", status.AccountAddress.Hex())
+	} else if status.WalletInitialized {
 		fmt.Println("The node wallet is initialized.")
 		fmt.Printf("Node account: %s\n", status.AccountAddress.Hex())
+		if status.AccountExternal {
+			fmt.Println("The node account is held by an external signer; manual transactions will be sent to it for signing.")
+			fmt.Printf("Automated tasks that anyone can do will be sent from the delegated hot key %s, which needs ETH for gas. You'll be alerted to do the tasks only the node account can do manually from the CLI.\n", status.DelegateAddress.Hex())
+		}
 	} else {
 		fmt.Println("The node wallet has not been initialized.")
 	}
@@ -94,7 +94,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 			}
 
 			// Run
-			api.PrintResponse(waitForTransaction(c, hash))
+			api.GetResponsePrinter(c).PrintResponse(waitForTransaction(c, hash))
 			return nil
 		},
 	})
@@ -17,6 +17,9 @@ import (
 func canBidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.CanBidOnLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -93,6 +96,9 @@ func canBidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.CanB
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanBid = !(response.DoesNotExist || response.BiddingEnded || response.RPLExhausted || response.BidOnLotDisabled)
 	return &response, nil
@@ -102,6 +108,9 @@ func canBidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.CanB
 func bidOnLot(c *cli.Context, lotIndex uint64, amountWei *big.Int) (*api.BidOnLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -16,6 +16,9 @@ import (
 func canClaimFromLot(c *cli.Context, lotIndex uint64) (*api.CanClaimFromLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -86,6 +89,9 @@ func canClaimFromLot(c *cli.Context, lotIndex uint64) (*api.CanClaimFromLotRespo
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanClaim = !(response.DoesNotExist || response.NoBidFromAddress || response.NotCleared)
 	return &response, nil
@@ -95,6 +101,9 @@ func canClaimFromLot(c *cli.Context, lotIndex uint64) (*api.CanClaimFromLotRespo
 func claimFromLot(c *cli.Context, lotIndex uint64) (*api.ClaimFromLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -47,7 +47,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getLots(c))
+					api.GetResponsePrinter(c).PrintResponse(getLots(c))
 					return nil
 
 				},
@@ -65,7 +65,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canCreateLot(c))
+					api.GetResponsePrinter(c).PrintResponse(canCreateLot(c))
 					return nil
 
 				},
@@ -83,7 +83,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(createLot(c))
+					api.GetResponsePrinter(c).PrintResponse(createLot(c))
 					return nil
 
 				},
@@ -109,7 +109,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canBidOnLot(c, lotIndex, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(canBidOnLot(c, lotIndex, amountWei))
 					return nil
 
 				},
@@ -135,7 +135,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(bidOnLot(c, lotIndex, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(bidOnLot(c, lotIndex, amountWei))
 					return nil
 
 				},
@@ -157,7 +157,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canClaimFromLot(c, lotIndex))
+					api.GetResponsePrinter(c).PrintResponse(canClaimFromLot(c, lotIndex))
 					return nil
 
 				},
@@ -179,7 +179,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(claimFromLot(c, lotIndex))
+					api.GetResponsePrinter(c).PrintResponse(claimFromLot(c, lotIndex))
 					return nil
 
 				},
@@ -201,7 +201,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canRecoverRplFromLot(c, lotIndex))
+					api.GetResponsePrinter(c).PrintResponse(canRecoverRplFromLot(c, lotIndex))
 					return nil
 
 				},
@@ -223,7 +223,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(recoverRplFromLot(c, lotIndex))
+					api.GetResponsePrinter(c).PrintResponse(recoverRplFromLot(c, lotIndex))
 					return nil
 
 				},
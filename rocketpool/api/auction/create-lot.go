@@ -16,6 +16,9 @@ import (
 func canCreateLot(c *cli.Context) (*api.CanCreateLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -73,6 +76,9 @@ func canCreateLot(c *cli.Context) (*api.CanCreateLotResponse, error) {
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanCreate = !(response.InsufficientBalance || response.CreateLotDisabled)
 	return &response, nil
@@ -82,6 +88,9 @@ func canCreateLot(c *cli.Context) (*api.CanCreateLotResponse, error) {
 func createLot(c *cli.Context) (*api.CreateLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -16,6 +16,9 @@ import (
 func canRecoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.CanRecoverRPLFromLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -91,6 +94,9 @@ func canRecoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.CanRecoverRPLFr
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanRecover = !(response.DoesNotExist || response.BiddingNotEnded || response.NoUnclaimedRPL || response.RPLAlreadyRecovered)
 	return &response, nil
@@ -100,6 +106,9 @@ func canRecoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.CanRecoverRPLFr
 func recoverRplFromLot(c *cli.Context, lotIndex uint64) (*api.RecoverRPLFromLotResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
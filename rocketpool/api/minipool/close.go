@@ -24,6 +24,9 @@ import (
 func getMinipoolCloseDetailsForNode(c *cli.Context) (*api.GetMinipoolCloseDetailsForNodeResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -131,11 +134,41 @@ func getMinipoolCloseDetailsForNode(c *cli.Context) (*api.GetMinipoolCloseDetail
 		}
 	}
 
+	// Simulate closing the minipools that can be closed
+	nodeAddresses := eth1.GetSimulationAddresses(rp, nodeAccount.Address)
+	for i, mp := range details {
+		if !mp.CanClose {
+			continue
+		}
+		details[i].Simulation, err = simulateMinipoolClose(c, rp, mp, nodeAddresses, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	response.Details = details
 	return &response, nil
 
 }
 
+// Simulate closing a minipool with the same method its gas was estimated for
+func simulateMinipoolClose(c *cli.Context, rp *rocketpool.RocketPool, details api.MinipoolCloseDetails, nodeAddresses []eth1.SimulationAddress, opts *bind.TransactOpts) (*api.TransactionSimulation, error) {
+	mp, err := minipool.NewMinipool(rp, details.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	addresses := append([]eth1.SimulationAddress{{Address: details.Address, Label: "Minipool"}}, nodeAddresses...)
+
+	switch {
+	case details.MinipoolStatus == types.Dissolved:
+		return eth1.SimulateTransaction(c, rp, opts, mp.GetContract(), addresses, "close"), nil
+	case details.Distributed:
+		return eth1.SimulateTransaction(c, rp, opts, mp.GetContract(), addresses, "finalise"), nil
+	default:
+		return eth1.SimulateTransaction(c, rp, opts, mp.GetContract(), addresses, "distributeBalance", false), nil
+	}
+}
+
 func getMinipoolCloseDetails(rp *rocketpool.RocketPool, minipoolAddress common.Address, nodeAddress common.Address, opts *bind.TransactOpts) (api.MinipoolCloseDetails, error) {
 
 	// Create minipool
@@ -304,6 +337,9 @@ func getMinipoolCloseDetails(rp *rocketpool.RocketPool, minipoolAddress common.A
 func closeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CloseMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
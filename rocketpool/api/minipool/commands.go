@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -50,7 +50,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canStakeMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canStakeMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -72,7 +72,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(stakeMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(stakeMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -94,7 +94,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canPromoteMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canPromoteMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -115,7 +115,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(promoteMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(promoteMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -137,7 +137,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canRefundMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canRefundMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -159,7 +159,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(refundMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(refundMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -181,7 +181,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDissolveMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canDissolveMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -203,7 +203,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(dissolveMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(dissolveMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -225,7 +225,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExitMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canExitMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -247,7 +247,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(exitMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(exitMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -265,7 +265,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getMinipoolCloseDetailsForNode(c))
+					api.GetResponsePrinter(c).PrintResponse(getMinipoolCloseDetailsForNode(c))
 					return nil
 
 				},
@@ -287,7 +287,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(closeMinipool(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(closeMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -309,7 +309,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDelegateUpgrade(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canDelegateUpgrade(c, minipoolAddress))
 					return nil
 
 				},
@@ -330,7 +330,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(delegateUpgrade(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(delegateUpgrade(c, minipoolAddress))
 					return nil
 
 				},
@@ -352,7 +352,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDelegateRollback(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canDelegateRollback(c, minipoolAddress))
 					return nil
 
 				},
@@ -373,7 +373,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(delegateRollback(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(delegateRollback(c, minipoolAddress))
 					return nil
 
 				},
@@ -399,7 +399,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetUseLatestDelegate(c, minipoolAddress, setting))
+					api.GetResponsePrinter(c).PrintResponse(canSetUseLatestDelegate(c, minipoolAddress, setting))
 					return nil
 
 				},
@@ -424,7 +424,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setUseLatestDelegate(c, minipoolAddress, setting))
+					api.GetResponsePrinter(c).PrintResponse(setUseLatestDelegate(c, minipoolAddress, setting))
 					return nil
 
 				},
@@ -446,7 +446,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getUseLatestDelegate(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(getUseLatestDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -468,7 +468,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getDelegate(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(getDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -490,7 +490,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getPreviousDelegate(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(getPreviousDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -512,7 +512,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getEffectiveDelegate(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(getEffectiveDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -536,7 +536,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					nodeAddressStr := c.Args().Get(1)
 
 					// Run
-					api.PrintResponse(getVanityArtifacts(c, depositAmount, nodeAddressStr))
+					api.GetResponsePrinter(c).PrintResponse(getVanityArtifacts(c, depositAmount, nodeAddressStr))
 					return nil
 
 				},
@@ -562,7 +562,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canBeginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
+					api.GetResponsePrinter(c).PrintResponse(canBeginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
 					return nil
 
 				},
@@ -587,7 +587,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(beginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
+					api.GetResponsePrinter(c).PrintResponse(beginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
 					return nil
 
 				},
@@ -609,7 +609,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canReduceBondAmount(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(canReduceBondAmount(c, minipoolAddress))
 					return nil
 
 				},
@@ -630,7 +630,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(reduceBondAmount(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(reduceBondAmount(c, minipoolAddress))
 					return nil
 
 				},
@@ -648,7 +648,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getDistributeBalanceDetails(c))
+					api.GetResponsePrinter(c).PrintResponse(getDistributeBalanceDetails(c))
 					return nil
 
 				},
@@ -669,7 +669,49 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(distributeBalance(c, minipoolAddress))
+					api.GetResponsePrinter(c).PrintResponse(distributeBalance(c, minipoolAddress))
+					return nil
+
+				},
+			},
+			{
+				Name:      "can-batch-distribute-balance",
+				Usage:     "Check which minipools can have their ETH balances distributed in a single transaction",
+				UsageText: "rocketpool api minipool can-batch-distribute-balance minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool addresses", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(canBatchDistributeBalance(c, minipoolAddresses))
+					return nil
+
+				},
+			},
+			{
+				Name:      "batch-distribute-balance",
+				Usage:     "Distribute the ETH balances of several minipools in a single transaction",
+				UsageText: "rocketpool api minipool batch-distribute-balance minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool addresses", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(batchDistributeBalance(c, minipoolAddresses))
 					return nil
 
 				},
@@ -695,7 +737,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(importKey(c, minipoolAddress, mnemonic))
+					api.GetResponsePrinter(c).PrintResponse(importKey(c, minipoolAddress, mnemonic))
 					return nil
 
 				},
@@ -721,7 +763,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canChangeWithdrawalCreds(c, minipoolAddress, mnemonic))
+					api.GetResponsePrinter(c).PrintResponse(canChangeWithdrawalCreds(c, minipoolAddress, mnemonic))
 					return nil
 
 				},
@@ -746,7 +788,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(changeWithdrawalCreds(c, minipoolAddress, mnemonic))
+					api.GetResponsePrinter(c).PrintResponse(changeWithdrawalCreds(c, minipoolAddress, mnemonic))
 					return nil
 
 				},
@@ -764,12 +806,37 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getMinipoolRescueDissolvedDetailsForNode(c))
+					api.GetResponsePrinter(c).PrintResponse(getMinipoolRescueDissolvedDetailsForNode(c))
 					return nil
 
 				},
 			},
 
+			{
+				Name:      "can-rescue-dissolved",
+				Usage:     "Check whether a dissolved minipool can be rescued with a deposit of the given amount",
+				UsageText: "rocketpool api minipool can-rescue-dissolved minipool-address deposit-amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					depositAmount, err := cliutils.ValidateBigInt("deposit amount", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(canRescueDissolvedMinipool(c, minipoolAddress, depositAmount))
+					return nil
+
+				},
+			},
 			{
 				Name:      "rescue-dissolved",
 				Usage:     "Rescue a dissolved minipool by depositing ETH for it to the Beacon deposit contract",
@@ -790,7 +857,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(rescueDissolvedMinipool(c, minipoolAddress, depositAmount))
+					api.GetResponsePrinter(c).PrintResponse(rescueDissolvedMinipool(c, minipoolAddress, depositAmount))
 					return nil
 
 				},
@@ -17,6 +17,9 @@ import (
 func canDelegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.CanDelegateUpgradeResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -54,6 +57,7 @@ func canDelegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 
 	// Return response
 	return &response, nil
@@ -63,6 +67,9 @@ func canDelegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 func delegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.DelegateUpgradeResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -111,6 +118,9 @@ func delegateUpgrade(c *cli.Context, minipoolAddress common.Address) (*api.Deleg
 func canDelegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.CanDelegateRollbackResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -158,6 +168,7 @@ func canDelegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.C
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 
 	// Return response
 	return &response, nil
@@ -167,6 +178,9 @@ func canDelegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.C
 func delegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.DelegateRollbackResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -225,6 +239,9 @@ func delegateRollback(c *cli.Context, minipoolAddress common.Address) (*api.Dele
 func canSetUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, setting bool) (*api.CanSetUseLatestDelegateResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -281,6 +298,7 @@ func canSetUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, set
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 
 	// Return response
 	return &response, nil
@@ -290,6 +308,9 @@ func canSetUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, set
 func setUseLatestDelegate(c *cli.Context, minipoolAddress common.Address, setting bool) (*api.SetUseLatestDelegateResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
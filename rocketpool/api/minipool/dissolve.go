@@ -16,6 +16,9 @@ import (
 func canDissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanDissolveMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -62,6 +65,7 @@ func canDissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.C
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 
 	// Update & return response
 	response.CanDissolve = !response.InvalidStatus
@@ -72,6 +76,9 @@ func canDissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.C
 func dissolveMinipool(c *cli.Context, minipoolAddress common.Address) (*api.DissolveMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
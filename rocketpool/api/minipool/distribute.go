@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
@@ -20,6 +21,9 @@ import (
 func getDistributeBalanceDetails(c *cli.Context) (*api.GetDistributeBalanceDetailsResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -185,6 +189,24 @@ func getDistributeBalanceDetails(c *cli.Context) (*api.GetDistributeBalanceDetai
 
 	}
 
+	// Simulate distributing the minipools that can be distributed
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	nodeAddresses := eth1.GetSimulationAddresses(rp, nodeAccount.Address)
+	for i, mpDetails := range details {
+		if !mpDetails.CanDistribute {
+			continue
+		}
+		mp, err := minipool.NewMinipool(rp, mpDetails.Address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating binding for minipool %s: %w", mpDetails.Address.Hex(), err)
+		}
+		addresses := append([]eth1.SimulationAddress{{Address: mpDetails.Address, Label: "Minipool"}}, nodeAddresses...)
+		details[i].Simulation = eth1.SimulateTransaction(c, rp, opts, mp.GetContract(), addresses, "distributeBalance", true)
+	}
+
 	// Update & return response
 	response.Details = details
 	return &response, nil
@@ -194,6 +216,9 @@ func getDistributeBalanceDetails(c *cli.Context) (*api.GetDistributeBalanceDetai
 func distributeBalance(c *cli.Context, minipoolAddress common.Address) (*api.CloseMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -242,3 +267,183 @@ func distributeBalance(c *cli.Context, minipoolAddress common.Address) (*api.Clo
 	return &response, nil
 
 }
+
+func canBatchDistributeBalance(c *cli.Context, minipoolAddresses []common.Address) (*api.CanBatchDistributeBalanceResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanBatchDistributeBalanceResponse{
+		Batches:     []api.MinipoolDistributeBatch{},
+		Unbatchable: []common.Address{},
+	}
+
+	// Get the calls
+	calls, err := getDistributeBalanceBatchCalls(rp, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check which ones the multicall contract can make; only minipools with less than 8 ETH accept distributions from anyone but the node
+	multicallAddress := common.HexToAddress(cfg.Smartnode.GetMulticallAddress())
+	batchable, err := eth1.GetBatchableCalls(rp.Client, multicallAddress, calls)
+	if err != nil {
+		return nil, err
+	}
+	batchAddresses := []common.Address{}
+	batchCalls := []eth1.BatchCall{}
+	for i, address := range minipoolAddresses {
+		if batchable[i] {
+			batchAddresses = append(batchAddresses, address)
+			batchCalls = append(batchCalls, calls[i])
+		} else {
+			response.Unbatchable = append(response.Unbatchable, address)
+		}
+	}
+
+	// Get the transactor and batch contract
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, fmt.Errorf("error getting node account: %w", err)
+	}
+	contract, err := eth1.GetBatchContract(rp.Client, multicallAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the minipools into batches that fit in a block
+	for bsi := 0; bsi < len(batchCalls); bsi += eth1.MaxBatchCalls {
+
+		// Get batch start & end index
+		msi := bsi
+		mei := bsi + eth1.MaxBatchCalls
+		if mei > len(batchCalls) {
+			mei = len(batchCalls)
+		}
+		batch := api.MinipoolDistributeBatch{
+			Minipools: batchAddresses[msi:mei],
+		}
+
+		// Get gas estimate
+		batch.GasInfo, err = eth1.EstimateBatchGas(contract, batchCalls[msi:mei], opts)
+		if err != nil {
+			return nil, fmt.Errorf("error estimating gas to distribute minipools in a batch: %w", err)
+		}
+
+		// Simulate the transaction
+		addresses := eth1.GetMinipoolSimulationAddresses(rp, nodeAccount.Address, batch.Minipools...)
+		batch.Simulation = eth1.SimulateTransaction(c, rp, opts, contract, addresses, "aggregate", eth1.GetMultiCalls(batchCalls[msi:mei]))
+
+		response.Batches = append(response.Batches, batch)
+
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func batchDistributeBalance(c *cli.Context, minipoolAddresses []common.Address) (*api.BatchDistributeBalanceResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BatchDistributeBalanceResponse{}
+
+	// Make sure the batch fits in a block
+	if len(minipoolAddresses) > eth1.MaxBatchCalls {
+		return nil, fmt.Errorf("a batch can distribute at most %d minipools", eth1.MaxBatchCalls)
+	}
+
+	// Get the calls
+	calls, err := getDistributeBalanceBatchCalls(rp, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Distribute the minipools' balances
+	contract, err := eth1.GetBatchContract(rp.Client, common.HexToAddress(cfg.Smartnode.GetMulticallAddress()))
+	if err != nil {
+		return nil, err
+	}
+	hash, err := eth1.SendBatch(contract, calls, opts)
+	if err != nil {
+		return nil, err
+	}
+	response.TxHash = hash
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the calls that distribute the rewards of a list of minipools
+func getDistributeBalanceBatchCalls(rp *rocketpool.RocketPool, minipoolAddresses []common.Address) ([]eth1.BatchCall, error) {
+	calls := make([]eth1.BatchCall, len(minipoolAddresses))
+	for i, address := range minipoolAddresses {
+		mp, err := minipool.NewMinipool(rp, address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating binding for minipool %s: %w", address.Hex(), err)
+		}
+		if _, success := minipool.GetMinipoolAsV3(mp); !success {
+			return nil, fmt.Errorf("minipool %s cannot be converted to v3 (current version: %d)", address.Hex(), mp.GetVersion())
+		}
+		calls[i], err = eth1.NewBatchCall(mp.GetContract(), "distributeBalance", true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return calls, nil
+}
@@ -18,6 +18,9 @@ import (
 func canPromoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanPromoteMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -103,6 +106,7 @@ func canPromoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 			return nil, fmt.Errorf("Could not estimate the gas required to promote the minipool: %w", err)
 		}
 		response.GasInfo = gasInfo
+		response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 
 	}
 
@@ -114,6 +118,9 @@ func canPromoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Ca
 func promoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
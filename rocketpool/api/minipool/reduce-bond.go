@@ -18,6 +18,9 @@ import (
 
 func canBeginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, newBondAmountWei *big.Int) (*api.CanBeginReduceBondAmountResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -117,6 +120,11 @@ func canBeginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, ne
 	if err == nil {
 		response.GasInfo = gasInfo
 	}
+	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating binding for minipool %s: %w", minipoolAddress.Hex(), err)
+	}
+	response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 
 	// Update & return response
 	return &response, nil
@@ -124,6 +132,9 @@ func canBeginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, ne
 
 func beginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, newBondAmountWei *big.Int) (*api.BeginReduceBondAmountResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -164,6 +175,9 @@ func beginReduceBondAmount(c *cli.Context, minipoolAddress common.Address, newBo
 
 func canReduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.CanReduceBondAmountResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -196,6 +210,7 @@ func canReduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.C
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 	}
 
 	response.CanReduce = success
@@ -206,6 +221,9 @@ func canReduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.C
 
 func reduceBondAmount(c *cli.Context, minipoolAddress common.Address) (*api.ReduceBondAmountResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -16,6 +16,9 @@ import (
 func canRefundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanRefundMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -63,6 +66,9 @@ func canRefundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Can
 		response.GasInfo = gasInfo
 	}
 
+	// Simulate the refund
+	response.Simulation = eth1.SimulateTransaction(c, rp, opts, mp.GetContract(), eth1.GetMinipoolSimulationAddresses(rp, nodeAccount.Address, minipoolAddress), "refund")
+
 	// Update & return response
 	response.CanRefund = !response.InsufficientRefundBalance
 	return &response, nil
@@ -72,6 +78,9 @@ func canRefundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Can
 func refundMinipool(c *cli.Context, minipoolAddress common.Address) (*api.RefundMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
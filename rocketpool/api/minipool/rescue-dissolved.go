@@ -20,12 +20,14 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 func getMinipoolRescueDissolvedDetailsForNode(c *cli.Context) (*api.GetMinipoolRescueDissolvedDetailsForNodeResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -182,36 +184,83 @@ func getMinipoolRescueDissolvedDetails(rp *rocketpool.RocketPool, w *wallet.Wall
 	// Passed the checks!
 	details.CanRescue = true
 
+	// Get the gas info for depositing
+	details.GasInfo, err = estimateDepositGas(rp, w, bc, minipoolAddress, eth.EthToWei(1))
+	if err != nil {
+		return api.MinipoolRescueDissolvedDetails{}, err
+	}
+
+	return details, nil
+
+}
+
+func canRescueDissolvedMinipool(c *cli.Context, minipoolAddress common.Address, amount *big.Int) (*api.CanRescueDissolvedMinipoolResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanRescueDissolvedMinipoolResponse{}
+
+	// Get the gas info and simulate the deposit for the chosen amount
+	response.GasInfo, err = estimateDepositGas(rp, w, bc, minipoolAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
+	// Return response
+	return &response, nil
+
+}
+
+// Estimate the gas for a rescue deposit of the given amount
+func estimateDepositGas(rp *rocketpool.RocketPool, w *wallet.Wallet, bc beacon.Client, minipoolAddress common.Address, amount *big.Int) (rocketpool.GasInfo, error) {
+
 	// Get the simulated deposit TX
-	one := eth.EthToWei(1)
 	opts, err := w.GetNodeAccountTransactor()
 	if err != nil {
-		return api.MinipoolRescueDissolvedDetails{}, err
+		return rocketpool.GasInfo{}, err
 	}
-	opts.Value = one
+	opts.Value = amount
 	opts.NoSend = true
 	opts.GasLimit = 0
 
 	// Get the gas info for depositing
-	tx, err := getDepositTx(rp, w, bc, minipoolAddress, one, opts)
+	tx, err := getDepositTx(rp, w, bc, minipoolAddress, amount, opts)
 	if err != nil {
-		return api.MinipoolRescueDissolvedDetails{}, fmt.Errorf("error estimating gas for rescue deposit on minipool %s: %w", minipoolAddress.Hex(), err)
+		return rocketpool.GasInfo{}, fmt.Errorf("error estimating gas for rescue deposit on minipool %s: %w", minipoolAddress.Hex(), err)
 	}
 	gasLimit := tx.Gas()
 	safeGasLimit := uint64(float64(gasLimit) * rocketpool.GasLimitMultiplier)
 	if gasLimit > rocketpool.MaxGasLimit {
-		return api.MinipoolRescueDissolvedDetails{}, fmt.Errorf("estimated gas of %d is greater than the max gas limit of %d", gasLimit, rocketpool.MaxGasLimit)
+		return rocketpool.GasInfo{}, fmt.Errorf("estimated gas of %d is greater than the max gas limit of %d", gasLimit, rocketpool.MaxGasLimit)
 	}
 	if safeGasLimit > rocketpool.MaxGasLimit {
 		safeGasLimit = rocketpool.MaxGasLimit
 	}
 
-	details.GasInfo = rocketpool.GasInfo{
+	return rocketpool.GasInfo{
 		EstGasLimit:  gasLimit,
 		SafeGasLimit: safeGasLimit,
-	}
-
-	return details, nil
+	}, nil
 
 }
 
@@ -250,21 +299,17 @@ func getDepositTx(rp *rocketpool.RocketPool, w *wallet.Wallet, bc beacon.Client,
 		return nil, err
 	}
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 	if err != nil {
 		return nil, err
 	}
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
 
 	// Get the deposit amount in gwei
 	amountGwei := big.NewInt(0).Div(amount, big.NewInt(1e9)).Uint64()
 
 	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, amountGwei)
+	depositData, depositDataRoot, err := w.GetDepositData(validatorPubkey, withdrawalCredentials, eth2Config, amountGwei)
 	if err != nil {
 		return nil, err
 	}
@@ -284,6 +329,9 @@ func getDepositTx(rp *rocketpool.RocketPool, w *wallet.Wallet, bc beacon.Client,
 func rescueDissolvedMinipool(c *cli.Context, minipoolAddress common.Address, amount *big.Int) (*api.RescueDissolvedMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -14,12 +14,14 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanStakeMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -98,15 +100,11 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 			return nil, err
 		}
 
-		// Get the validator key for the minipool
+		// Get the validator pubkey for the minipool
 		validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 		if err != nil {
 			return nil, err
 		}
-		validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-		if err != nil {
-			return nil, err
-		}
 
 		// Get the minipool type
 		depositType, err := minipool.GetMinipoolDepositType(rp, mp.GetAddress(), nil)
@@ -125,7 +123,7 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 		}
 
 		// Get validator deposit data
-		depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+		depositData, depositDataRoot, err := w.GetDepositData(validatorPubkey, withdrawalCredentials, eth2Config, depositAmount)
 		if err != nil {
 			return nil, err
 		}
@@ -142,6 +140,7 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 		if err == nil {
 			response.GasInfo = gasInfo
 		}
+		response.Simulation = eth1.SimulateEstimatedMinipoolTransaction(c, rp, mp)
 	}
 
 	// Return response
@@ -152,6 +151,9 @@ func canStakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanS
 func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMinipoolResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -201,15 +203,11 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 		return nil, err
 	}
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey, err := minipool.GetMinipoolPubkey(rp, mp.GetAddress(), nil)
 	if err != nil {
 		return nil, err
 	}
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
 
 	// Get the minipool type
 	depositType, err := minipool.GetMinipoolDepositType(rp, mp.GetAddress(), nil)
@@ -228,7 +226,7 @@ func stakeMinipool(c *cli.Context, minipoolAddress common.Address) (*api.StakeMi
 	}
 
 	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+	depositData, depositDataRoot, err := w.GetDepositData(validatorPubkey, withdrawalCredentials, eth2Config, depositAmount)
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getNodeFee(c))
+					api.GetResponsePrinter(c).PrintResponse(getNodeFee(c))
 					return nil
 
 				},
@@ -47,7 +47,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRplPrice(c))
+					api.GetResponsePrinter(c).PrintResponse(getRplPrice(c))
 					return nil
 
 				},
@@ -66,7 +66,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStats(c))
+					api.GetResponsePrinter(c).PrintResponse(getStats(c))
 					return nil
 
 				},
@@ -85,7 +85,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getTimezones(c))
+					api.GetResponsePrinter(c).PrintResponse(getTimezones(c))
 					return nil
 
 				},
@@ -108,7 +108,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canGenerateRewardsTree(c, index))
+					api.GetResponsePrinter(c).PrintResponse(canGenerateRewardsTree(c, index))
 					return nil
 
 				},
@@ -131,7 +131,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(generateRewardsTree(c, index))
+					api.GetResponsePrinter(c).PrintResponse(generateRewardsTree(c, index))
 					return nil
 
 				},
@@ -150,7 +150,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getActiveDAOProposals(c))
+					api.GetResponsePrinter(c).PrintResponse(getActiveDAOProposals(c))
 					return nil
 
 				},
@@ -174,7 +174,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(downloadRewardsFile(c, interval))
+					api.GetResponsePrinter(c).PrintResponse(downloadRewardsFile(c, interval))
 					return nil
 
 				},
@@ -193,7 +193,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(isHoustonDeployed(c))
+					api.GetResponsePrinter(c).PrintResponse(isHoustonDeployed(c))
 					return nil
 
 				},
@@ -211,7 +211,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getLatestDelegate(c))
+					api.GetResponsePrinter(c).PrintResponse(getLatestDelegate(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "fee-suggestion",
+				Usage:     "Get max fee suggestions from the execution client's fee history",
+				UsageText: "rocketpool api network fee-suggestion",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getFeeSuggestion(c))
 					return nil
 
 				},
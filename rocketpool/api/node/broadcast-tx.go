@@ -0,0 +1,53 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func broadcastTransaction(c *cli.Context, signedTx string) (*api.BroadcastTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastTransactionResponse{}
+
+	// Decode the signed transaction
+	txBytes, err := hexutil.Decode(strings.TrimSpace(signedTx))
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode the signed transaction: %w", err)
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("Could not decode the signed transaction: %w", err)
+	}
+	response.From, err = types.Sender(types.LatestSignerForChainID(tx.ChainId()), &tx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not recover the sender of the transaction - make sure it has been signed: %w", err)
+	}
+
+	// Send it
+	if err := ec.SendTransaction(context.Background(), &tx); err != nil {
+		return nil, fmt.Errorf("Could not broadcast the transaction: %w", err)
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
@@ -16,6 +16,9 @@ import (
 func canNodeBurn(c *cli.Context, amountWei *big.Int, token string) (*api.CanNodeBurnResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -86,6 +89,7 @@ func canNodeBurn(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 			gasInfo, err := tokens.EstimateBurnRETHGas(rp, amountWei, opts)
 			if err == nil {
 				response.GasInfo = gasInfo
+				response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 			}
 			return err
 		}
@@ -106,6 +110,9 @@ func canNodeBurn(c *cli.Context, amountWei *big.Int, token string) (*api.CanNode
 func nodeBurn(c *cli.Context, amountWei *big.Int, token string) (*api.NodeBurnResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
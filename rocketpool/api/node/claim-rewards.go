@@ -171,6 +171,9 @@ func getRewardsInfo(c *cli.Context) (*api.NodeGetRewardsInfoResponse, error) {
 func canClaimRewards(c *cli.Context, indicesString string) (*api.CanNodeClaimRewardsResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -212,6 +215,13 @@ func canClaimRewards(c *cli.Context, indicesString string) (*api.CanNodeClaimRew
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+
+	// Simulate the claim
+	distributor, err := rp.GetContract("rocketMerkleDistributorMainnet", nil)
+	if err != nil {
+		return nil, err
+	}
+	response.Simulation = eth1.SimulateTransaction(c, rp, opts, distributor, eth1.GetSimulationAddresses(rp, nodeAccount.Address), "claim", nodeAccount.Address, indices, amountRPL, amountETH, merkleProofs)
 	return &response, nil
 
 }
@@ -219,6 +229,9 @@ func canClaimRewards(c *cli.Context, indicesString string) (*api.CanNodeClaimRew
 func claimRewards(c *cli.Context, indicesString string) (*api.NodeClaimRewardsResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -277,6 +290,9 @@ func claimRewards(c *cli.Context, indicesString string) (*api.NodeClaimRewardsRe
 func canClaimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *big.Int) (*api.CanNodeClaimAndStakeRewardsResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -318,6 +334,13 @@ func canClaimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+
+	// Simulate the claim
+	distributor, err := rp.GetContract("rocketMerkleDistributorMainnet", nil)
+	if err != nil {
+		return nil, err
+	}
+	response.Simulation = eth1.SimulateTransaction(c, rp, opts, distributor, eth1.GetSimulationAddresses(rp, nodeAccount.Address), "claimAndStake", nodeAccount.Address, indices, amountRPL, amountETH, merkleProofs, stakeAmount)
 	return &response, nil
 
 }
@@ -325,6 +348,9 @@ func canClaimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *
 func claimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *big.Int) (*api.NodeClaimAndStakeRewardsResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -15,6 +15,9 @@ import (
 func canNodeClaimRpl(c *cli.Context) (*api.CanNodeClaimRplResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -71,6 +74,7 @@ func canNodeClaimRpl(c *cli.Context) (*api.CanNodeClaimRplResponse, error) {
 		return nil, fmt.Errorf("Could not estimate the gas required to claim RPL: %w", err)
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	return &response, nil
 }
@@ -78,6 +82,9 @@ func canNodeClaimRpl(c *cli.Context) (*api.CanNodeClaimRplResponse, error) {
 func nodeClaimRpl(c *cli.Context) (*api.NodeClaimRplResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
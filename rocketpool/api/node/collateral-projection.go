@@ -0,0 +1,124 @@
+package node
+
+import (
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getCollateralProjection(c *cli.Context, targetRatio float64) (*api.NodeCollateralProjectionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeCollateralProjectionResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the configured target if one wasn't provided
+	if targetRatio == 0 {
+		targetRatio = cfg.Smartnode.CollateralTargetRatio.Value.(float64)
+	}
+	response.TargetRatio = targetRatio
+
+	// Sync
+	var wg errgroup.Group
+	var ethMatched *big.Int
+	var pendingMatchAmount *big.Int
+	var minFraction *big.Int
+	var maxFraction *big.Int
+	var activeMinipools int64
+
+	wg.Go(func() error {
+		var err error
+		response.RplStake, err = node.GetNodeRPLStake(rp, nodeAccount.Address, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.RplPrice, err = network.GetRPLPrice(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		ethMatched, _, pendingMatchAmount, err = rputils.CheckCollateral(rp, nodeAccount.Address, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		minFraction, err = protocol.GetMinimumPerMinipoolStakeRaw(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		maxFraction, err = protocol.GetMaximumPerMinipoolStakeRaw(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		details, err := getNodeMinipoolCountDetails(rp, nodeAccount.Address)
+		if err != nil {
+			return err
+		}
+		for _, mpDetails := range details {
+			if !mpDetails.Finalised {
+				activeMinipools++
+			}
+		}
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the borrowed and bonded ETH, including pending bond reductions
+	response.BorrowedEth = big.NewInt(0).Add(ethMatched, pendingMatchAmount)
+	response.BondedEth = big.NewInt(0).Mul(big.NewInt(activeMinipools), eth.EthToWei(32))
+	response.BondedEth.Sub(response.BondedEth, response.BorrowedEth)
+	if response.BondedEth.Sign() < 0 {
+		response.BondedEth.SetUint64(0)
+	}
+	response.MinimumCollateralPercent = eth.WeiToEth(minFraction) * 100
+	response.MaximumCollateralPercent = eth.WeiToEth(maxFraction) * 100
+
+	// Project the collateral
+	projection := rputils.ProjectCollateral(response.RplStake, response.BorrowedEth, response.BondedEth, response.RplPrice, minFraction, maxFraction, targetRatio)
+	response.BorrowedCollateralRatio = projection.BorrowedCollateralRatio * 100
+	response.BondedCollateralRatio = projection.BondedCollateralRatio * 100
+	response.MinimumRplPrice = projection.MinimumRplPrice
+	response.MaximumRplPrice = projection.MaximumRplPrice
+	response.RplNeededForTarget = projection.RplNeededForTarget
+
+	// Return response
+	return &response, nil
+
+}
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -47,7 +47,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getSyncProgress(c))
+					api.GetResponsePrinter(c).PrintResponse(getSyncProgress(c))
 					return nil
 
 				},
@@ -69,7 +69,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canRegisterNode(c, timezoneLocation))
+					api.GetResponsePrinter(c).PrintResponse(canRegisterNode(c, timezoneLocation))
 					return nil
 
 				},
@@ -91,7 +91,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(registerNode(c, timezoneLocation))
+					api.GetResponsePrinter(c).PrintResponse(registerNode(c, timezoneLocation))
 					return nil
 
 				},
@@ -118,7 +118,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.GetResponsePrinter(c).PrintResponse(canSetPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -144,7 +144,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.GetResponsePrinter(c).PrintResponse(setPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -162,7 +162,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canConfirmPrimaryWithdrawalAddress(c))
+					api.GetResponsePrinter(c).PrintResponse(canConfirmPrimaryWithdrawalAddress(c))
 					return nil
 
 				},
@@ -179,7 +179,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(confirmPrimaryWithdrawalAddress(c))
+					api.GetResponsePrinter(c).PrintResponse(confirmPrimaryWithdrawalAddress(c))
 					return nil
 
 				},
@@ -206,7 +206,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetRPLWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.GetResponsePrinter(c).PrintResponse(canSetRPLWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -232,7 +232,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setRPLWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.GetResponsePrinter(c).PrintResponse(setRPLWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -250,7 +250,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canConfirmRPLWithdrawalAddress(c))
+					api.GetResponsePrinter(c).PrintResponse(canConfirmRPLWithdrawalAddress(c))
 					return nil
 
 				},
@@ -267,7 +267,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(confirmRPLWithdrawalAddress(c))
+					api.GetResponsePrinter(c).PrintResponse(confirmRPLWithdrawalAddress(c))
 					return nil
 
 				},
@@ -289,7 +289,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetTimezoneLocation(c, timezoneLocation))
+					api.GetResponsePrinter(c).PrintResponse(canSetTimezoneLocation(c, timezoneLocation))
 					return nil
 
 				},
@@ -311,7 +311,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setTimezoneLocation(c, timezoneLocation))
+					api.GetResponsePrinter(c).PrintResponse(setTimezoneLocation(c, timezoneLocation))
 					return nil
 
 				},
@@ -333,7 +333,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeSwapRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(canNodeSwapRpl(c, amountWei))
 					return nil
 
 				},
@@ -355,7 +355,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(approveFsRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(approveFsRpl(c, amountWei))
 					return nil
 
 				},
@@ -381,7 +381,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(waitForApprovalAndSwapFsRpl(c, amountWei, hash))
+					api.GetResponsePrinter(c).PrintResponse(waitForApprovalAndSwapFsRpl(c, amountWei, hash))
 					return nil
 
 				},
@@ -402,7 +402,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getSwapApprovalGas(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(getSwapApprovalGas(c, amountWei))
 					return nil
 
 				},
@@ -419,7 +419,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(allowanceFsRpl(c))
+					api.GetResponsePrinter(c).PrintResponse(allowanceFsRpl(c))
 					return nil
 
 				},
@@ -441,7 +441,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(swapRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(swapRpl(c, amountWei))
 					return nil
 
 				},
@@ -463,7 +463,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeStakeRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(canNodeStakeRpl(c, amountWei))
 					return nil
 
 				},
@@ -485,7 +485,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(approveRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(approveRpl(c, amountWei))
 					return nil
 
 				},
@@ -511,7 +511,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(waitForApprovalAndStakeRpl(c, amountWei, hash))
+					api.GetResponsePrinter(c).PrintResponse(waitForApprovalAndStakeRpl(c, amountWei, hash))
 					return nil
 
 				},
@@ -532,7 +532,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStakeApprovalGas(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(getStakeApprovalGas(c, amountWei))
 					return nil
 
 				},
@@ -549,7 +549,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(allowanceRpl(c))
+					api.GetResponsePrinter(c).PrintResponse(allowanceRpl(c))
 					return nil
 
 				},
@@ -571,7 +571,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(stakeRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(stakeRpl(c, amountWei))
 					return nil
 
 				},
@@ -594,7 +594,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetRplLockAllowed(c, allowed))
+					api.GetResponsePrinter(c).PrintResponse(canSetRplLockAllowed(c, allowed))
 					return nil
 
 				},
@@ -618,7 +618,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setRplLockAllowed(c, allowed))
+					api.GetResponsePrinter(c).PrintResponse(setRplLockAllowed(c, allowed))
 					return nil
 
 				},
@@ -648,7 +648,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetStakeRplForAllowed(c, callerAddress, allowed))
+					api.GetResponsePrinter(c).PrintResponse(canSetStakeRplForAllowed(c, callerAddress, allowed))
 					return nil
 
 				},
@@ -678,7 +678,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setStakeRplForAllowed(c, callerAddress, allowed))
+					api.GetResponsePrinter(c).PrintResponse(setStakeRplForAllowed(c, callerAddress, allowed))
 
 					return nil
 				},
@@ -699,7 +699,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeWithdrawEth(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(canNodeWithdrawEth(c, amountWei))
 					return nil
 
 				},
@@ -721,7 +721,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeWithdrawEth(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(nodeWithdrawEth(c, amountWei))
 					return nil
 
 				},
@@ -742,7 +742,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeWithdrawRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(canNodeWithdrawRpl(c, amountWei))
 					return nil
 
 				},
@@ -764,7 +764,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeWithdrawRpl(c, amountWei))
+					api.GetResponsePrinter(c).PrintResponse(nodeWithdrawRpl(c, amountWei))
 					return nil
 
 				},
@@ -794,7 +794,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeDeposit(c, amountWei, minNodeFee, salt))
+					api.GetResponsePrinter(c).PrintResponse(canNodeDeposit(c, amountWei, minNodeFee, salt))
 					return nil
 
 				},
@@ -834,7 +834,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					// Run
 					response, err := nodeDeposit(c, amountWei, minNodeFee, salt, useCreditBalance, submit)
 					if submit {
-						api.PrintResponse(response, err)
+						api.GetResponsePrinter(c).PrintResponse(response, err)
 					} // else nodeDeposit already printed the encoded transaction
 					return nil
 
@@ -865,7 +865,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeSend(c, amountWei, token, toAddress))
+					api.GetResponsePrinter(c).PrintResponse(canNodeSend(c, amountWei, token, toAddress))
 					return nil
 
 				},
@@ -895,7 +895,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeSend(c, amountWei, token, toAddress))
+					api.GetResponsePrinter(c).PrintResponse(nodeSend(c, amountWei, token, toAddress))
 					return nil
 
 				},
@@ -921,7 +921,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeBurn(c, amountWei, token))
+					api.GetResponsePrinter(c).PrintResponse(canNodeBurn(c, amountWei, token))
 					return nil
 
 				},
@@ -947,7 +947,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeBurn(c, amountWei, token))
+					api.GetResponsePrinter(c).PrintResponse(nodeBurn(c, amountWei, token))
 					return nil
 
 				},
@@ -965,7 +965,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeClaimRpl(c))
+					api.GetResponsePrinter(c).PrintResponse(canNodeClaimRpl(c))
 					return nil
 
 				},
@@ -982,7 +982,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeClaimRpl(c))
+					api.GetResponsePrinter(c).PrintResponse(nodeClaimRpl(c))
 					return nil
 
 				},
@@ -1000,7 +1000,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRewards(c))
+					api.GetResponsePrinter(c).PrintResponse(getRewards(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "rewards-ledger",
+				Usage:     "Get the node's history of rewards, claims and distributions",
+				UsageText: "rocketpool api node rewards-ledger",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getRewardsLedger(c))
 					return nil
 
 				},
@@ -1018,7 +1036,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getDepositContractInfo(c))
+					api.GetResponsePrinter(c).PrintResponse(getDepositContractInfo(c))
 					return nil
 
 				},
@@ -1038,7 +1056,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					data := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(sign(c, data))
+					api.GetResponsePrinter(c).PrintResponse(sign(c, data))
 					return nil
 
 				},
@@ -1058,7 +1076,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					message := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(signMessage(c, message))
+					api.GetResponsePrinter(c).PrintResponse(signMessage(c, message))
 					return nil
 
 				},
@@ -1081,7 +1099,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(estimateSetSnapshotDelegateGas(c, delegate))
+					api.GetResponsePrinter(c).PrintResponse(estimateSetSnapshotDelegateGas(c, delegate))
 					return nil
 
 				},
@@ -1103,7 +1121,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setSnapshotDelegate(c, delegate))
+					api.GetResponsePrinter(c).PrintResponse(setSnapshotDelegate(c, delegate))
 					return nil
 
 				},
@@ -1121,7 +1139,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(estimateClearSnapshotDelegateGas(c))
+					api.GetResponsePrinter(c).PrintResponse(estimateClearSnapshotDelegateGas(c))
 					return nil
 
 				},
@@ -1138,7 +1156,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(clearSnapshotDelegate(c))
+					api.GetResponsePrinter(c).PrintResponse(clearSnapshotDelegate(c))
 					return nil
 
 				},
@@ -1156,7 +1174,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(isFeeDistributorInitialized(c))
+					api.GetResponsePrinter(c).PrintResponse(isFeeDistributorInitialized(c))
 					return nil
 
 				},
@@ -1173,7 +1191,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getInitializeFeeDistributorGas(c))
+					api.GetResponsePrinter(c).PrintResponse(getInitializeFeeDistributorGas(c))
 					return nil
 				},
 			},
@@ -1195,7 +1213,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(estimateSetSnapshotDelegateGas(c, delegate))
+					api.GetResponsePrinter(c).PrintResponse(estimateSetSnapshotDelegateGas(c, delegate))
 					return nil
 
 				},
@@ -1212,7 +1230,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(initializeFeeDistributor(c))
+					api.GetResponsePrinter(c).PrintResponse(initializeFeeDistributor(c))
 					return nil
 
 				},
@@ -1230,7 +1248,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDistribute(c))
+					api.GetResponsePrinter(c).PrintResponse(canDistribute(c))
 					return nil
 
 				},
@@ -1252,7 +1270,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setSnapshotDelegate(c, delegate))
+					api.GetResponsePrinter(c).PrintResponse(setSnapshotDelegate(c, delegate))
 					return nil
 
 				},
@@ -1269,7 +1287,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(distribute(c))
+					api.GetResponsePrinter(c).PrintResponse(distribute(c))
 					return nil
 
 				},
@@ -1286,7 +1304,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeClaimRpl(c))
+					api.GetResponsePrinter(c).PrintResponse(nodeClaimRpl(c))
 					return nil
 
 				},
@@ -1304,7 +1322,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRewardsInfo(c))
+					api.GetResponsePrinter(c).PrintResponse(getRewardsInfo(c))
 					return nil
 
 				},
@@ -1322,7 +1340,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					indicesString := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(canClaimRewards(c, indicesString))
+					api.GetResponsePrinter(c).PrintResponse(canClaimRewards(c, indicesString))
 					return nil
 
 				},
@@ -1340,7 +1358,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					indicesString := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(claimRewards(c, indicesString))
+					api.GetResponsePrinter(c).PrintResponse(claimRewards(c, indicesString))
 					return nil
 
 				},
@@ -1363,7 +1381,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canClaimAndStakeRewards(c, indicesString, stakeAmount))
+					api.GetResponsePrinter(c).PrintResponse(canClaimAndStakeRewards(c, indicesString, stakeAmount))
 					return nil
 
 				},
@@ -1386,7 +1404,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(claimAndStakeRewards(c, indicesString, stakeAmount))
+					api.GetResponsePrinter(c).PrintResponse(claimAndStakeRewards(c, indicesString, stakeAmount))
 					return nil
 
 				},
@@ -1404,7 +1422,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getSmoothingPoolRegistrationStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getSmoothingPoolRegistrationStatus(c))
 					return nil
 
 				},
@@ -1425,7 +1443,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetSmoothingPoolStatus(c, status))
+					api.GetResponsePrinter(c).PrintResponse(canSetSmoothingPoolStatus(c, status))
 					return nil
 
 				},
@@ -1446,7 +1464,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setSmoothingPoolStatus(c, status))
+					api.GetResponsePrinter(c).PrintResponse(setSmoothingPoolStatus(c, status))
 					return nil
 
 				},
@@ -1463,7 +1481,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(resolveEnsName(c, c.Args().Get(0)))
+					api.GetResponsePrinter(c).PrintResponse(resolveEnsName(c, c.Args().Get(0)))
 					return nil
 
 				},
@@ -1484,7 +1502,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(reverseResolveEnsName(c, address))
+					api.GetResponsePrinter(c).PrintResponse(reverseResolveEnsName(c, address))
 					return nil
 
 				},
@@ -1518,7 +1536,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canCreateVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
+					api.GetResponsePrinter(c).PrintResponse(canCreateVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
 					return nil
 
 				},
@@ -1551,7 +1569,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(createVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
+					api.GetResponsePrinter(c).PrintResponse(createVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
 					return nil
 
 				},
@@ -1569,7 +1587,73 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(checkCollateral(c))
+					api.GetResponsePrinter(c).PrintResponse(checkCollateral(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-collateral-projection",
+				Usage:     "Project the RPL prices at which the node leaves the rewardable collateral range, and the RPL needed to reach a target ratio",
+				UsageText: "rocketpool api node get-collateral-projection target-ratio",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					targetRatio, err := cliutils.ValidatePercentage("target ratio", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getCollateralProjection(c, targetRatio))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "snapshots",
+				Usage:     "List the network state snapshots saved by the node daemon",
+				UsageText: "rocketpool api node snapshots",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getSnapshots(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "snapshot-status",
+				Usage:     "Get the node's status from the latest saved network state snapshot at or before a slot or block; pass 0 for the block to query by slot",
+				UsageText: "rocketpool api node snapshot-status slot block",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					slot, err := cliutils.ValidateUint("slot", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					block, err := cliutils.ValidateUint("block", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getSnapshotStatus(c, slot, block))
 					return nil
 
 				},
@@ -1587,7 +1671,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getNodeEthBalance(c))
+					api.GetResponsePrinter(c).PrintResponse(getNodeEthBalance(c))
 					return nil
 
 				},
@@ -1613,7 +1697,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSendMessage(c, address, message))
+					api.GetResponsePrinter(c).PrintResponse(canSendMessage(c, address, message))
 					return nil
 
 				},
@@ -1638,7 +1722,125 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(sendMessage(c, address, message))
+					api.GetResponsePrinter(c).PrintResponse(sendMessage(c, address, message))
+					return nil
+
+				},
+			},
+			{
+				Name:      "broadcast-tx",
+				Usage:     "Broadcast a transaction that was signed offline",
+				UsageText: "rocketpool api node broadcast-tx signed-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(broadcastTransaction(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
+			{
+				Name:      "pending-tx",
+				Usage:     "List the transactions the daemons have sent that haven't been included yet",
+				UsageText: "rocketpool api node pending-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getPendingTransactions(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "cancel-pending-tx",
+				Usage:     "Cancel a pending transaction by replacing it with an empty transfer",
+				UsageText: "rocketpool api node cancel-pending-tx nonce",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					nonce, err := cliutils.ValidateUint("nonce", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(cancelPendingTransaction(c, nonce))
+					return nil
+
+				},
+			},
+			{
+				Name:      "defer-tx",
+				Usage:     "Queue an unsigned transaction for the node daemon to send once gas is below a target or a deadline passes",
+				UsageText: "rocketpool api node defer-tx tx-json when-gas-below not-after description",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 4); err != nil {
+						return err
+					}
+					whenGasBelow, err := cliutils.ValidateGasPrice("gas target", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					notAfter, err := cliutils.ValidateUint("deadline", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(deferTransaction(c, c.Args().Get(0), whenGasBelow, notAfter, c.Args().Get(3)))
+					return nil
+
+				},
+			},
+			{
+				Name:      "deferred-txs",
+				Usage:     "List the deferred transactions",
+				UsageText: "rocketpool api node deferred-txs",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getDeferredTransactions(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "cancel-deferred-tx",
+				Usage:     "Remove a deferred transaction from the queue",
+				UsageText: "rocketpool api node cancel-deferred-tx id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					id, err := cliutils.ValidatePositiveUint("id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(cancelDeferredTransaction(c, id))
 					return nil
 
 				},
@@ -0,0 +1,128 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func deferTransaction(c *cli.Context, txJson string, whenGasBelowGwei float64, notAfterUnix uint64, description string) (*api.DeferTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.DeferTransactionResponse{}
+
+	// Decode the transaction
+	var tx api.OfflineTransaction
+	if err := json.Unmarshal([]byte(txJson), &tx); err != nil {
+		return nil, fmt.Errorf("Could not decode the transaction: %w", err)
+	}
+	if tx.Transaction == nil {
+		return nil, fmt.Errorf("The transaction is empty")
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if tx.From != nodeAccount.Address {
+		return nil, fmt.Errorf("The transaction is from %s, but only transactions from the node account (%s) can be deferred", tx.From.Hex(), nodeAccount.Address.Hex())
+	}
+
+	// Check the conditions
+	if whenGasBelowGwei <= 0 && notAfterUnix == 0 {
+		return nil, fmt.Errorf("A deferred transaction needs a gas target, a deadline, or both")
+	}
+	var notAfter time.Time
+	if notAfterUnix != 0 {
+		notAfter = time.Unix(int64(notAfterUnix), 0)
+	}
+
+	// Queue it
+	response.ID, err = txmanager.AddDeferredTransaction(cfg.Smartnode.GetDeferredTxPath(), txmanager.DeferredTransaction{
+		Description:      description,
+		From:             tx.From,
+		Tx:               tx.Transaction,
+		WhenGasBelowGwei: whenGasBelowGwei,
+		NotAfter:         notAfter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func getDeferredTransactions(c *cli.Context) (*api.DeferredTransactionsResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.DeferredTransactionsResponse{}
+
+	// Get the transactions
+	response.Transactions, err = txmanager.LoadDeferredTransactions(cfg.Smartnode.GetDeferredTxPath())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func cancelDeferredTransaction(c *cli.Context, id uint64) (*api.CancelDeferredTransactionResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CancelDeferredTransactionResponse{}
+
+	// Make sure it hasn't been sent yet
+	path := cfg.Smartnode.GetDeferredTxPath()
+	txs, err := txmanager.LoadDeferredTransactions(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		if tx.ID == id && tx.Status == txmanager.DeferredTransactionStatus_Sent {
+			return nil, fmt.Errorf("Deferred transaction %d has already been sent with hash %s; use `rocketpool node pending-tx` to cancel it instead", id, tx.TxHash.Hex())
+		}
+	}
+
+	// Remove it
+	if err := txmanager.RemoveDeferredTransaction(path, id); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
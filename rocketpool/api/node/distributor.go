@@ -49,6 +49,9 @@ func isFeeDistributorInitialized(c *cli.Context) (*api.NodeIsFeeDistributorIniti
 
 func getInitializeFeeDistributorGas(c *cli.Context) (*api.NodeInitializeFeeDistributorGasResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -90,6 +93,7 @@ func getInitializeFeeDistributorGas(c *cli.Context) (*api.NodeInitializeFeeDistr
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	return &response, nil
@@ -99,6 +103,9 @@ func getInitializeFeeDistributorGas(c *cli.Context) (*api.NodeInitializeFeeDistr
 func initializeFeeDistributor(c *cli.Context) (*api.NodeInitializeFeeDistributorResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -139,6 +146,9 @@ func initializeFeeDistributor(c *cli.Context) (*api.NodeInitializeFeeDistributor
 
 func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -202,6 +212,7 @@ func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 		}
 		gasInfo, err := distributor.EstimateDistributeGas(opts)
 		response.GasInfo = gasInfo
+		response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 		return err
 	})
 
@@ -217,6 +228,9 @@ func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 
 func distribute(c *cli.Context) (*api.NodeDistributeResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
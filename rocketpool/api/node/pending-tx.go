@@ -0,0 +1,235 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Config
+const cancelGasLimit uint64 = 21000
+
+func getPendingTransactions(c *cli.Context) (*api.PendingTransactionsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PendingTransactionsResponse{
+		Transactions: []api.PendingTransaction{},
+		Accounts:     []api.PendingAccountNonces{},
+	}
+
+	// Get the accounts the node's transactions can come from
+	addresses, err := getTransactionAccounts(w)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the base fee for cancellation estimates
+	header, err := ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting latest block header: %w", err)
+	}
+
+	// Get the nonces of each account
+	tracked := map[common.Address]map[uint64]bool{}
+	for _, address := range addresses {
+		confirmedNonce, err := ec.NonceAt(context.Background(), address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting nonce for %s: %w", address.Hex(), err)
+		}
+		pendingNonce, err := ec.PendingNonceAt(context.Background(), address)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting pending nonce for %s: %w", address.Hex(), err)
+		}
+		response.Accounts = append(response.Accounts, api.PendingAccountNonces{
+			Address:         address,
+			ConfirmedNonce:  confirmedNonce,
+			PendingNonce:    pendingNonce,
+			UntrackedNonces: []uint64{},
+		})
+		tracked[address] = map[uint64]bool{}
+	}
+
+	// Get the journaled transactions that haven't been included yet
+	for _, name := range txmanager.JournalNames {
+		journal, err := txmanager.LoadJournal(cfg.Smartnode.GetTxJournalPath(name))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range journal.GetEntries() {
+			for _, account := range response.Accounts {
+				if account.Address != entry.From || entry.Nonce < account.ConfirmedNonce {
+					continue
+				}
+				tx := api.PendingTransaction{
+					Daemon:       name,
+					From:         entry.From,
+					Nonce:        entry.Nonce,
+					TxHash:       entry.Hashes[len(entry.Hashes)-1],
+					Replacements: len(entry.Hashes) - 1,
+					FirstSent:    entry.FirstSent,
+					LastSent:     entry.LastSent,
+				}
+				if entry.Tx != nil {
+					tx.To = entry.Tx.To()
+					tx.MaxFeeWei = entry.Tx.GasFeeCap()
+					tx.MaxPriorityFeeWei = entry.Tx.GasTipCap()
+					tx.CancelPriorityFeeWei, tx.CancelMaxFeeWei, _ = txmanager.GetBumpedFees(entry.Tx.GasTipCap(), entry.Tx.GasFeeCap(), header.BaseFee, nil)
+				}
+				response.Transactions = append(response.Transactions, tx)
+				tracked[entry.From][entry.Nonce] = true
+			}
+		}
+	}
+
+	// Find pending nonces the daemons didn't send
+	for i, account := range response.Accounts {
+		for nonce := account.ConfirmedNonce; nonce < account.PendingNonce; nonce++ {
+			if !tracked[account.Address][nonce] {
+				response.Accounts[i].UntrackedNonces = append(response.Accounts[i].UntrackedNonces, nonce)
+			}
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func cancelPendingTransaction(c *cli.Context, nonce uint64) (*api.CancelPendingTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CancelPendingTransactionResponse{}
+
+	// Find the transaction
+	addresses, err := getTransactionAccounts(w)
+	if err != nil {
+		return nil, err
+	}
+	var pendingTx *types.Transaction
+	var from common.Address
+	for _, name := range txmanager.JournalNames {
+		journal, err := txmanager.LoadJournal(cfg.Smartnode.GetTxJournalPath(name))
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range journal.GetEntries() {
+			if entry.Nonce != nonce || entry.Tx == nil {
+				continue
+			}
+			for _, address := range addresses {
+				if entry.From != address {
+					continue
+				}
+				confirmedNonce, err := ec.NonceAt(context.Background(), address, nil)
+				if err != nil {
+					return nil, fmt.Errorf("Error getting nonce for %s: %w", address.Hex(), err)
+				}
+				if nonce >= confirmedNonce {
+					pendingTx = entry.Tx
+					from = entry.From
+				}
+			}
+		}
+	}
+	if pendingTx == nil {
+		return nil, fmt.Errorf("There is no pending transaction with nonce %d in the daemons' journals. If it was sent by something other than the Smartnode, please cancel it from the wallet that sent it.", nonce)
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace it with an empty transfer to the same account
+	header, err := ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting latest block header: %w", err)
+	}
+	tip, feeCap, _ := txmanager.GetBumpedFees(pendingTx.GasTipCap(), pendingTx.GasFeeCap(), header.BaseFee, nil)
+	tx, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   w.GetChainID(),
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       cancelGasLimit,
+		To:        &from,
+		Value:     big.NewInt(0),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("Error signing cancellation transaction: %w", err)
+	}
+
+	// Send it unless it's being exported for offline signing
+	if !opts.NoSend {
+		if err := ec.SendTransaction(context.Background(), tx); err != nil {
+			return nil, fmt.Errorf("Error sending cancellation transaction: %w", err)
+		}
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the accounts the Smartnode sends transactions from
+func getTransactionAccounts(w *wallet.Wallet) ([]common.Address, error) {
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	return []common.Address{nodeAccount.Address}, nil
+}
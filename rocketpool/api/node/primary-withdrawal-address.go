@@ -15,6 +15,9 @@ import (
 func canSetPrimaryWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, confirm bool) (*api.CanSetNodePrimaryWithdrawalAddressResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -48,6 +51,7 @@ func canSetPrimaryWithdrawalAddress(c *cli.Context, withdrawalAddress common.Add
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	response.CanSet = true
@@ -57,6 +61,9 @@ func canSetPrimaryWithdrawalAddress(c *cli.Context, withdrawalAddress common.Add
 func setPrimaryWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, confirm bool) (*api.SetNodePrimaryWithdrawalAddressResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -115,6 +122,9 @@ func setPrimaryWithdrawalAddress(c *cli.Context, withdrawalAddress common.Addres
 func canConfirmPrimaryWithdrawalAddress(c *cli.Context) (*api.CanConfirmNodePrimaryWithdrawalAddressResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -154,6 +164,7 @@ func canConfirmPrimaryWithdrawalAddress(c *cli.Context) (*api.CanConfirmNodePrim
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	response.CanConfirm = (pendingAddress != nodeAccount.Address)
@@ -163,6 +174,9 @@ func canConfirmPrimaryWithdrawalAddress(c *cli.Context) (*api.CanConfirmNodePrim
 func confirmPrimaryWithdrawalAddress(c *cli.Context) (*api.ConfirmNodePrimaryWithdrawalAddressResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -16,6 +16,9 @@ import (
 func canRegisterNode(c *cli.Context, timezoneLocation string) (*api.CanRegisterNodeResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -69,6 +72,7 @@ func canRegisterNode(c *cli.Context, timezoneLocation string) (*api.CanRegisterN
 		gasInfo, err := node.EstimateRegisterNodeGas(rp, timezoneLocation, opts)
 		if err == nil {
 			response.GasInfo = gasInfo
+			response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 		}
 		return err
 	})
@@ -87,6 +91,9 @@ func canRegisterNode(c *cli.Context, timezoneLocation string) (*api.CanRegisterN
 func registerNode(c *cli.Context, timezoneLocation string) (*api.RegisterNodeResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
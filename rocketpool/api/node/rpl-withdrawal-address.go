@@ -17,6 +17,9 @@ import (
 
 func canSetRPLWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, confirm bool) (*api.CanSetNodeRPLWithdrawalAddressResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -99,6 +102,7 @@ func canSetRPLWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	return &response, nil
@@ -106,6 +110,9 @@ func canSetRPLWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address
 
 func setRPLWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, confirm bool) (*api.SetNodeRPLWithdrawalAddressResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -152,6 +159,9 @@ func setRPLWithdrawalAddress(c *cli.Context, withdrawalAddress common.Address, c
 
 func canConfirmRPLWithdrawalAddress(c *cli.Context) (*api.CanConfirmNodeRPLWithdrawalAddressResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -196,6 +206,7 @@ func canConfirmRPLWithdrawalAddress(c *cli.Context) (*api.CanConfirmNodeRPLWithd
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	return &response, nil
@@ -203,6 +214,9 @@ func canConfirmRPLWithdrawalAddress(c *cli.Context) (*api.CanConfirmNodeRPLWithd
 
 func confirmRPLWithdrawalAddress(c *cli.Context) (*api.ConfirmNodeRPLWithdrawalAddressResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -15,6 +15,9 @@ import (
 func canSendMessage(c *cli.Context, address common.Address, message []byte) (*api.CanNodeSendMessageResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -22,7 +25,7 @@ func canSendMessage(c *cli.Context, address common.Address, message []byte) (*ap
 	if err != nil {
 		return nil, err
 	}
-	ec, err := services.GetEthClient(c)
+	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
 	}
@@ -36,12 +39,13 @@ func canSendMessage(c *cli.Context, address common.Address, message []byte) (*ap
 		return nil, err
 	}
 
-	gasInfo, err := eth.EstimateSendTransactionGas(ec, address, message, true, opts)
+	gasInfo, err := eth.EstimateSendTransactionGas(rp.Client, address, message, true, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error estimating gas to send message: %w", err)
 	}
 
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	return &response, nil
 
@@ -50,6 +54,9 @@ func canSendMessage(c *cli.Context, address common.Address, message []byte) (*ap
 func sendMessage(c *cli.Context, address common.Address, message []byte) (*api.NodeSendMessageResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -78,7 +85,7 @@ func sendMessage(c *cli.Context, address common.Address, message []byte) (*api.N
 	}
 
 	// Send the message
-	hash, err := eth.SendTransaction(ec, address, w.GetChainID(), message, true, opts)
+	hash, err := eth.SendTransaction(eth1.GetSendClient(ec, opts), address, w.GetChainID(), message, true, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error sending message: %w", err)
 	}
@@ -19,6 +19,9 @@ import (
 func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Address) (*api.CanNodeSendResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -112,6 +115,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Add
 			return nil, err
 		}
 		response.GasInfo = gasInfo
+		response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	} else {
 		// Handle well-known token types
 		switch token {
@@ -129,6 +133,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Add
 				return nil, err
 			}
 			response.GasInfo = gasInfo
+			response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 		case "rpl":
 
@@ -148,6 +153,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Add
 				return nil, err
 			}
 			response.GasInfo = gasInfo
+			response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 		case "fsrpl":
 
@@ -167,6 +173,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Add
 				return nil, err
 			}
 			response.GasInfo = gasInfo
+			response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 		case "reth":
 
@@ -186,6 +193,7 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Add
 				return nil, err
 			}
 			response.GasInfo = gasInfo
+			response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 		}
 	}
@@ -199,6 +207,9 @@ func canNodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Add
 func nodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Address) (*api.NodeSendResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -250,7 +261,7 @@ func nodeSend(c *cli.Context, amountWei *big.Int, token string, to common.Addres
 
 			// Transfer ETH
 			opts.Value = amountWei
-			hash, err := eth.SendTransaction(ec, to, w.GetChainID(), nil, false, opts)
+			hash, err := eth.SendTransaction(eth1.GetSendClient(ec, opts), to, w.GetChainID(), nil, false, opts)
 			if err != nil {
 				return nil, err
 			}
@@ -14,6 +14,9 @@ import (
 func canSetRplLockAllowed(c *cli.Context, allowed bool) (*api.CanSetRplLockingAllowedResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -49,6 +52,7 @@ func canSetRplLockAllowed(c *cli.Context, allowed bool) (*api.CanSetRplLockingAl
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Update & return response
 	response.CanSet = (!isAllowed && allowed) || (isAllowed && !allowed)
@@ -66,6 +70,9 @@ func canSetRplLockAllowed(c *cli.Context, allowed bool) (*api.CanSetRplLockingAl
 func setRplLockAllowed(c *cli.Context, allowed bool) (*api.SetRplLockingAllowedResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -15,6 +15,9 @@ import (
 func canSetStakeRplForAllowed(c *cli.Context, caller common.Address, allowed bool) (*api.CanSetStakeRplForAllowedResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -40,6 +43,7 @@ func canSetStakeRplForAllowed(c *cli.Context, caller common.Address, allowed boo
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Update & return response
 	response.CanSet = true
@@ -50,6 +54,9 @@ func canSetStakeRplForAllowed(c *cli.Context, caller common.Address, allowed boo
 func setStakeRplForAllowed(c *cli.Context, caller common.Address, allowed bool) (*api.SetStakeRplForAllowedResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
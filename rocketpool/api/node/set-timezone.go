@@ -15,6 +15,9 @@ import (
 func canSetTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.CanSetNodeTimezoneResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -40,6 +43,7 @@ func canSetTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.CanSe
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	response.CanSet = true
 	return &response, nil
 
@@ -48,6 +52,9 @@ func canSetTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.CanSe
 func setTimezoneLocation(c *cli.Context, timezoneLocation string) (*api.SetNodeTimezoneResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -13,6 +13,9 @@ import (
 )
 
 func signMessage(c *cli.Context, message string) (*api.NodeSignResponse, error) {
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return nil, err
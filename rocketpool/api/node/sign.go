@@ -15,6 +15,9 @@ import (
 func sign(c *cli.Context, serializedTx string) (*api.NodeSignResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -82,6 +82,9 @@ func getSmoothingPoolRegistrationStatus(c *cli.Context) (*api.GetSmoothingPoolRe
 func canSetSmoothingPoolStatus(c *cli.Context, status bool) (*api.CanSetSmoothingPoolRegistrationStatusResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -108,6 +111,7 @@ func canSetSmoothingPoolStatus(c *cli.Context, status bool) (*api.CanSetSmoothin
 	gasInfo, err := node.EstimateSetSmoothingPoolRegistrationStateGas(rp, status, opts)
 	if err == nil {
 		response.GasInfo = gasInfo
+		response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	}
 
 	return &response, err
@@ -117,6 +121,9 @@ func canSetSmoothingPoolStatus(c *cli.Context, status bool) (*api.CanSetSmoothin
 func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolRegistrationStatusResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
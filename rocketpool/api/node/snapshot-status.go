@@ -0,0 +1,153 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getSnapshots(c *cli.Context) (*api.NodeSnapshotsResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	store, err := state.NewSnapshotStoreFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeSnapshotsResponse{}
+	response.Snapshots, err = store.List()
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+
+}
+
+func getSnapshotStatus(c *cli.Context, slot uint64, block uint64) (*api.NodeSnapshotStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	store, err := state.NewSnapshotStoreFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the snapshot
+	var networkState *state.NetworkState
+	if block != 0 {
+		networkState, err = store.GetStateAtBlock(block)
+	} else {
+		networkState, err = store.GetStateAtSlot(slot)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	genesisTime := time.Unix(int64(networkState.BeaconConfig.GenesisTime), 0)
+	response := api.NodeSnapshotStatusResponse{
+		Slot:           networkState.BeaconSlotNumber,
+		Block:          networkState.ElBlockNumber,
+		Time:           genesisTime.Add(time.Duration(networkState.BeaconSlotNumber*networkState.BeaconConfig.SecondsPerSlot) * time.Second),
+		AccountAddress: nodeAccount.Address,
+		RplPrice:       networkState.NetworkDetails.RplPrice,
+		Minipools:      []api.SnapshotMinipoolDetails{},
+	}
+
+	// Get the node details
+	nd, exists := networkState.NodeDetailsByAddress[nodeAccount.Address]
+	if !exists {
+		return nil, fmt.Errorf("the snapshot for slot %d doesn't include node %s", networkState.BeaconSlotNumber, nodeAccount.Address.Hex())
+	}
+	response.Registered = nd.Exists
+	if !nd.Exists {
+		return &response, nil
+	}
+	response.WithdrawalAddress = nd.WithdrawalAddress
+	response.PendingWithdrawalAddress = nd.PendingWithdrawalAddress
+	response.TimezoneLocation = nd.TimezoneLocation
+	response.AccountBalances.ETH = nd.BalanceETH
+	response.AccountBalances.RPL = nd.BalanceRPL
+	response.AccountBalances.RETH = nd.BalanceRETH
+	response.AccountBalances.FixedSupplyRPL = nd.BalanceOldRPL
+	response.DepositCreditBalance = nd.DepositCreditBalance
+	response.RplStake = nd.RplStake
+	response.EffectiveRplStake = nd.EffectiveRPLStake
+	response.MinimumRplStake = nd.MinimumRPLStake
+	response.MaximumRplStake = nd.MaximumRPLStake
+	response.EthMatched = nd.EthMatched
+	response.EthMatchedLimit = nd.EthMatchedLimit
+	response.SmoothingPoolRegistered = nd.SmoothingPoolRegistrationState
+	response.FeeDistributorAddress = nd.FeeDistributorAddress
+	response.FeeDistributorBalance = nd.DistributorBalance
+
+	// Get the collateral ratios, including pending bond reductions
+	borrowedEth, bondedEth := networkState.GetPendingBorrowedAndBondedEth(nodeAccount.Address)
+	projection := rputils.ProjectCollateral(nd.RplStake, borrowedEth, bondedEth, networkState.NetworkDetails.RplPrice, networkState.NetworkDetails.MinCollateralFraction, networkState.NetworkDetails.MaxCollateralFraction, 0)
+	response.BorrowedCollateralRatio = projection.BorrowedCollateralRatio
+	response.BondedCollateralRatio = projection.BondedCollateralRatio
+
+	// Get the minipool details
+	epoch := networkState.BeaconSlotNumber / networkState.BeaconConfig.SlotsPerEpoch
+	for _, mpd := range networkState.MinipoolDetailsByNode[nodeAccount.Address] {
+		details := api.SnapshotMinipoolDetails{
+			Address:            mpd.MinipoolAddress,
+			ValidatorPubkey:    mpd.Pubkey,
+			Status:             mpd.Status,
+			StatusTime:         time.Unix(mpd.StatusTime.Int64(), 0),
+			Finalised:          mpd.Finalised,
+			NodeFee:            eth.WeiToEth(mpd.NodeFee),
+			NodeDepositBalance: mpd.NodeDepositBalance,
+			UserDepositBalance: mpd.UserDepositBalance,
+			Balance:            mpd.Balance,
+			NodeShareOfBalance: mpd.NodeShareOfBalance,
+		}
+		validator, exists := networkState.ValidatorDetails[mpd.Pubkey]
+		if exists && validator.Exists {
+			details.Validator = api.ValidatorDetails{
+				Exists:      true,
+				Active:      validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch,
+				Index:       validator.Index,
+				Balance:     eth.GweiToWei(float64(validator.Balance)),
+				NodeBalance: mpd.NodeShareOfBeaconBalance,
+			}
+		} else {
+			details.Validator.Balance = big.NewInt(0)
+			details.Validator.NodeBalance = big.NewInt(0)
+		}
+		response.Minipools = append(response.Minipools, details)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -25,6 +25,9 @@ import (
 func estimateSetSnapshotDelegateGas(c *cli.Context, address common.Address) (*api.EstimateSetSnapshotDelegateGasResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -36,7 +39,7 @@ func estimateSetSnapshotDelegateGas(c *cli.Context, address common.Address) (*ap
 	if err != nil {
 		return nil, err
 	}
-	ec, err := services.GetEthClient(c)
+	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
 	}
@@ -63,10 +66,10 @@ func estimateSetSnapshotDelegateGas(c *cli.Context, address common.Address) (*ap
 		return nil, err
 	}
 	contract := &rocketpool.Contract{
-		Contract: bind.NewBoundContract(snapshotDelegationAddress, snapshotDelegationAbi, ec, ec, ec),
+		Contract: bind.NewBoundContract(snapshotDelegationAddress, snapshotDelegationAbi, rp.Client, rp.Client, rp.Client),
 		Address:  &snapshotDelegationAddress,
 		ABI:      &snapshotDelegationAbi,
-		Client:   ec,
+		Client:   rp.Client,
 	}
 
 	// Create the ID hash
@@ -78,6 +81,7 @@ func estimateSetSnapshotDelegateGas(c *cli.Context, address common.Address) (*ap
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	return &response, nil
@@ -87,6 +91,9 @@ func estimateSetSnapshotDelegateGas(c *cli.Context, address common.Address) (*ap
 func setSnapshotDelegate(c *cli.Context, address common.Address) (*api.SetSnapshotDelegateResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -139,6 +146,9 @@ func setSnapshotDelegate(c *cli.Context, address common.Address) (*api.SetSnapsh
 func estimateClearSnapshotDelegateGas(c *cli.Context) (*api.EstimateClearSnapshotDelegateGasResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -150,7 +160,7 @@ func estimateClearSnapshotDelegateGas(c *cli.Context) (*api.EstimateClearSnapsho
 	if err != nil {
 		return nil, err
 	}
-	ec, err := services.GetEthClient(c)
+	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
 	}
@@ -177,10 +187,10 @@ func estimateClearSnapshotDelegateGas(c *cli.Context) (*api.EstimateClearSnapsho
 		return nil, err
 	}
 	contract := &rocketpool.Contract{
-		Contract: bind.NewBoundContract(snapshotDelegationAddress, snapshotDelegationAbi, ec, ec, ec),
+		Contract: bind.NewBoundContract(snapshotDelegationAddress, snapshotDelegationAbi, rp.Client, rp.Client, rp.Client),
 		Address:  &snapshotDelegationAddress,
 		ABI:      &snapshotDelegationAbi,
-		Client:   ec,
+		Client:   rp.Client,
 	}
 
 	// Create the ID hash
@@ -192,6 +202,7 @@ func estimateClearSnapshotDelegateGas(c *cli.Context) (*api.EstimateClearSnapsho
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	return &response, nil
@@ -201,6 +212,9 @@ func estimateClearSnapshotDelegateGas(c *cli.Context) (*api.EstimateClearSnapsho
 func clearSnapshotDelegate(c *cli.Context) (*api.ClearSnapshotDelegateResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
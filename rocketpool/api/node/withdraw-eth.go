@@ -17,6 +17,9 @@ import (
 func canNodeWithdrawEth(c *cli.Context, amountWei *big.Int) (*api.CanNodeWithdrawEthResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -74,10 +77,18 @@ func canNodeWithdrawEth(c *cli.Context, amountWei *big.Int) (*api.CanNodeWithdra
 			return err
 		}
 		gasInfo, err := node.EstimateWithdrawEthGas(rp, nodeAccount.Address, amountWei, opts)
-		if err == nil {
-			response.GasInfo = gasInfo
+		if err != nil {
+			return err
 		}
-		return err
+		response.GasInfo = gasInfo
+
+		// Simulate the withdrawal
+		rocketNodeDeposit, err := rp.GetContract("rocketNodeDeposit", nil)
+		if err != nil {
+			return err
+		}
+		response.Simulation = eth1.SimulateTransaction(c, rp, opts, rocketNodeDeposit, eth1.GetSimulationAddresses(rp, nodeAccount.Address), "withdrawEth", nodeAccount.Address, amountWei)
+		return nil
 	})
 
 	// Wait for data
@@ -98,6 +109,9 @@ func canNodeWithdrawEth(c *cli.Context, amountWei *big.Int) (*api.CanNodeWithdra
 func nodeWithdrawEth(c *cli.Context, amountWei *big.Int) (*api.NodeWithdrawRplResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -21,6 +21,9 @@ import (
 func canNodeWithdrawRpl(c *cli.Context, amountWei *big.Int) (*api.CanNodeWithdrawRplResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -138,10 +141,18 @@ func canNodeWithdrawRpl(c *cli.Context, amountWei *big.Int) (*api.CanNodeWithdra
 			return err
 		}
 		gasInfo, err := node.EstimateWithdrawRPLGas(rp, nodeAccount.Address, amountWei, opts)
-		if err == nil {
-			response.GasInfo = gasInfo
+		if err != nil {
+			return err
 		}
-		return err
+		response.GasInfo = gasInfo
+
+		// Simulate the withdrawal
+		rocketNodeStaking, err := rp.GetContract("rocketNodeStaking", nil)
+		if err != nil {
+			return err
+		}
+		response.Simulation = eth1.SimulateTransaction(c, rp, opts, rocketNodeStaking, eth1.GetSimulationAddresses(rp, nodeAccount.Address), "withdrawRPL", nodeAccount.Address, amountWei)
+		return nil
 	})
 
 	// Wait for data
@@ -168,6 +179,9 @@ func canNodeWithdrawRpl(c *cli.Context, amountWei *big.Int) (*api.CanNodeWithdra
 func nodeWithdrawRpl(c *cli.Context, amountWei *big.Int) (*api.NodeWithdrawRplResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
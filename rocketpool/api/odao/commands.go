@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -47,7 +47,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getMembers(c))
+					api.GetResponsePrinter(c).PrintResponse(getMembers(c))
 					return nil
 
 				},
@@ -66,7 +66,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposals(c))
+					api.GetResponsePrinter(c).PrintResponse(getProposals(c))
 					return nil
 
 				},
@@ -90,7 +90,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposal(c, id))
+					api.GetResponsePrinter(c).PrintResponse(getProposal(c, id))
 					return nil
 
 				},
@@ -116,7 +116,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
+					api.GetResponsePrinter(c).PrintResponse(canProposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
 					return nil
 
 				},
@@ -142,7 +142,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
+					api.GetResponsePrinter(c).PrintResponse(proposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
 					return nil
 
 				},
@@ -160,7 +160,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeLeave(c))
+					api.GetResponsePrinter(c).PrintResponse(canProposeLeave(c))
 					return nil
 
 				},
@@ -178,7 +178,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeLeave(c))
+					api.GetResponsePrinter(c).PrintResponse(proposeLeave(c))
 					return nil
 
 				},
@@ -204,7 +204,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeKick(c, memberAddress, fineAmountWei))
+					api.GetResponsePrinter(c).PrintResponse(canProposeKick(c, memberAddress, fineAmountWei))
 					return nil
 
 				},
@@ -230,7 +230,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeKick(c, memberAddress, fineAmountWei))
+					api.GetResponsePrinter(c).PrintResponse(proposeKick(c, memberAddress, fineAmountWei))
 					return nil
 
 				},
@@ -252,7 +252,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canCancelProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canCancelProposal(c, proposalId))
 					return nil
 
 				},
@@ -274,7 +274,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(cancelProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(cancelProposal(c, proposalId))
 					return nil
 
 				},
@@ -296,7 +296,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canVoteOnProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canVoteOnProposal(c, proposalId))
 					return nil
 
 				},
@@ -322,7 +322,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(voteOnProposal(c, proposalId, support))
+					api.GetResponsePrinter(c).PrintResponse(voteOnProposal(c, proposalId, support))
 					return nil
 
 				},
@@ -344,7 +344,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExecuteProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canExecuteProposal(c, proposalId))
 					return nil
 
 				},
@@ -366,7 +366,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(executeProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(executeProposal(c, proposalId))
 					return nil
 
 				},
@@ -384,7 +384,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canJoin(c))
+					api.GetResponsePrinter(c).PrintResponse(canJoin(c))
 					return nil
 
 				},
@@ -402,7 +402,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(approveRpl(c))
+					api.GetResponsePrinter(c).PrintResponse(approveRpl(c))
 					return nil
 
 				},
@@ -424,7 +424,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(waitForApprovalAndJoin(c, hash))
+					api.GetResponsePrinter(c).PrintResponse(waitForApprovalAndJoin(c, hash))
 					return nil
 
 				},
@@ -442,7 +442,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canLeave(c))
+					api.GetResponsePrinter(c).PrintResponse(canLeave(c))
 					return nil
 
 				},
@@ -464,7 +464,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(leave(c, bondRefundAddress))
+					api.GetResponsePrinter(c).PrintResponse(leave(c, bondRefundAddress))
 					return nil
 
 				},
@@ -486,7 +486,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingMembersQuorum(c, quorum))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingMembersQuorum(c, quorum))
 					return nil
 
 				},
@@ -507,7 +507,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingMembersQuorum(c, quorum))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingMembersQuorum(c, quorum))
 					return nil
 
 				},
@@ -529,7 +529,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingMembersRplBond(c, bondAmountWei))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingMembersRplBond(c, bondAmountWei))
 					return nil
 
 				},
@@ -550,7 +550,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingMembersRplBond(c, bondAmountWei))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingMembersRplBond(c, bondAmountWei))
 					return nil
 
 				},
@@ -572,7 +572,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
 					return nil
 
 				},
@@ -593,7 +593,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
 					return nil
 
 				},
@@ -615,7 +615,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalCooldown(c, proposalCooldownBlocks))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingProposalCooldown(c, proposalCooldownBlocks))
 					return nil
 
 				},
@@ -636,7 +636,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalCooldown(c, proposalCooldownBlocks))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingProposalCooldown(c, proposalCooldownBlocks))
 					return nil
 
 				},
@@ -658,7 +658,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
 					return nil
 
 				},
@@ -679,7 +679,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
 					return nil
 
 				},
@@ -701,7 +701,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
 					return nil
 
 				},
@@ -722,7 +722,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
 					return nil
 
 				},
@@ -744,7 +744,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
 					return nil
 
 				},
@@ -765,7 +765,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
 					return nil
 
 				},
@@ -787,7 +787,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalActionTimespan(c, proposalActionTimespan))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingProposalActionTimespan(c, proposalActionTimespan))
 					return nil
 
 				},
@@ -808,7 +808,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalActionTimespan(c, proposalActionTimespan))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingProposalActionTimespan(c, proposalActionTimespan))
 					return nil
 
 				},
@@ -830,7 +830,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingScrubPeriod(c, scrubPeriod))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -851,7 +851,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingScrubPeriod(c, scrubPeriod))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -873,7 +873,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingPromotionScrubPeriod(c, scrubPeriod))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingPromotionScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -894,7 +894,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingPromotionScrubPeriod(c, scrubPeriod))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingPromotionScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -916,7 +916,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingScrubPenaltyEnabled(c, enabled))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingScrubPenaltyEnabled(c, enabled))
 					return nil
 
 				},
@@ -937,7 +937,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingScrubPenaltyEnabled(c, enabled))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingScrubPenaltyEnabled(c, enabled))
 					return nil
 
 				},
@@ -959,7 +959,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingBondReductionWindowStart(c, windowStart))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingBondReductionWindowStart(c, windowStart))
 					return nil
 
 				},
@@ -980,7 +980,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingBondReductionWindowStart(c, windowStart))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingBondReductionWindowStart(c, windowStart))
 					return nil
 
 				},
@@ -1002,7 +1002,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingBondReductionWindowLength(c, windowLength))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSettingBondReductionWindowLength(c, windowLength))
 					return nil
 
 				},
@@ -1023,7 +1023,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingBondReductionWindowLength(c, windowLength))
+					api.GetResponsePrinter(c).PrintResponse(proposeSettingBondReductionWindowLength(c, windowLength))
 					return nil
 
 				},
@@ -1036,7 +1036,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getMemberSettings(c))
+					api.GetResponsePrinter(c).PrintResponse(getMemberSettings(c))
 					return nil
 
 				},
@@ -1048,7 +1048,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getProposalSettings(c))
+					api.GetResponsePrinter(c).PrintResponse(getProposalSettings(c))
 					return nil
 
 				},
@@ -1060,7 +1060,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getMinipoolSettings(c))
+					api.GetResponsePrinter(c).PrintResponse(getMinipoolSettings(c))
 					return nil
 
 				},
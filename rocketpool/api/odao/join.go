@@ -20,6 +20,9 @@ import (
 func canJoin(c *cli.Context) (*api.CanJoinTNDAOResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -109,6 +112,9 @@ func canJoin(c *cli.Context) (*api.CanJoinTNDAOResponse, error) {
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Check data
 	response.InsufficientRplBalance = (nodeRplBalance.Cmp(rplBondAmount) < 0)
 
@@ -121,6 +127,9 @@ func canJoin(c *cli.Context) (*api.CanJoinTNDAOResponse, error) {
 func approveRpl(c *cli.Context) (*api.JoinTNDAOApproveResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -184,6 +193,9 @@ func approveRpl(c *cli.Context) (*api.JoinTNDAOApproveResponse, error) {
 func waitForApprovalAndJoin(c *cli.Context, hash common.Hash) (*api.JoinTNDAOJoinResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -16,6 +16,9 @@ import (
 func canLeave(c *cli.Context) (*api.CanLeaveTNDAOResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
@@ -74,6 +77,9 @@ func canLeave(c *cli.Context) (*api.CanLeaveTNDAOResponse, error) {
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanLeave = !(response.ProposalExpired || response.InsufficientMembers)
 	return &response, nil
@@ -83,6 +89,9 @@ func canLeave(c *cli.Context) (*api.CanLeaveTNDAOResponse, error) {
 func leave(c *cli.Context, bondRefundAddress common.Address) (*api.LeaveTNDAOResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
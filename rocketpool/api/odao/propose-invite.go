@@ -16,6 +16,9 @@ import (
 func canProposeInvite(c *cli.Context, memberAddress common.Address, memberId, memberUrl string) (*api.CanProposeTNDAOInviteResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
@@ -75,6 +78,9 @@ func canProposeInvite(c *cli.Context, memberAddress common.Address, memberId, me
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanPropose = !(response.ProposalCooldownActive || response.MemberAlreadyExists)
 	return &response, nil
@@ -84,6 +90,9 @@ func canProposeInvite(c *cli.Context, memberAddress common.Address, memberId, me
 func proposeInvite(c *cli.Context, memberAddress common.Address, memberId, memberUrl string) (*api.ProposeTNDAOInviteResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
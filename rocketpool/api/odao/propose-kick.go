@@ -19,6 +19,9 @@ import (
 func canProposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei *big.Int) (*api.CanProposeTNDAOKickResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
@@ -86,6 +89,9 @@ func canProposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanPropose = !(response.ProposalCooldownActive || response.InsufficientRplBond)
 	return &response, nil
@@ -95,6 +101,9 @@ func canProposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei
 func proposeKick(c *cli.Context, memberAddress common.Address, fineAmountWei *big.Int) (*api.ProposeTNDAOKickResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
@@ -15,6 +15,9 @@ import (
 func canProposeLeave(c *cli.Context) (*api.CanProposeTNDAOLeaveResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
@@ -86,6 +89,9 @@ func canProposeLeave(c *cli.Context) (*api.CanProposeTNDAOLeaveResponse, error)
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanPropose = !(response.ProposalCooldownActive || response.InsufficientMembers)
 	return &response, nil
@@ -95,6 +101,9 @@ func canProposeLeave(c *cli.Context) (*api.CanProposeTNDAOLeaveResponse, error)
 func proposeLeave(c *cli.Context) (*api.ProposeTNDAOLeaveResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeTrusted(c); err != nil {
 		return nil, err
 	}
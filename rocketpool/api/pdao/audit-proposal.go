@@ -0,0 +1,129 @@
+package pdao
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/rocket-pool/rocketpool-go/dao/protocol"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func auditProposal(c *cli.Context, proposalID uint64) (*api.PDAOAuditProposalResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOAuditProposalResponse{
+		SmartnodeVersion: shared.RocketPoolVersion,
+		Network:          fmt.Sprint(cfg.Smartnode.Network.Value),
+		ProposalID:       proposalID,
+		Submissions:      []api.PDAORootSubmissionAudit{},
+	}
+
+	// Get the proposal
+	proposalCount, err := protocol.GetTotalProposalCount(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposal count: %w", err)
+	}
+	if proposalID == 0 || proposalID > proposalCount {
+		return nil, fmt.Errorf("proposal %d does not exist", proposalID)
+	}
+	prop, err := protocol.GetProposalDetails(rp, proposalID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposal %d: %w", proposalID, err)
+	}
+	response.Message = prop.Message
+	response.State = prop.State
+	response.Proposer = prop.ProposerAddress
+	response.TargetBlock = prop.TargetBlock
+
+	// Rebuild the network tree for the proposal block and compare its root with the one on-chain
+	propMgr, err := proposals.NewProposalManager(nil, cfg, rp, bc)
+	if err != nil {
+		return nil, err
+	}
+	networkTree, err := propMgr.GetNetworkTree(prop.TargetBlock, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network tree for block %d: %w", prop.TargetBlock, err)
+	}
+	response.LocalRoot = *networkTree.Nodes[0]
+	response.OnchainRoot, err = protocol.GetNode(rp, proposalID, 1, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting root node for proposal %d: %w", proposalID, err)
+	}
+	response.RootMatches = response.OnchainRoot.Hash == response.LocalRoot.Hash && response.OnchainRoot.Sum.Cmp(response.LocalRoot.Sum) == 0
+
+	// Get the window of blocks to scan, from the proposal's creation to the head of the chain
+	beaconCfg, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	startBlock, err := getElBlockForTimestamp(bc, beaconCfg, prop.CreatedTime)
+	if err != nil {
+		return nil, err
+	}
+	latestBlock, err := ec.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest block number: %w", err)
+	}
+	endBlock := big.NewInt(0).SetUint64(latestBlock)
+	response.StartBlock = startBlock.Uint64()
+	response.EndBlock = latestBlock
+
+	// Get every root submitted for the proposal, including the proposal's own root and all challenge responses
+	intervalSize := big.NewInt(int64(cfg.Geth.EventLogInterval))
+	verifierAddresses := cfg.Smartnode.GetPreviousRocketDAOProtocolVerifierAddresses()
+	events, err := protocol.GetRootSubmittedEvents(rp, []uint64{proposalID}, intervalSize, startBlock, endBlock, verifierAddresses, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error scanning for RootSubmitted events: %w", err)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Index.Cmp(events[j].Index) < 0
+	})
+
+	// Check each one against the local trees
+	for _, event := range events {
+		audit := propMgr.AuditRootSubmission(event)
+		state, err := protocol.GetChallengeState(rp, proposalID, audit.Index, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting challenge state for proposal %d, index %d: %w", proposalID, audit.Index, err)
+		}
+		response.Submissions = append(response.Submissions, api.PDAORootSubmissionAudit{
+			RootSubmissionAudit: *audit,
+			ChallengeState:      state,
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
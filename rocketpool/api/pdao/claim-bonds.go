@@ -16,6 +16,9 @@ import (
 
 func canClaimBonds(c *cli.Context, proposalId uint64, indices []uint64) (*api.PDAOCanClaimBondsResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -101,11 +104,15 @@ func canClaimBonds(c *cli.Context, proposalId uint64, indices []uint64) (*api.PD
 
 	// Update & return response
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	return &response, nil
 }
 
 func claimBonds(c *cli.Context, isProposer bool, proposalId uint64, indices []uint64) (*api.PDAOClaimBondsResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package pdao
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -30,7 +31,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposals(c))
+					api.GetResponsePrinter(c).PrintResponse(getProposals(c))
 					return nil
 
 				},
@@ -54,7 +55,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposal(c, id))
+					api.GetResponsePrinter(c).PrintResponse(getProposal(c, id))
 					return nil
 
 				},
@@ -80,7 +81,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canVoteOnProposal(c, proposalId, voteDir))
+					api.GetResponsePrinter(c).PrintResponse(canVoteOnProposal(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -106,7 +107,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(voteOnProposal(c, proposalId, voteDir))
+					api.GetResponsePrinter(c).PrintResponse(voteOnProposal(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -132,7 +133,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canOverrideVote(c, proposalId, voteDir))
+					api.GetResponsePrinter(c).PrintResponse(canOverrideVote(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -157,7 +158,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(overrideVote(c, proposalId, voteDir))
+					api.GetResponsePrinter(c).PrintResponse(overrideVote(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -179,7 +180,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExecuteProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canExecuteProposal(c, proposalId))
 					return nil
 
 				},
@@ -201,7 +202,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(executeProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(executeProposal(c, proposalId))
 					return nil
 
 				},
@@ -214,7 +215,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getSettings(c))
+					api.GetResponsePrinter(c).PrintResponse(getSettings(c))
 					return nil
 
 				},
@@ -235,7 +236,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					value := c.Args().Get(2)
 
 					// Run
-					api.PrintResponse(canProposeSetting(c, contractName, settingName, value))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSetting(c, contractName, settingName, value))
 					return nil
 
 				},
@@ -259,7 +260,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSetting(c, contractName, settingName, value, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeSetting(c, contractName, settingName, value, blockNumber))
 					return nil
 
 				},
@@ -277,7 +278,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRewardsPercentages(c))
+					api.GetResponsePrinter(c).PrintResponse(getRewardsPercentages(c))
 					return nil
 
 				},
@@ -306,7 +307,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeRewardsPercentages(c, node, odao, pdao))
+					api.GetResponsePrinter(c).PrintResponse(canProposeRewardsPercentages(c, node, odao, pdao))
 					return nil
 
 				},
@@ -339,7 +340,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeRewardsPercentages(c, node, odao, pdao, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeRewardsPercentages(c, node, odao, pdao, blockNumber))
 					return nil
 
 				},
@@ -366,7 +367,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeOneTimeSpend(c, invoiceID, recipient, amount))
+					api.GetResponsePrinter(c).PrintResponse(canProposeOneTimeSpend(c, invoiceID, recipient, amount))
 					return nil
 
 				},
@@ -396,7 +397,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeOneTimeSpend(c, invoiceID, recipient, amount, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeOneTimeSpend(c, invoiceID, recipient, amount, blockNumber))
 					return nil
 
 				},
@@ -435,7 +436,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods))
+					api.GetResponsePrinter(c).PrintResponse(canProposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods))
 					return nil
 
 				},
@@ -477,7 +478,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods, blockNumber))
 					return nil
 
 				},
@@ -512,7 +513,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods))
+					api.GetResponsePrinter(c).PrintResponse(canProposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods))
 					return nil
 
 				},
@@ -550,7 +551,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods, blockNumber))
 					return nil
 
 				},
@@ -573,7 +574,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeInviteToSecurityCouncil(c, id, address))
+					api.GetResponsePrinter(c).PrintResponse(canProposeInviteToSecurityCouncil(c, id, address))
 					return nil
 
 				},
@@ -599,7 +600,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeInviteToSecurityCouncil(c, id, address, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeInviteToSecurityCouncil(c, id, address, blockNumber))
 					return nil
 
 				},
@@ -621,7 +622,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeKickFromSecurityCouncil(c, address))
+					api.GetResponsePrinter(c).PrintResponse(canProposeKickFromSecurityCouncil(c, address))
 					return nil
 
 				},
@@ -646,7 +647,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeKickFromSecurityCouncil(c, address, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeKickFromSecurityCouncil(c, address, blockNumber))
 					return nil
 
 				},
@@ -668,7 +669,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeKickMultiFromSecurityCouncil(c, addresses))
+					api.GetResponsePrinter(c).PrintResponse(canProposeKickMultiFromSecurityCouncil(c, addresses))
 					return nil
 
 				},
@@ -693,7 +694,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeKickMultiFromSecurityCouncil(c, addresses, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeKickMultiFromSecurityCouncil(c, addresses, blockNumber))
 					return nil
 
 				},
@@ -720,7 +721,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress))
+					api.GetResponsePrinter(c).PrintResponse(canProposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress))
 					return nil
 
 				},
@@ -750,7 +751,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress, blockNumber))
+					api.GetResponsePrinter(c).PrintResponse(proposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress, blockNumber))
 					return nil
 
 				},
@@ -768,7 +769,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getClaimableBonds(c))
+					api.GetResponsePrinter(c).PrintResponse(getClaimableBonds(c))
 					return nil
 
 				},
@@ -794,7 +795,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canClaimBonds(c, proposalId, indices))
+					api.GetResponsePrinter(c).PrintResponse(canClaimBonds(c, proposalId, indices))
 					return nil
 
 				},
@@ -823,7 +824,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(claimBonds(c, isProposer, proposalId, indices))
+					api.GetResponsePrinter(c).PrintResponse(claimBonds(c, isProposer, proposalId, indices))
 					return nil
 
 				},
@@ -849,7 +850,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDefeatProposal(c, proposalId, index))
+					api.GetResponsePrinter(c).PrintResponse(canDefeatProposal(c, proposalId, index))
 					return nil
 
 				},
@@ -874,7 +875,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(defeatProposal(c, proposalId, index))
+					api.GetResponsePrinter(c).PrintResponse(defeatProposal(c, proposalId, index))
 					return nil
 
 				},
@@ -896,7 +897,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canFinalizeProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canFinalizeProposal(c, proposalId))
 					return nil
 
 				},
@@ -917,7 +918,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(finalizeProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(finalizeProposal(c, proposalId))
 					return nil
 
 				},
@@ -935,7 +936,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeInitializeVoting(c))
+					api.GetResponsePrinter(c).PrintResponse(canNodeInitializeVoting(c))
 					return nil
 
 				},
@@ -953,7 +954,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeInitializedVoting(c))
+					api.GetResponsePrinter(c).PrintResponse(nodeInitializedVoting(c))
 					return nil
 
 				},
@@ -975,7 +976,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(estimateSetVotingDelegateGas(c, delegate))
+					api.GetResponsePrinter(c).PrintResponse(estimateSetVotingDelegateGas(c, delegate))
 					return nil
 
 				},
@@ -997,7 +998,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setVotingDelegate(c, delegate))
+					api.GetResponsePrinter(c).PrintResponse(setVotingDelegate(c, delegate))
 					return nil
 
 				},
@@ -1014,7 +1015,45 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getCurrentVotingDelegate(c))
+					api.GetResponsePrinter(c).PrintResponse(getCurrentVotingDelegate(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "voting-policy",
+				Usage:     "Evaluate the node's voting policy against the proposals that haven't finished voting",
+				UsageText: "rocketpool api pdao voting-policy",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getVotingPolicy(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "audit-proposal",
+				Usage:     "Compare every root submitted for a proposal with the locally generated voting trees",
+				UsageText: "rocketpool api pdao audit-proposal proposal-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					proposalId, err := cliutils.ValidatePositiveUint("proposal ID", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(auditProposal(c, proposalId))
 					return nil
 
 				},
@@ -1026,7 +1065,72 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getVotePower(c))
+					api.GetResponsePrinter(c).PrintResponse(getVotePower(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "simulate-voting-power",
+				Usage:     "Recalculate the node's voting power at a block with hypothetical changes to its stake, minipools and delegations",
+				UsageText: "rocketpool api pdao simulate-voting-power block-number additional-rpl new-minipools new-minipool-bond bond-reductions new-delegators",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 6); err != nil {
+						return err
+					}
+					blockNumber, err := cliutils.ValidateUint32("block-number", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					additionalRpl, err := cliutils.ValidatePositiveOrZeroWeiAmount("additional-rpl", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+					newMinipools, err := cliutils.ValidateUint("new-minipools", c.Args().Get(2))
+					if err != nil {
+						return err
+					}
+					newMinipoolBond, err := cliutils.ValidatePositiveWeiAmount("new-minipool-bond", c.Args().Get(3))
+					if err != nil {
+						return err
+					}
+					bondReductions, err := cliutils.ValidateUint("bond-reductions", c.Args().Get(4))
+					if err != nil {
+						return err
+					}
+					var newDelegators []common.Address
+					if c.Args().Get(5) != "" {
+						newDelegators, err = cliutils.ValidateAddresses("new-delegators", c.Args().Get(5))
+						if err != nil {
+							return err
+						}
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(simulateVotingPower(c, blockNumber, additionalRpl, newMinipools, newMinipoolBond, bondReductions, newDelegators))
+					return nil
+
+				},
+			},
+			{
+				Name:      "delegation-graph",
+				Usage:     "Get the nodes that delegate their voting power to this node and the node it delegates to at a block",
+				UsageText: "rocketpool api pdao delegation-graph block-number",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					blockNumber, err := cliutils.ValidateUint32("block-number", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(getDelegationGraph(c, blockNumber))
 					return nil
 
 				},
@@ -0,0 +1,131 @@
+package pdao
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getDelegationGraph(c *cli.Context, blockNumber uint32) (*api.PDAODelegationGraphResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAODelegationGraphResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.NodeAddress = nodeAccount.Address
+
+	// Get the voting power and delegate of every node
+	response.BlockNumber, err = getVotingBlockNumber(rp, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := getVotingInfos(rp, cfg, response.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the node's part of the graph
+	delegators, delegatedPower, totalPower := getDelegators(infos, nodeAccount.Address)
+	response.Delegators = make([]api.PDAODelegator, len(delegators))
+	for i, info := range delegators {
+		response.Delegators[i] = api.PDAODelegator{
+			Address:          info.NodeAddress,
+			AddressFormatted: formatResolvedAddress(c, info.NodeAddress),
+			VotingPower:      info.VotingPower,
+		}
+	}
+	response.DelegatedVotingPower = delegatedPower
+	response.TotalVotingPower = totalPower
+	response.VotingPower = big.NewInt(0)
+	response.Delegate = nodeAccount.Address
+	for _, info := range infos {
+		if info.NodeAddress == nodeAccount.Address {
+			response.VotingPower = info.VotingPower
+			response.Delegate = info.Delegate
+		}
+	}
+	response.DelegateFormatted = formatResolvedAddress(c, response.Delegate)
+	_, response.DelegateVotingPower, _ = getDelegators(infos, response.Delegate)
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the block to check voting power at, defaulting to the latest block
+func getVotingBlockNumber(rp *rocketpool.RocketPool, blockNumber uint32) (uint32, error) {
+	latestBlock, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("error getting latest block number: %w", err)
+	}
+	if blockNumber == 0 {
+		return uint32(latestBlock), nil
+	}
+	if uint64(blockNumber) > latestBlock {
+		return 0, fmt.Errorf("block %d is after the latest block (%d)", blockNumber, latestBlock)
+	}
+	return blockNumber, nil
+}
+
+// Get the voting power and delegate of every node at a block, like the voting info snapshots used for proposals
+func getVotingInfos(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, blockNumber uint32) ([]types.NodeVotingInfo, error) {
+	multicallAddress := common.HexToAddress(cfg.Smartnode.GetMulticallAddress())
+	infos, err := network.GetNodeInfoSnapshotFast(rp, blockNumber, multicallAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting voting info for block %d: %w", blockNumber, err)
+	}
+	return infos, nil
+}
+
+// Get the nodes that delegate to an address, sorted by voting power, along with the total power delegated to it and the total power of the network
+func getDelegators(infos []types.NodeVotingInfo, address common.Address) ([]types.NodeVotingInfo, *big.Int, *big.Int) {
+	delegators := []types.NodeVotingInfo{}
+	delegatedPower := big.NewInt(0)
+	totalPower := big.NewInt(0)
+	for _, info := range infos {
+		totalPower.Add(totalPower, info.VotingPower)
+		if info.Delegate != address {
+			continue
+		}
+		delegatedPower.Add(delegatedPower, info.VotingPower)
+		if info.NodeAddress != address {
+			delegators = append(delegators, info)
+		}
+	}
+	sort.SliceStable(delegators, func(i, j int) bool {
+		return delegators[i].VotingPower.Cmp(delegators[j].VotingPower) > 0
+	})
+	return delegators, delegatedPower, totalPower
+}
@@ -16,6 +16,9 @@ import (
 func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecutePDAOProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -73,6 +76,9 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecutePDAOP
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanExecute = !(response.DoesNotExist || response.InvalidState)
 	return &response, nil
@@ -82,6 +88,9 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecutePDAOP
 func executeProposal(c *cli.Context, proposalId uint64) (*api.ExecutePDAOProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -15,6 +15,9 @@ import (
 
 func canFinalizeProposal(c *cli.Context, proposalId uint64) (*api.PDAOCanFinalizeProposalResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -84,11 +87,15 @@ func canFinalizeProposal(c *cli.Context, proposalId uint64) (*api.PDAOCanFinaliz
 
 	// Update & return response
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	return &response, nil
 }
 
 func finalizeProposal(c *cli.Context, proposalId uint64) (*api.PDAOFinalizeProposalResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -14,6 +14,9 @@ import (
 func canNodeInitializeVoting(c *cli.Context) (*api.PDAOCanInitializeVotingResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -55,6 +58,7 @@ func canNodeInitializeVoting(c *cli.Context) (*api.PDAOCanInitializeVotingRespon
 		return nil, fmt.Errorf("Could not estimate the gas required to claim RPL: %w", err)
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	return &response, nil
 }
@@ -62,6 +66,9 @@ func canNodeInitializeVoting(c *cli.Context) (*api.PDAOCanInitializeVotingRespon
 func nodeInitializedVoting(c *cli.Context) (*api.PDAOInitializeVotingResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -13,6 +13,9 @@ import (
 
 func canProposeKickMultiFromSecurityCouncil(c *cli.Context, addresses []common.Address) (*api.PDAOCanProposeKickMultiFromSecurityCouncilResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return nil, err
@@ -53,11 +56,15 @@ func canProposeKickMultiFromSecurityCouncil(c *cli.Context, addresses []common.A
 	// Update & return response
 	response.BlockNumber = blockNumber
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	return &response, nil
 }
 
 func proposeKickMultiFromSecurityCouncil(c *cli.Context, addresses []common.Address, blockNumber uint32) (*api.PDAOProposeKickMultiFromSecurityCouncilResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return nil, err
@@ -14,6 +14,9 @@ import (
 
 func canProposeKickFromSecurityCouncil(c *cli.Context, address common.Address) (*api.PDAOCanProposeKickFromSecurityCouncilResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return nil, err
@@ -78,11 +81,15 @@ func canProposeKickFromSecurityCouncil(c *cli.Context, address common.Address) (
 	// Update & return response
 	response.BlockNumber = blockNumber
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	return &response, nil
 }
 
 func proposeKickFromSecurityCouncil(c *cli.Context, address common.Address, blockNumber uint32) (*api.PDAOProposeKickFromSecurityCouncilResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return nil, err
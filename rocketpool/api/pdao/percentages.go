@@ -51,6 +51,9 @@ func canProposeRewardsPercentages(c *cli.Context, node *big.Int, odao *big.Int,
 	}
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -120,6 +123,7 @@ func canProposeRewardsPercentages(c *cli.Context, node *big.Int, odao *big.Int,
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	return &response, nil
@@ -127,6 +131,9 @@ func canProposeRewardsPercentages(c *cli.Context, node *big.Int, odao *big.Int,
 
 func proposeRewardsPercentages(c *cli.Context, node *big.Int, odao *big.Int, pdao *big.Int, blockNumber uint32) (*api.PDAOProposeRewardsPercentagesResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
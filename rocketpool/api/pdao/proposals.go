@@ -4,7 +4,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/dao/protocol"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
@@ -79,10 +81,12 @@ func getProposalsWithNodeVoteDirection(rp *rocketpool.RocketPool, nodeAddress co
 				prop := props[pi]
 				details[pi].ProtocolDaoProposalDetails = prop
 				voteDir, err := protocol.GetAddressVoteDirection(rp, prop.ID, nodeAddress, nil)
-				if err == nil {
-					details[pi].NodeVoteDirection = voteDir
+				if err != nil {
+					return err
 				}
-				return err
+				details[pi].NodeVoteDirection = voteDir
+				decodeProposalPayload(rp, &details[pi])
+				return nil
 			})
 		}
 		if err := wg.Wait(); err != nil {
@@ -137,9 +141,31 @@ func getProposal(c *cli.Context, id uint64) (*api.PDAOProposalResponse, error) {
 		ProtocolDaoProposalDetails: proposal,
 		NodeVoteDirection:          voteDir,
 	}
+	decodeProposalPayload(rp, &augmentedProp)
 	response.Proposal = augmentedProp
 
 	// Return response
 	return &response, nil
 
 }
+
+// Decode a proposal's payload, including the current values of anything it changes if it hasn't been executed yet.
+// Anyone can submit a proposal, so problems with its payload are recorded on it instead of failing the whole request.
+func decodeProposalPayload(rp *rocketpool.RocketPool, prop *api.PDAOProposalWithNodeVoteDirection) {
+	payload, err := proposals.DecodeProposalPayload(rp, prop.Payload)
+	if err != nil {
+		prop.PayloadError = err.Error()
+		return
+	}
+	prop.DecodedPayload = payload
+
+	switch prop.State {
+	case types.ProtocolDaoProposalState_Pending,
+		types.ProtocolDaoProposalState_ActivePhase1,
+		types.ProtocolDaoProposalState_ActivePhase2,
+		types.ProtocolDaoProposalState_Succeeded:
+		if err := payload.LoadCurrentValues(rp, nil); err != nil {
+			prop.CurrentValueError = err.Error()
+		}
+	}
+}
@@ -19,6 +19,9 @@ import (
 func canProposeSetting(c *cli.Context, contractName string, settingName string, value string) (*api.CanProposePDAOSettingResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -754,6 +757,7 @@ func canProposeSetting(c *cli.Context, contractName string, settingName string,
 	if response.GasInfo == blankGasInfo {
 		return nil, fmt.Errorf("[%s - %s] is not a valid PDAO contract and setting name combo", contractName, settingName)
 	}
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Update & return response
 	return &response, nil
@@ -763,6 +767,9 @@ func canProposeSetting(c *cli.Context, contractName string, settingName string,
 func proposeSetting(c *cli.Context, contractName string, settingName string, value string, blockNumber uint32) (*api.ProposePDAOSettingResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
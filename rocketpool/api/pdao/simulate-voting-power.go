@@ -0,0 +1,239 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// The setting used to cap the RPL stake that counts towards voting power
+const votingPowerStakeMaximumPath string = "node.voting.power.stake.maximum"
+
+func simulateVotingPower(c *cli.Context, blockNumber uint32, additionalRpl *big.Int, newMinipools uint64, newMinipoolBond *big.Int, bondReductions uint64, newDelegators []common.Address) (*api.PDAOSimulateVotingPowerResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the bond size of new minipools
+	if newMinipools > 0 && newMinipoolBond.Cmp(eth.EthToWei(8)) != 0 && newMinipoolBond.Cmp(eth.EthToWei(16)) != 0 {
+		return nil, fmt.Errorf("new minipools must have a bond of 8 or 16 ETH")
+	}
+
+	// Response
+	response := api.PDAOSimulateVotingPowerResponse{
+		NewDelegators: []api.PDAODelegator{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the block
+	response.BlockNumber, err = getVotingBlockNumber(rp, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	opts := getCallOptsForBlock(response.BlockNumber)
+
+	// Sync
+	var wg errgroup.Group
+	var infos []types.NodeVotingInfo
+
+	wg.Go(func() error {
+		var err error
+		response.RplStake, err = node.GetNodeRPLStake(rp, nodeAccount.Address, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.EthMatched, err = node.GetNodeEthMatched(rp, nodeAccount.Address, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.RplPrice, err = network.GetRPLPrice(rp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		rocketDAOProtocolSettingsNode, err := rp.GetContract("rocketDAOProtocolSettingsNode", opts)
+		if err != nil {
+			return err
+		}
+		value := new(*big.Int)
+		if err := rocketDAOProtocolSettingsNode.Call(opts, value, "getSettingUint", votingPowerStakeMaximumPath); err != nil {
+			return fmt.Errorf("error getting maximum stake for voting power: %w", err)
+		}
+		response.MaxStakeFraction = *value
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		infos, err = getVotingInfos(rp, cfg, response.BlockNumber)
+		return err
+	})
+	var reducibleMinipools uint64
+	if bondReductions > 0 {
+		wg.Go(func() error {
+			var err error
+			reducibleMinipools, err = getReducibleMinipoolCount(rp, nodeAccount.Address, opts)
+			return err
+		})
+	}
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Only the node's staking 16-ETH minipools can have their bonds reduced
+	if bondReductions > reducibleMinipools {
+		return nil, fmt.Errorf("the node only has %d staking minipool(s) with a 16 ETH bond at block %d, so it can't reduce the bonds of %d", reducibleMinipools, response.BlockNumber, bondReductions)
+	}
+
+	// Get the node's current voting power
+	response.OnchainVotingPower = big.NewInt(0)
+	infosByAddress := map[common.Address]types.NodeVotingInfo{}
+	for _, info := range infos {
+		infosByAddress[info.NodeAddress] = info
+	}
+	ownInfo, exists := infosByAddress[nodeAccount.Address]
+	if exists {
+		response.OnchainVotingPower = ownInfo.VotingPower
+		response.IsSelfDelegated = ownInfo.Delegate == nodeAccount.Address
+	}
+	response.VotingPower = rputils.CalculateVotingPower(response.RplStake, response.EthMatched, response.RplPrice, response.MaxStakeFraction)
+
+	// Apply the changes; new minipools borrow whatever their bond doesn't cover, and bond reductions move 16-ETH minipools to 8 ETH
+	response.SimulatedRplStake = big.NewInt(0).Add(response.RplStake, additionalRpl)
+	response.SimulatedEthMatched = big.NewInt(0).Set(response.EthMatched)
+	if newMinipools > 0 {
+		borrowedPerMinipool := big.NewInt(0).Sub(eth.EthToWei(32), newMinipoolBond)
+		response.SimulatedEthMatched.Add(response.SimulatedEthMatched, borrowedPerMinipool.Mul(borrowedPerMinipool, big.NewInt(int64(newMinipools))))
+	}
+	if bondReductions > 0 {
+		reducedEth := big.NewInt(0).Mul(eth.EthToWei(8), big.NewInt(int64(bondReductions)))
+		response.SimulatedEthMatched.Add(response.SimulatedEthMatched, reducedEth)
+	}
+	response.SimulatedVotingPower = rputils.CalculateVotingPower(response.SimulatedRplStake, response.SimulatedEthMatched, response.RplPrice, response.MaxStakeFraction)
+
+	// Get the delegated voting power, replacing the node's own power if it votes for itself
+	_, response.DelegatedVotingPower, response.TotalVotingPower = getDelegators(infos, nodeAccount.Address)
+	response.SimulatedDelegatedVotingPower = big.NewInt(0).Set(response.DelegatedVotingPower)
+	response.SimulatedTotalVotingPower = big.NewInt(0).Sub(response.TotalVotingPower, response.OnchainVotingPower)
+	response.SimulatedTotalVotingPower.Add(response.SimulatedTotalVotingPower, response.SimulatedVotingPower)
+	if response.IsSelfDelegated {
+		response.SimulatedDelegatedVotingPower.Sub(response.SimulatedDelegatedVotingPower, response.OnchainVotingPower)
+		response.SimulatedDelegatedVotingPower.Add(response.SimulatedDelegatedVotingPower, response.SimulatedVotingPower)
+	}
+
+	// Add the new delegations
+	added := map[common.Address]bool{}
+	for _, address := range newDelegators {
+		if address == nodeAccount.Address {
+			return nil, fmt.Errorf("the node can't be one of its own new delegators")
+		}
+		if added[address] {
+			return nil, fmt.Errorf("node %s was provided as a new delegator more than once", address.Hex())
+		}
+		info, exists := infosByAddress[address]
+		if !exists {
+			return nil, fmt.Errorf("%s is not a registered node at block %d", address.Hex(), response.BlockNumber)
+		}
+		if info.Delegate == nodeAccount.Address {
+			return nil, fmt.Errorf("node %s already delegates to this node", address.Hex())
+		}
+		added[address] = true
+		response.SimulatedDelegatedVotingPower.Add(response.SimulatedDelegatedVotingPower, info.VotingPower)
+		response.NewDelegators = append(response.NewDelegators, api.PDAODelegator{
+			Address:          address,
+			AddressFormatted: formatResolvedAddress(c, address),
+			VotingPower:      info.VotingPower,
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the number of the node's staking minipools that still have a 16 ETH bond, which could be reduced to 8 ETH
+func getReducibleMinipoolCount(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	addresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAddress, opts)
+	if err != nil {
+		return 0, fmt.Errorf("error getting the node's minipools: %w", err)
+	}
+
+	// Get each minipool's status and bond
+	var wg errgroup.Group
+	reducible := make([]bool, len(addresses))
+	for i, address := range addresses {
+		i, address := i, address
+		wg.Go(func() error {
+			mp, err := minipool.NewMinipool(rp, address, opts)
+			if err != nil {
+				return fmt.Errorf("error making binding for minipool %s: %w", address.Hex(), err)
+			}
+			status, err := mp.GetStatus(opts)
+			if err != nil {
+				return fmt.Errorf("error getting status of minipool %s: %w", address.Hex(), err)
+			}
+			if status != types.Staking {
+				return nil
+			}
+			nodeDeposit, err := mp.GetNodeDepositBalance(opts)
+			if err != nil {
+				return fmt.Errorf("error getting node deposit for minipool %s: %w", address.Hex(), err)
+			}
+			reducible[i] = nodeDeposit.Cmp(eth.EthToWei(16)) == 0
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return 0, err
+	}
+
+	count := uint64(0)
+	for _, isReducible := range reducible {
+		if isReducible {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Get call options for reading state at a block
+func getCallOptsForBlock(blockNumber uint32) *bind.CallOpts {
+	return &bind.CallOpts{
+		BlockNumber: big.NewInt(int64(blockNumber)),
+	}
+}
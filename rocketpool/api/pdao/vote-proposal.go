@@ -2,6 +2,7 @@ package pdao
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/dao/protocol"
@@ -17,6 +18,9 @@ import (
 
 func canVoteOnProposal(c *cli.Context, proposalId uint64, voteDirection types.VoteDirection) (*api.CanVoteOnPDAOProposalResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return nil, err
@@ -116,12 +120,22 @@ func canVoteOnProposal(c *cli.Context, proposalId uint64, voteDirection types.Vo
 	}
 	response.GasInfo = gasInfo
 
+	// Simulate the vote
+	rocketDAOProtocolProposal, err := rp.GetContract("rocketDAOProtocolProposal", nil)
+	if err != nil {
+		return nil, err
+	}
+	response.Simulation = eth1.SimulateTransaction(c, rp, opts, rocketDAOProtocolProposal, eth1.GetSimulationAddresses(rp, nodeAccount.Address), "vote", big.NewInt(int64(proposalId)), voteDirection, totalDelegatedVP, big.NewInt(int64(nodeIndex)), proof)
+
 	// Update & return response
 	return &response, nil
 }
 
 func voteOnProposal(c *cli.Context, proposalId uint64, voteDirection types.VoteDirection) (*api.VoteOnPDAOProposalResponse, error) {
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,97 @@
+package pdao
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/rocketpool-go/dao/protocol"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getVotingPolicy(c *cli.Context) (*api.PDAOVotingPolicyResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOVotingPolicyResponse{
+		Proposals: []api.PDAOVotingPolicyProposal{},
+	}
+
+	// Load the policy
+	policy, err := proposals.LoadVotingPolicy(cfg.Smartnode.GetPdaoVotingPolicyPath())
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return &response, nil
+	}
+	response.PolicyExists = true
+	response.Policy = policy
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Evaluate the policy against the proposals that haven't finished voting
+	props, err := protocol.GetProposals(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, prop := range props {
+		if prop.State != types.ProtocolDaoProposalState_Pending &&
+			prop.State != types.ProtocolDaoProposalState_ActivePhase1 &&
+			prop.State != types.ProtocolDaoProposalState_ActivePhase2 {
+			continue
+		}
+		details := api.PDAOVotingPolicyProposal{
+			ID:      prop.ID,
+			Message: prop.Message,
+			State:   prop.State,
+		}
+		details.NodeVoteDirection, err = protocol.GetAddressVoteDirection(rp, prop.ID, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the node's vote on proposal %d: %w", prop.ID, err)
+		}
+		details.Payload, err = proposals.DecodeProposalPayload(rp, prop.Payload)
+		if err != nil {
+			details.PayloadError = err.Error()
+		}
+		delegateVote := types.VoteDirection_NoVote
+		if delegate, ok := policy.GetDelegate(); ok {
+			delegateVote, err = protocol.GetAddressVoteDirection(rp, prop.ID, delegate, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error getting the vote of policy delegate %s on proposal %d: %w", delegate.Hex(), prop.ID, err)
+			}
+		}
+		details.Decision = policy.Evaluate(details.Payload, delegateVote)
+		response.Proposals = append(response.Proposals, details)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -15,6 +15,9 @@ import (
 func estimateSetVotingDelegateGas(c *cli.Context, address common.Address) (*api.PDAOCanSetVotingDelegateResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -42,6 +45,7 @@ func estimateSetVotingDelegateGas(c *cli.Context, address common.Address) (*api.
 		return nil, err
 	}
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 
 	// Return response
 	return &response, nil
@@ -51,6 +55,9 @@ func estimateSetVotingDelegateGas(c *cli.Context, address common.Address) (*api.
 func setVotingDelegate(c *cli.Context, address common.Address) (*api.PDAOSetVotingDelegateResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
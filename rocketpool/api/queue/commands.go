@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -46,7 +46,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProcessQueue(c))
+					api.GetResponsePrinter(c).PrintResponse(canProcessQueue(c))
 					return nil
 
 				},
@@ -64,7 +64,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(processQueue(c))
+					api.GetResponsePrinter(c).PrintResponse(processQueue(c))
 					return nil
 
 				},
@@ -16,6 +16,9 @@ import (
 func canProcessQueue(c *cli.Context) (*api.CanProcessQueueResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -64,6 +67,9 @@ func canProcessQueue(c *cli.Context) (*api.CanProcessQueueResponse, error) {
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Check next minipool capacity & deposit pool balance
 	response.CanProcess = !response.AssignDepositsDisabled
 	return &response, nil
@@ -73,6 +79,9 @@ func canProcessQueue(c *cli.Context) (*api.CanProcessQueueResponse, error) {
 func processQueue(c *cli.Context) (*api.ProcessQueueResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
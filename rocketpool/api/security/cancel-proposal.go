@@ -18,6 +18,9 @@ import (
 func canCancelProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanCancelProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
@@ -85,6 +88,9 @@ func canCancelProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanCance
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanCancel = !(response.DoesNotExist || response.InvalidState || response.InvalidProposer)
 	return &response, nil
@@ -94,6 +100,9 @@ func canCancelProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanCance
 func cancelProposal(c *cli.Context, proposalId uint64) (*api.SecurityCancelProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
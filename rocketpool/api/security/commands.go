@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -46,7 +46,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getMembers(c))
+					api.GetResponsePrinter(c).PrintResponse(getMembers(c))
 					return nil
 
 				},
@@ -65,7 +65,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposals(c))
+					api.GetResponsePrinter(c).PrintResponse(getProposals(c))
 					return nil
 
 				},
@@ -89,7 +89,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposal(c, id))
+					api.GetResponsePrinter(c).PrintResponse(getProposal(c, id))
 					return nil
 
 				},
@@ -106,7 +106,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeLeave(c))
+					api.GetResponsePrinter(c).PrintResponse(canProposeLeave(c))
 					return nil
 
 				},
@@ -124,7 +124,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeLeave(c))
+					api.GetResponsePrinter(c).PrintResponse(proposeLeave(c))
 					return nil
 
 				},
@@ -141,7 +141,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canLeave(c))
+					api.GetResponsePrinter(c).PrintResponse(canLeave(c))
 					return nil
 
 				},
@@ -159,7 +159,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(leave(c))
+					api.GetResponsePrinter(c).PrintResponse(leave(c))
 					return nil
 
 				},
@@ -180,7 +180,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canCancelProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canCancelProposal(c, proposalId))
 					return nil
 
 				},
@@ -202,7 +202,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(cancelProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(cancelProposal(c, proposalId))
 					return nil
 
 				},
@@ -224,7 +224,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canVoteOnProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canVoteOnProposal(c, proposalId))
 					return nil
 
 				},
@@ -250,7 +250,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(voteOnProposal(c, proposalId, support))
+					api.GetResponsePrinter(c).PrintResponse(voteOnProposal(c, proposalId, support))
 					return nil
 
 				},
@@ -272,7 +272,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExecuteProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(canExecuteProposal(c, proposalId))
 					return nil
 
 				},
@@ -294,7 +294,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(executeProposal(c, proposalId))
+					api.GetResponsePrinter(c).PrintResponse(executeProposal(c, proposalId))
 					return nil
 
 				},
@@ -312,7 +312,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canJoin(c))
+					api.GetResponsePrinter(c).PrintResponse(canJoin(c))
 					return nil
 
 				},
@@ -330,7 +330,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(join(c))
+					api.GetResponsePrinter(c).PrintResponse(join(c))
 					return nil
 
 				},
@@ -348,7 +348,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canLeave(c))
+					api.GetResponsePrinter(c).PrintResponse(canLeave(c))
 					return nil
 
 				},
@@ -366,7 +366,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(leave(c))
+					api.GetResponsePrinter(c).PrintResponse(leave(c))
 					return nil
 
 				},
@@ -387,7 +387,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					value := c.Args().Get(2)
 
 					// Run
-					api.PrintResponse(canProposeSetting(c, contractName, settingName, value))
+					api.GetResponsePrinter(c).PrintResponse(canProposeSetting(c, contractName, settingName, value))
 					return nil
 
 				},
@@ -407,7 +407,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					value := c.Args().Get(2)
 
 					// Run
-					api.PrintResponse(proposeSetting(c, contractName, settingName, value))
+					api.GetResponsePrinter(c).PrintResponse(proposeSetting(c, contractName, settingName, value))
 					return nil
 
 				},
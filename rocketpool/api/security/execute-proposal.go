@@ -17,6 +17,9 @@ import (
 func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanExecuteProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
@@ -74,6 +77,9 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanExec
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanExecute = !(response.DoesNotExist || response.InvalidState)
 	return &response, nil
@@ -83,6 +89,9 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanExec
 func executeProposal(c *cli.Context, proposalId uint64) (*api.SecurityExecuteProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeWallet(c); err != nil {
 		return nil, err
 	}
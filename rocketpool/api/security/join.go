@@ -15,6 +15,9 @@ import (
 func canJoin(c *cli.Context) (*api.SecurityCanJoinResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
@@ -74,6 +77,9 @@ func canJoin(c *cli.Context) (*api.SecurityCanJoinResponse, error) {
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanJoin = !(response.ProposalExpired || response.AlreadyMember)
 	return &response, nil
@@ -83,6 +89,9 @@ func canJoin(c *cli.Context) (*api.SecurityCanJoinResponse, error) {
 func join(c *cli.Context) (*api.SecurityJoinResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeRegistered(c); err != nil {
 		return nil, err
 	}
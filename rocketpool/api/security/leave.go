@@ -15,6 +15,9 @@ import (
 func canLeave(c *cli.Context) (*api.SecurityCanLeaveResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
@@ -64,6 +67,9 @@ func canLeave(c *cli.Context) (*api.SecurityCanLeaveResponse, error) {
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Update & return response
 	response.CanLeave = !(response.ProposalExpired)
 	return &response, nil
@@ -73,6 +79,9 @@ func canLeave(c *cli.Context) (*api.SecurityCanLeaveResponse, error) {
 func leave(c *cli.Context) (*api.SecurityLeaveResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
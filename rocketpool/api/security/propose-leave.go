@@ -14,6 +14,9 @@ import (
 func canProposeLeave(c *cli.Context) (*api.SecurityCanProposeLeaveResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
@@ -60,6 +63,7 @@ func canProposeLeave(c *cli.Context) (*api.SecurityCanProposeLeaveResponse, erro
 
 	// Update & return response
 	response.GasInfo = gasInfo
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
 	return &response, nil
 
 }
@@ -67,6 +71,9 @@ func canProposeLeave(c *cli.Context) (*api.SecurityCanProposeLeaveResponse, erro
 func proposeLeave(c *cli.Context) (*api.SecurityProposeLeaveResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
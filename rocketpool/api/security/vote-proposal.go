@@ -17,6 +17,9 @@ import (
 func canVoteOnProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanVoteOnProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
@@ -102,6 +105,9 @@ func canVoteOnProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanVoteO
 		return nil, err
 	}
 
+	// Simulate the transaction
+	response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+
 	// Check data
 	response.JoinedAfterCreated = (memberJoinedTime >= proposalCreatedTime)
 
@@ -114,6 +120,9 @@ func canVoteOnProposal(c *cli.Context, proposalId uint64) (*api.SecurityCanVoteO
 func voteOnProposal(c *cli.Context, proposalId uint64, support bool) (*api.SecurityVoteOnProposalResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	if err := services.RequireNodeSecurityMember(c); err != nil {
 		return nil, err
 	}
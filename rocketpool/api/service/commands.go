@@ -27,7 +27,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(terminateDataFolder(c))
+					api.GetResponsePrinter(c).PrintResponse(terminateDataFolder(c))
 					return nil
 
 				},
@@ -46,7 +46,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getClientStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getClientStatus(c))
 					return nil
 
 				},
@@ -64,7 +64,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(restartVc(c))
+					api.GetResponsePrinter(c).PrintResponse(restartVc(c))
 					return nil
 
 				},
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.GetResponsePrinter(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -51,7 +51,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setPassword(c, password))
+					api.GetResponsePrinter(c).PrintResponse(setPassword(c, password))
 					return nil
 
 				},
@@ -76,7 +76,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(initWallet(c))
+					api.GetResponsePrinter(c).PrintResponse(initWallet(c))
 					return nil
 
 				},
@@ -114,7 +114,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(recoverWallet(c, mnemonic))
+					api.GetResponsePrinter(c).PrintResponse(recoverWallet(c, mnemonic))
 					return nil
 
 				},
@@ -147,7 +147,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(searchAndRecoverWallet(c, mnemonic, address))
+					api.GetResponsePrinter(c).PrintResponse(searchAndRecoverWallet(c, mnemonic, address))
 					return nil
 
 				},
@@ -166,7 +166,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(rebuildWallet(c))
+					api.GetResponsePrinter(c).PrintResponse(rebuildWallet(c))
 					return nil
 
 				},
@@ -204,7 +204,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(testRecoverWallet(c, mnemonic))
+					api.GetResponsePrinter(c).PrintResponse(testRecoverWallet(c, mnemonic))
 					return nil
 
 				},
@@ -237,7 +237,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(testSearchAndRecoverWallet(c, mnemonic, address))
+					api.GetResponsePrinter(c).PrintResponse(testSearchAndRecoverWallet(c, mnemonic, address))
 					return nil
 
 				},
@@ -256,7 +256,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(exportWallet(c))
+					api.GetResponsePrinter(c).PrintResponse(exportWallet(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "sign-tx",
+				Usage:     "Sign a transaction that was exported for offline signing",
+				UsageText: "rocketpool api wallet sign-tx exported-tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.GetResponsePrinter(c).PrintResponse(signTransaction(c, c.Args().Get(0)))
 					return nil
 
 				},
@@ -274,7 +292,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setEnsName(c, c.Args().Get(0), true))
+					api.GetResponsePrinter(c).PrintResponse(setEnsName(c, c.Args().Get(0), true))
 					return nil
 
 				},
@@ -291,7 +309,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setEnsName(c, c.Args().Get(0), false))
+					api.GetResponsePrinter(c).PrintResponse(setEnsName(c, c.Args().Get(0), false))
 					return nil
 
 				},
@@ -6,6 +6,7 @@ import (
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/urfave/cli"
 	ens "github.com/wealdtech/go-ens/v3"
 )
@@ -17,6 +18,9 @@ const (
 
 // Set a name to the node wallet's ENS reverse record.
 func setEnsName(c *cli.Context, name string, onlyEstimateGas bool) (*api.SetEnsNameResponse, error) {
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -87,6 +91,9 @@ func setEnsName(c *cli.Context, name string, onlyEstimateGas bool) (*api.SetEnsN
 	if response.GasInfo.SafeGasLimit > MaxGasLimit {
 		response.GasInfo.SafeGasLimit = MaxGasLimit
 	}
+	if onlyEstimateGas {
+		response.Simulation = eth1.SimulateEstimatedTransaction(c, rp)
+	}
 
 	return &response, nil
 }
@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func signTransaction(c *cli.Context, exportedTx string) (*api.SignTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeSigner(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SignTransactionResponse{}
+
+	// Decode the exported transaction
+	var offlineTx api.OfflineTransaction
+	if err := json.Unmarshal([]byte(exportedTx), &offlineTx); err != nil {
+		return nil, fmt.Errorf("Could not decode the exported transaction: %w", err)
+	}
+	tx := offlineTx.Transaction
+	if tx == nil {
+		return nil, fmt.Errorf("The exported transaction file doesn't contain a transaction")
+	}
+
+	// Make sure it's meant for this wallet
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if offlineTx.From != nodeAccount.Address {
+		return nil, fmt.Errorf("The transaction must be sent from %s, but this wallet's node account is %s. Only node account transactions can be signed offline; transactions from a withdrawal address must be signed by that address's own wallet.", offlineTx.From.Hex(), nodeAccount.Address.Hex())
+	}
+	if tx.Type() == types.DynamicFeeTxType && tx.ChainId().Cmp(w.GetChainID()) != 0 {
+		return nil, fmt.Errorf("The transaction is for chain %s, but this wallet is configured for chain %s", tx.ChainId().String(), w.GetChainID().String())
+	}
+
+	// Sign it
+	unsignedTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode the transaction: %w", err)
+	}
+	signedTx, err := w.Sign(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+	var decodedTx types.Transaction
+	if err := decodedTx.UnmarshalBinary(signedTx); err != nil {
+		return nil, fmt.Errorf("Could not decode the signed transaction: %w", err)
+	}
+	response.SignedTx = hexutil.Encode(signedTx)
+	response.TxHash = decodedTx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
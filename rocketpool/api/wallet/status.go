@@ -25,6 +25,17 @@ func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
 	// Get wallet status
 	response.PasswordSet = pm.IsPasswordSet()
 	response.WalletInitialized = w.IsInitialized()
+	response.WatchOnly = w.IsWatchOnly()
+
+	// Get the watched node account if there's no wallet
+	if response.WatchOnly {
+		nodeAccount, err := w.GetNodeAccount()
+		if err != nil {
+			return nil, err
+		}
+		response.AccountAddress = nodeAccount.Address
+		return &response, nil
+	}
 
 	// Get accounts if initialized
 	if response.WalletInitialized {
@@ -36,6 +47,17 @@ func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
 		}
 		response.AccountAddress = nodeAccount.Address
 
+		response.AccountExternal = w.IsNodeAccountExternal()
+
+		// Get the delegated hot key the daemons use for permissionless tasks
+		if response.AccountExternal {
+			delegateOpts, err := w.GetDelegateTransactor()
+			if err != nil {
+				return nil, err
+			}
+			response.DelegateAddress = delegateOpts.From
+		}
+
 	}
 
 	// Return response
@@ -0,0 +1,295 @@
+package apiserver
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/fatih/color"
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/rocketpool/api"
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services"
+	apitypes "github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Config
+const (
+	// The prefix of every versioned API route
+	RoutePrefixV1 string = "/api/v1/"
+
+	// The route used to check if the server is up
+	VersionRoute string = RoutePrefixV1 + "version"
+
+	// The route that lists the commands the server can run
+	CommandsRoute string = RoutePrefixV1 + "commands"
+
+	ApiServerColor = color.FgHiBlue
+	ErrorColor     = color.FgRed
+)
+
+// Version response
+type versionResponse struct {
+	Status  string `json:"status"`
+	Error   string `json:"error"`
+	Version string `json:"version"`
+}
+
+// The API server
+type apiServer struct {
+	log          log.ColorLogger
+	errLog       log.ColorLogger
+	settingsPath string
+	name         string
+	version      string
+	flags        []cli.Flag
+	commands     []cli.Command
+
+	// Commands that use the node wallet take turns with it; the rest (such as waiting for a transaction) run right away
+	walletLock sync.Mutex
+}
+
+// Register API server command
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Run the Rocket Pool API server, which serves the API commands over a local socket",
+		Action: func(c *cli.Context) error {
+			return run(c)
+		},
+	})
+}
+
+// Run daemon
+func run(c *cli.Context) error {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+
+	// Get the API commands; each request runs them in its own app with the global flags of the daemon
+	app := cli.NewApp()
+	api.RegisterCommands(app, "api", []string{"a"})
+
+	server := &apiServer{
+		log:          log.NewColorLogger(ApiServerColor),
+		errLog:       log.NewColorLogger(ErrorColor),
+		settingsPath: c.GlobalString("settings"),
+		name:         c.App.Name,
+		version:      c.App.Version,
+		flags:        c.App.Flags,
+		commands:     app.Commands,
+	}
+
+	// Remove the socket left over from a previous run, if there is one
+	socketPath := os.ExpandEnv(cfg.Smartnode.GetApiSocketPath(true))
+	err = os.Remove(socketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing old API socket [%s]: %w", socketPath, err)
+	}
+
+	// Start listening
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("error creating API socket [%s]: %w", socketPath, err)
+	}
+	defer listener.Close()
+	err = setSocketPermissions(socketPath)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(VersionRoute, server.handleVersion)
+	mux.HandleFunc(CommandsRoute, server.handleCommands)
+	mux.HandleFunc(RoutePrefixV1, server.handleCommand)
+
+	server.log.Printlnf("Starting API server on %s.", socketPath)
+	return http.Serve(listener, mux)
+
+}
+
+// Give the socket the same owner as the data folder it lives in, so the CLI user can connect to it
+func setSocketPermissions(socketPath string) error {
+	info, err := os.Stat(filepath.Dir(socketPath))
+	if err != nil {
+		return fmt.Errorf("error checking API socket folder: %w", err)
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		err = os.Lchown(socketPath, int(stat.Uid), int(stat.Gid))
+		if err != nil {
+			return fmt.Errorf("error setting API socket owner: %w", err)
+		}
+	}
+	err = os.Chmod(socketPath, 0660)
+	if err != nil {
+		return fmt.Errorf("error setting API socket permissions: %w", err)
+	}
+	return nil
+}
+
+// Handle a version check
+func (s *apiServer) handleVersion(w http.ResponseWriter, r *http.Request) {
+	response := versionResponse{
+		Status:  "success",
+		Version: shared.RocketPoolVersion,
+	}
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		s.errLog.Printlnf("Error serializing version response: %s", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bytes)
+}
+
+// Handle a request for the command paths the server can run
+func (s *apiServer) handleCommands(w http.ResponseWriter, r *http.Request) {
+	response := apitypes.APIServerCommandsResponse{
+		Status:   "success",
+		Commands: []string{},
+	}
+	for _, command := range s.commands {
+		if command.Name == "api" {
+			response.Commands = getCommandPaths("", command.Subcommands)
+		}
+	}
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		s.errLog.Printlnf("Error serializing commands response: %s", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bytes)
+}
+
+// Get the full paths of the commands that can be run, with their words separated by spaces
+func getCommandPaths(parentPath string, commands []cli.Command) []string {
+	paths := []string{}
+	for _, command := range commands {
+		path := strings.TrimSpace(parentPath + " " + command.Name)
+		if len(command.Subcommands) > 0 {
+			paths = append(paths, getCommandPaths(path, command.Subcommands)...)
+		} else {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// Handle an API command; the route after the prefix is the command path (e.g. /api/v1/node/status) and
+// the body holds the remaining arguments and global flags
+func (s *apiServer) handleCommand(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Parse the request
+	var request apitypes.APIServerRequest
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, fmt.Errorf("error reading request body: %w", err))
+		return
+	}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &request)
+		if err != nil {
+			s.writeError(w, fmt.Errorf("error deserializing request body: %w", err))
+			return
+		}
+	}
+
+	commandPath := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, RoutePrefixV1), "/"), "/")
+	args := append(commandPath, request.Args...)
+
+	// Run the command
+	output := s.runCommand(request, args)
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(output)
+}
+
+// Run an API command and capture its response
+func (s *apiServer) runCommand(request apitypes.APIServerRequest, args []string) []byte {
+	// Build the full command line
+	cmdArgs := []string{
+		s.name,
+		"--settings", s.settingsPath,
+		"--maxFee", fmt.Sprint(request.MaxFee),
+		"--maxPrioFee", fmt.Sprint(request.MaxPrioFee),
+		"--gasLimit", fmt.Sprint(request.GasLimit),
+	}
+	if request.Nonce != "" {
+		cmdArgs = append(cmdArgs, "--nonce", request.Nonce)
+	}
+	if request.IgnoreSyncCheck {
+		cmdArgs = append(cmdArgs, "--ignore-sync-check")
+	}
+	if request.ForceFallbacks {
+		cmdArgs = append(cmdArgs, "--force-fallbacks")
+	}
+	if request.OfflineExport {
+		cmdArgs = append(cmdArgs, "--offline-export")
+	}
+	cmdArgs = append(cmdArgs, "api")
+	cmdArgs = append(cmdArgs, args...)
+
+	// Run the command in its own app so it has its own flags and response, and capture the response
+	buffer := new(bytes.Buffer)
+	app := cli.NewApp()
+	app.Name = s.name
+	app.Version = s.version
+	app.Flags = append([]cli.Flag{}, s.flags...)
+	app.Commands = copyCommands(s.commands)
+	app.Writer = buffer
+	app.ErrWriter = buffer
+	walletLock := services.SetWalletLock(app, &s.walletLock)
+	defer walletLock.Release()
+
+	err := app.Run(cmdArgs)
+	if err != nil {
+		buffer.Reset()
+		apiutils.PrintErrorResponse(app, err)
+	}
+	return buffer.Bytes()
+}
+
+// Copy a command tree, since running a command writes to its subcommands and flags
+func copyCommands(commands []cli.Command) []cli.Command {
+	if commands == nil {
+		return nil
+	}
+	copied := make([]cli.Command, len(commands))
+	for i, command := range commands {
+		copied[i] = command
+		copied[i].Flags = append([]cli.Flag(nil), command.Flags...)
+		copied[i].Subcommands = copyCommands(command.Subcommands)
+	}
+	return copied
+}
+
+// Write an error response
+func (s *apiServer) writeError(w http.ResponseWriter, err error) {
+	s.errLog.Println(err)
+	buffer := new(bytes.Buffer)
+	apiutils.PrintErrorResponse(&cli.App{Writer: buffer}, err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_, _ = w.Write(buffer.Bytes())
+}
@@ -0,0 +1,70 @@
+package apiserver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli"
+
+	apitypes "github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+)
+
+func TestRunCommandsConcurrently(t *testing.T) {
+	release := make(chan struct{})
+	server := &apiServer{
+		name: "rocketpool",
+		flags: []cli.Flag{
+			cli.StringFlag{Name: "settings"},
+			cli.Float64Flag{Name: "maxFee"},
+			cli.Float64Flag{Name: "maxPrioFee"},
+			cli.Uint64Flag{Name: "gasLimit"},
+		},
+		commands: []cli.Command{{
+			Name: "api",
+			Subcommands: []cli.Command{
+				{
+					Name: "wait",
+					Action: func(c *cli.Context) error {
+						<-release
+						apiutils.GetResponsePrinter(c).PrintResponse(&apitypes.APIResponse{}, nil)
+						return nil
+					},
+				},
+				{
+					Name: "status",
+					Action: func(c *cli.Context) error {
+						apiutils.GetResponsePrinter(c).PrintResponse(&apitypes.APIResponse{}, nil)
+						return nil
+					},
+				},
+			},
+		}},
+	}
+
+	// A command that's still waiting doesn't hold up the others
+	waitOutput := make(chan []byte)
+	go func() {
+		waitOutput <- server.runCommand(apitypes.APIServerRequest{}, []string{"wait"})
+	}()
+	statusOutput := make(chan []byte)
+	go func() {
+		statusOutput <- server.runCommand(apitypes.APIServerRequest{}, []string{"status"})
+	}()
+	select {
+	case output := <-statusOutput:
+		if !strings.Contains(string(output), `"status":"success"`) {
+			t.Errorf("unexpected status response: %s", output)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("status command was blocked by the waiting command")
+	}
+
+	// Each command gets its own response
+	close(release)
+	output := <-waitOutput
+	if strings.Count(string(output), `"status"`) != 1 {
+		t.Errorf("unexpected wait response: %s", output)
+	}
+}
@@ -0,0 +1,285 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/dao/protocol"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	// How long before the end of a voting phase to remind the node to vote
+	pdaoVoteReminderWindow = 48 * time.Hour
+)
+
+// Alert on pDAO proposal events task
+type alertPdaoProps struct {
+	c            *cli.Context
+	log          log.ColorLogger
+	cfg          *config.RocketPoolConfig
+	w            *wallet.Wallet
+	rp           *rocketpool.RocketPool
+	bc           beacon.Client
+	intervalSize *big.Int
+
+	// The last block scanned for challenges against the node's proposals
+	lastScannedBlock *big.Int
+
+	// The proposal events that have already been alerted on, so they aren't repeated every cycle or after a restart
+	alertLog     *proposals.ProposalAlertLog
+	alertLogPath string
+}
+
+// Create alert on pDAO proposal events task
+func newAlertPdaoProps(c *cli.Context, logger log.ColorLogger) (*alertPdaoProps, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load the alerts sent before the last restart
+	alertLogPath := cfg.Smartnode.GetPdaoProposalAlertLogPath()
+	alertLog, err := proposals.LoadProposalAlertLog(alertLogPath)
+	if err != nil {
+		logger.Printlnf("WARNING: %s; alerts for proposals that are still active will be sent again.", err.Error())
+		alertLog = proposals.NewProposalAlertLog()
+	}
+
+	// Return task
+	return &alertPdaoProps{
+		c:            c,
+		log:          logger,
+		cfg:          cfg,
+		w:            w,
+		rp:           rp,
+		bc:           bc,
+		intervalSize: big.NewInt(int64(cfg.Geth.EventLogInterval)),
+		alertLog:     alertLog,
+		alertLogPath: alertLogPath,
+	}, nil
+
+}
+
+// Send alerts for pDAO proposals entering their voting phases, upcoming vote deadlines, delegate votes that differ from the node's,
+// and challenges against the node's own proposals
+func (t *alertPdaoProps) run(state *state.NetworkState) error {
+
+	// Check if alerting is disabled
+	if t.cfg.Alertmanager.EnableAlerting.Value != true {
+		return nil
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	nd, exists := state.NodeDetailsByAddress[nodeAccount.Address]
+	if !exists || !nd.Exists {
+		return nil
+	}
+
+	// Check for challenges against the node's proposals
+	if err := t.checkChallenges(state, nodeAccount.Address); err != nil {
+		t.log.Printlnf("Error checking for challenges against the node's Protocol DAO proposals: %s", err.Error())
+	}
+
+	// Check each proposal in a voting phase
+	for _, prop := range state.ProtocolDaoProposalDetails {
+		if prop.State != types.ProtocolDaoProposalState_ActivePhase1 && prop.State != types.ProtocolDaoProposalState_ActivePhase2 {
+			if t.alertLog.PruneVoting(prop.ID) {
+				t.saveAlertLog()
+			}
+			continue
+		}
+
+		// Alert when voting starts
+		if !t.alertLog.VotingStarted[prop.ID] {
+			t.log.Printlnf("Protocol DAO proposal %d is open for voting.", prop.ID)
+			alerting.AlertPdaoProposalVotingStarted(t.cfg, prop.ID, prop.Message, prop.Phase1EndTime)
+			t.alertLog.VotingStarted[prop.ID] = true
+			t.saveAlertLog()
+		}
+
+		if err := t.checkVotes(prop, nodeAccount.Address); err != nil {
+			t.log.Printlnf("Error checking votes on Protocol DAO proposal %d: %s", prop.ID, err.Error())
+		}
+	}
+
+	return nil
+
+}
+
+// Check the votes of the node and its delegate on a proposal
+func (t *alertPdaoProps) checkVotes(prop protocol.ProtocolDaoProposalDetails, nodeAddress common.Address) error {
+
+	// Get the votes of the node and its delegate
+	nodeVote, err := protocol.GetAddressVoteDirection(t.rp, prop.ID, nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the node's vote: %w", err)
+	}
+	delegate, err := network.GetVotingDelegate(t.rp, nodeAddress, prop.TargetBlock, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the node's voting delegate: %w", err)
+	}
+	delegateVote := types.VoteDirection_NoVote
+	if delegate != nodeAddress {
+		delegateVote, err = protocol.GetAddressVoteDirection(t.rp, prop.ID, delegate, nil)
+		if err != nil {
+			return fmt.Errorf("error getting the vote of delegate %s: %w", delegate.Hex(), err)
+		}
+	}
+
+	// Alert when the delegate voted differently than the node's override
+	if nodeVote != types.VoteDirection_NoVote {
+		if delegateVote != types.VoteDirection_NoVote && delegateVote != nodeVote && !t.alertLog.DelegateVotes[prop.ID] {
+			t.log.Printlnf("The node's delegate %s voted '%s' on proposal %d, but the node voted '%s'.", delegate.Hex(), types.VoteDirections[delegateVote], prop.ID, types.VoteDirections[nodeVote])
+			alerting.AlertPdaoDelegateVoteDiffers(t.cfg, prop.ID, delegate, types.VoteDirections[delegateVote], types.VoteDirections[nodeVote])
+			t.alertLog.DelegateVotes[prop.ID] = true
+			t.saveAlertLog()
+		}
+		return nil
+	}
+
+	// The node only needs to vote in phase 1 if it's its own delegate, and in phase 2 if its delegate didn't vote
+	phase := 1
+	endTime := prop.Phase1EndTime
+	if prop.State == types.ProtocolDaoProposalState_ActivePhase1 && delegate != nodeAddress {
+		return nil
+	}
+	if prop.State == types.ProtocolDaoProposalState_ActivePhase2 {
+		if delegate == nodeAddress || delegateVote != types.VoteDirection_NoVote {
+			return nil
+		}
+		phase = 2
+		endTime = prop.Phase2EndTime
+	}
+
+	// Remind the node to vote when the phase is about to end
+	if time.Until(endTime) > pdaoVoteReminderWindow || t.alertLog.Deadlines[prop.ID] == prop.State {
+		return nil
+	}
+	votingPower, err := network.GetVotingPower(t.rp, nodeAddress, prop.TargetBlock, nil)
+	if err != nil {
+		return fmt.Errorf("error getting the node's voting power: %w", err)
+	}
+	if votingPower.Sign() == 0 {
+		return nil
+	}
+	t.log.Printlnf("Phase %d of proposal %d ends at %s and the node hasn't voted yet.", phase, prop.ID, endTime.Format(time.RFC1123))
+	alerting.AlertPdaoProposalVoteDeadline(t.cfg, prop.ID, prop.Message, phase, endTime)
+	t.alertLog.Deadlines[prop.ID] = prop.State
+	t.saveAlertLog()
+	return nil
+
+}
+
+// Alert on new challenges against the node's proposals that haven't been responded to yet
+func (t *alertPdaoProps) checkChallenges(state *state.NetworkState, nodeAddress common.Address) error {
+
+	// Get the node's proposals that are still in the challenge phase
+	ids := []uint64{}
+	var firstProp *protocol.ProtocolDaoProposalDetails
+	for i, prop := range state.ProtocolDaoProposalDetails {
+		if prop.State != types.ProtocolDaoProposalState_Pending {
+			if t.alertLog.PruneChallenges(prop.ID) {
+				t.saveAlertLog()
+			}
+			continue
+		}
+		if prop.ProposerAddress == nodeAddress {
+			ids = append(ids, prop.ID)
+			if firstProp == nil {
+				firstProp = &state.ProtocolDaoProposalDetails[i]
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// Get the window of blocks to scan
+	endBlock := big.NewInt(int64(state.ElBlockNumber))
+	var startBlock *big.Int
+	if t.lastScannedBlock == nil {
+		var err error
+		startBlock, err = getPdaoProposalCreationBlock(t.bc, state, *firstProp)
+		if err != nil {
+			return err
+		}
+	} else {
+		startBlock = big.NewInt(0).Add(t.lastScannedBlock, common.Big1)
+	}
+
+	// Get the challenges submitted in the window
+	opts := &bind.CallOpts{
+		BlockNumber: endBlock,
+	}
+	verifierAddresses := t.cfg.Smartnode.GetPreviousRocketDAOProtocolVerifierAddresses()
+	challengeEvents, err := protocol.GetChallengeSubmittedEvents(t.rp, ids, t.intervalSize, startBlock, endBlock, verifierAddresses, opts)
+	if err != nil {
+		return fmt.Errorf("error scanning for ChallengeSubmitted events: %w", err)
+	}
+
+	// Alert once for each challenge that's still open
+	for _, event := range challengeEvents {
+		proposalID := event.ProposalID.Uint64()
+		index := event.Index.Uint64()
+		if t.alertLog.Challenges[proposalID][index] {
+			continue
+		}
+		challengeState, err := protocol.GetChallengeState(t.rp, proposalID, index, opts)
+		if err != nil {
+			return fmt.Errorf("error checking state of challenge on proposal %d, index %d: %w", proposalID, index, err)
+		}
+		if challengeState != types.ChallengeState_Challenged {
+			continue
+		}
+		t.log.Printlnf("Proposal %d, index %d has been challenged by %s.", proposalID, index, event.Challenger.Hex())
+		alerting.AlertPdaoProposalChallenged(t.cfg, proposalID, index, event.Challenger)
+		t.alertLog.AddChallenge(proposalID, index)
+		t.saveAlertLog()
+	}
+	t.lastScannedBlock = endBlock
+
+	return nil
+
+}
+
+// Save the sent alerts so they aren't repeated after a restart
+func (t *alertPdaoProps) saveAlertLog() {
+	if err := t.alertLog.Save(t.alertLogPath); err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
@@ -0,0 +1,42 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Config
+var apiServerRestartDelay, _ = time.ParseDuration("15s")
+
+// Run the API server as a separate process next to the node daemon, restarting it whenever it stops.
+// It runs in its own process so the API commands can't change the gas settings or clients the daemon's tasks use.
+func runApiServer(c *cli.Context, logger log.ColorLogger) error {
+
+	// Get the daemon binary
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("error getting the daemon binary path for the API server: %w", err)
+	}
+	args := []string{"--settings", c.GlobalString("settings"), "api-server"}
+
+	for {
+		cmd := exec.Command(executable, args...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err := cmd.Run()
+		if err != nil {
+			logger.Printlnf("API server stopped: %s", err.Error())
+		} else {
+			logger.Println("API server stopped.")
+		}
+		logger.Printlnf("Restarting the API server in %s...", apiServerRestartDelay)
+		time.Sleep(apiServerRestartDelay)
+	}
+
+}
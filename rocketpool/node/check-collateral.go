@@ -0,0 +1,92 @@
+package node
+
+import (
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Check collateral task
+type checkCollateral struct {
+	c           *cli.Context
+	log         log.ColorLogger
+	cfg         *config.RocketPoolConfig
+	w           *wallet.Wallet
+	targetRatio float64
+}
+
+// Create check collateral task
+func newCheckCollateral(c *cli.Context, logger log.ColorLogger) (*checkCollateral, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &checkCollateral{
+		c:           c,
+		log:         logger,
+		cfg:         cfg,
+		w:           w,
+		targetRatio: cfg.Smartnode.CollateralTargetRatio.Value.(float64),
+	}, nil
+
+}
+
+// Warn if the node's collateral has dropped below its target ratio
+func (t *checkCollateral) run(state *state.NetworkState) error {
+
+	// Check if collateral warnings are disabled
+	if t.targetRatio == 0 {
+		return nil
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	nd, exists := state.NodeDetailsByAddress[nodeAccount.Address]
+	if !exists || !nd.Exists {
+		return nil
+	}
+
+	// Project the collateral
+	borrowedEth, bondedEth := state.GetPendingBorrowedAndBondedEth(nodeAccount.Address)
+	if borrowedEth.Sign() == 0 {
+		return nil
+	}
+	projection := rputils.ProjectCollateral(nd.RplStake, borrowedEth, bondedEth, state.NetworkDetails.RplPrice, state.NetworkDetails.MinCollateralFraction, state.NetworkDetails.MaxCollateralFraction, t.targetRatio)
+	ratio := projection.BorrowedCollateralRatio * 100
+	if ratio >= t.targetRatio {
+		return nil
+	}
+
+	// Log a warning; the CollateralLow alert comes from Prometheus rules over the node collector metrics
+	minimumRatio := eth.WeiToEth(state.NetworkDetails.MinCollateralFraction) * 100
+	rplPrice := eth.WeiToEth(state.NetworkDetails.RplPrice)
+	minimumRplPrice := float64(0)
+	if projection.MinimumRplPrice != nil {
+		minimumRplPrice = eth.WeiToEth(projection.MinimumRplPrice)
+	}
+	rplNeeded := eth.WeiToEth(projection.RplNeededForTarget)
+	if ratio < minimumRatio {
+		t.log.Printlnf("WARNING: your collateral ratio is %.2f%%, which is below the %.2f%% minimum; your node is not earning RPL rewards. Stake %.6f RPL to restore your target ratio of %.2f%%.", ratio, minimumRatio, rplNeeded, t.targetRatio)
+	} else {
+		t.log.Printlnf("WARNING: your collateral ratio is %.2f%%, which is below your target of %.2f%%. You will stop earning RPL rewards if the RPL price drops below %.6f ETH (currently %.6f ETH). Stake %.6f RPL to restore your target ratio.", ratio, t.targetRatio, minimumRplPrice, rplPrice, rplNeeded)
+	}
+	return nil
+
+}
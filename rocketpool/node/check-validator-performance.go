@@ -0,0 +1,404 @@
+package node
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	// The most epochs to check in one run; if the daemon falls further behind than this, older epochs are skipped
+	maxPerformanceEpochsPerRun uint64 = 4
+
+	// The most Beacon blocks to request at the same time
+	maxConcurrentPerformanceRequests int = 4
+
+	// The smallest balance drop that's alerted on, in gwei. Missed attestations and sync committee duties only cost a few
+	// thousand gwei per epoch, so this only catches larger losses such as slashings.
+	balanceDecreaseThresholdGwei uint64 = 5e6
+
+	// A full validator balance, in gwei
+	fullValidatorBalanceGwei uint64 = 32e9
+
+	// How far a validator's balance can be from 32 ETH after a drop for it to count as a withdrawal sweep, in gwei
+	withdrawalSweepToleranceGwei uint64 = 1e6
+)
+
+// A validator duty to attest in a specific committee
+type attestationDuty struct {
+	pubkey   types.ValidatorPubkey
+	index    string
+	slot     uint64
+	position int
+}
+
+// A validator balance recorded at a specific epoch
+type epochBalance struct {
+	epoch   uint64
+	balance uint64
+}
+
+// Check validator performance task
+type checkValidatorPerformance struct {
+	c   *cli.Context
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+	w   *wallet.Wallet
+	bc  beacon.Client
+
+	// The last epoch that was checked for missed duties
+	lastCheckedEpoch uint64
+	hasCheckedEpoch  bool
+
+	// The balance of each validator when it was last checked
+	balances map[types.ValidatorPubkey]epochBalance
+
+	// The Beacon blocks that have been downloaded but are still needed to check the next epoch, by slot; nil for missed slots
+	blocks map[uint64]*beacon.BeaconBlock
+}
+
+// Create check validator performance task
+func newCheckValidatorPerformance(c *cli.Context, logger log.ColorLogger) (*checkValidatorPerformance, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &checkValidatorPerformance{
+		c:        c,
+		log:      logger,
+		cfg:      cfg,
+		w:        w,
+		bc:       bc,
+		balances: map[types.ValidatorPubkey]epochBalance{},
+		blocks:   map[uint64]*beacon.BeaconBlock{},
+	}, nil
+
+}
+
+// Check the node's validators for missed attestations, missed proposals and balance decreases
+func (t *checkValidatorPerformance) run(state *state.NetworkState) error {
+
+	// Don't do anything if none of the alerts would be sent
+	alertCfg := t.cfg.Alertmanager
+	if alertCfg.EnableAlerting.Value != true {
+		return nil
+	}
+	checkAttestations := alertCfg.AlertEnabled_MissedAttestation.Value == true
+	checkProposals := alertCfg.AlertEnabled_MissedProposal.Value == true
+	checkBalances := alertCfg.AlertEnabled_ValidatorBalanceDecreased.Value == true
+	if !checkAttestations && !checkProposals && !checkBalances {
+		return nil
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Get the node's active validators
+	validators := map[string]beacon.ValidatorStatus{}
+	for _, mpd := range state.MinipoolDetailsByNode[nodeAccount.Address] {
+		status, exists := state.ValidatorDetails[mpd.Pubkey]
+		if !exists || !status.Exists {
+			continue
+		}
+		if status.Status == beacon.ValidatorState_ActiveOngoing || status.Status == beacon.ValidatorState_ActiveExiting {
+			validators[status.Index] = status
+		}
+	}
+	if len(validators) == 0 {
+		t.balances = map[types.ValidatorPubkey]epochBalance{}
+		return nil
+	}
+
+	// Log
+	t.log.Printlnf("Checking the performance of %d validator(s)...", len(validators))
+
+	slotsPerEpoch := state.BeaconConfig.SlotsPerEpoch
+	currentEpoch := state.BeaconSlotNumber / slotsPerEpoch
+
+	// Check for balance decreases since the last run
+	if checkBalances {
+		t.checkBalances(validators, currentEpoch)
+	}
+
+	// Attestations for an epoch can be included until the end of the next one, so only check epochs that are fully settled
+	if (!checkAttestations && !checkProposals) || currentEpoch < 2 {
+		return nil
+	}
+	targetEpoch := currentEpoch - 2
+	startEpoch := targetEpoch
+	if t.hasCheckedEpoch {
+		if t.lastCheckedEpoch >= targetEpoch {
+			return nil
+		}
+		startEpoch = t.lastCheckedEpoch + 1
+	}
+	if targetEpoch-startEpoch+1 > maxPerformanceEpochsPerRun {
+		t.log.Printlnf("NOTE: skipping the duty check for epochs %d to %d since they are too old.", startEpoch, targetEpoch-maxPerformanceEpochsPerRun)
+		startEpoch = targetEpoch - maxPerformanceEpochsPerRun + 1
+	}
+
+	for epoch := startEpoch; epoch <= targetEpoch; epoch++ {
+		// Attestations for this epoch can be in the blocks of the next one, which are reused for the next check
+		firstSlot := epoch * slotsPerEpoch
+		err = t.loadBlocks(firstSlot, 2*slotsPerEpoch)
+		if err != nil {
+			return fmt.Errorf("error getting blocks for epoch %d: %w", epoch, err)
+		}
+		if checkAttestations {
+			err = t.checkAttestations(validators, epoch, slotsPerEpoch)
+			if err != nil {
+				return fmt.Errorf("error checking attestations for epoch %d: %w", epoch, err)
+			}
+		}
+		if checkProposals {
+			err = t.checkProposals(validators, epoch, slotsPerEpoch)
+			if err != nil {
+				return fmt.Errorf("error checking proposals for epoch %d: %w", epoch, err)
+			}
+		}
+		t.lastCheckedEpoch = epoch
+		t.hasCheckedEpoch = true
+		for slot := range t.blocks {
+			if slot < firstSlot+slotsPerEpoch {
+				delete(t.blocks, slot)
+			}
+		}
+	}
+
+	// Return
+	return nil
+
+}
+
+// Compare each validator's balance with its balance in the previously checked epoch
+func (t *checkValidatorPerformance) checkBalances(validators map[string]beacon.ValidatorStatus, epoch uint64) {
+
+	newBalances := map[types.ValidatorPubkey]epochBalance{}
+	for index, status := range validators {
+		current := epochBalance{epoch: epoch, balance: status.Balance}
+		previous, exists := t.balances[status.Pubkey]
+		if !exists {
+			newBalances[status.Pubkey] = current
+			continue
+		}
+		if previous.epoch == epoch {
+			// Only compare across epochs
+			newBalances[status.Pubkey] = previous
+			continue
+		}
+
+		newBalances[status.Pubkey] = current
+		if status.Balance+balanceDecreaseThresholdGwei > previous.balance || isWithdrawalSweep(previous.balance, status.Balance) {
+			continue
+		}
+
+		t.log.Printlnf("WARNING: the balance of validator %s dropped from %d to %d gwei between epochs %d and %d.", index, previous.balance, status.Balance, previous.epoch, epoch)
+		err := alerting.AlertValidatorBalanceDecreased(t.cfg, index, status.Pubkey, previous.balance, status.Balance, previous.epoch, epoch)
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't send balance decrease alert: %s", err.Error())
+		}
+	}
+
+	// Drop validators that are no longer active
+	t.balances = newBalances
+
+}
+
+// Check if a balance drop was caused by a partial withdrawal sweeping the validator's balance back down to 32 ETH
+func isWithdrawalSweep(previousBalance uint64, newBalance uint64) bool {
+	if previousBalance <= fullValidatorBalanceGwei {
+		return false
+	}
+	return newBalance+withdrawalSweepToleranceGwei >= fullValidatorBalanceGwei &&
+		newBalance <= fullValidatorBalanceGwei+withdrawalSweepToleranceGwei
+}
+
+// Check if any of the validators missed their attestation duty in the given epoch
+func (t *checkValidatorPerformance) checkAttestations(validators map[string]beacon.ValidatorStatus, epoch uint64, slotsPerEpoch uint64) error {
+
+	// Get the committee assignments for the node's validators
+	committees, err := t.bc.GetCommitteesForEpoch(&epoch)
+	if err != nil {
+		return fmt.Errorf("error getting committees: %w", err)
+	}
+	duties := map[uint64]map[uint64][]*attestationDuty{}
+	pendingDuties := []*attestationDuty{}
+	for i := 0; i < committees.Count(); i++ {
+		slot := committees.Slot(i)
+		committeeIndex := committees.Index(i)
+		for position, validatorIndex := range committees.Validators(i) {
+			status, exists := validators[validatorIndex]
+			if !exists {
+				continue
+			}
+			duty := &attestationDuty{
+				pubkey:   status.Pubkey,
+				index:    validatorIndex,
+				slot:     slot,
+				position: position,
+			}
+			slotDuties, exists := duties[slot]
+			if !exists {
+				slotDuties = map[uint64][]*attestationDuty{}
+				duties[slot] = slotDuties
+			}
+			slotDuties[committeeIndex] = append(slotDuties[committeeIndex], duty)
+			pendingDuties = append(pendingDuties, duty)
+		}
+	}
+	committees.Release()
+	if len(pendingDuties) == 0 {
+		return nil
+	}
+
+	// Mark every duty that was fulfilled
+	completed := map[*attestationDuty]bool{}
+	firstSlot := epoch * slotsPerEpoch
+	for slot := firstSlot; slot < firstSlot+2*slotsPerEpoch; slot++ {
+		block := t.blocks[slot]
+		if block == nil {
+			continue
+		}
+		for _, attestation := range block.Attestations {
+			slotDuties, exists := duties[attestation.SlotIndex]
+			if !exists {
+				continue
+			}
+			for _, duty := range slotDuties[attestation.CommitteeIndex] {
+				if attestation.AggregationBits.BitAt(uint64(duty.position)) {
+					completed[duty] = true
+				}
+			}
+		}
+	}
+
+	// Alert on the rest
+	for _, duty := range pendingDuties {
+		if completed[duty] {
+			continue
+		}
+		t.log.Printlnf("WARNING: validator %s missed its attestation for slot %d.", duty.index, duty.slot)
+		err := alerting.AlertMissedAttestation(t.cfg, duty.index, duty.pubkey, epoch)
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't send missed attestation alert: %s", err.Error())
+		}
+	}
+
+	return nil
+
+}
+
+// Check if any of the validators missed a block proposal in the given epoch
+func (t *checkValidatorPerformance) checkProposals(validators map[string]beacon.ValidatorStatus, epoch uint64, slotsPerEpoch uint64) error {
+
+	// Get the proposal duties for the node's validators
+	indices := make([]string, 0, len(validators))
+	for index := range validators {
+		indices = append(indices, index)
+	}
+	proposalDuties, err := t.bc.GetValidatorProposerDuties(indices, epoch)
+	if err != nil {
+		return err
+	}
+	hasDuties := false
+	for _, count := range proposalDuties {
+		if count > 0 {
+			hasDuties = true
+			break
+		}
+	}
+	if !hasDuties {
+		return nil
+	}
+
+	// Count the blocks the validators actually proposed
+	firstSlot := epoch * slotsPerEpoch
+	proposed := map[string]uint64{}
+	for slot := firstSlot; slot < firstSlot+slotsPerEpoch; slot++ {
+		if block := t.blocks[slot]; block != nil {
+			proposed[block.ProposerIndex]++
+		}
+	}
+
+	// Alert on the missing ones
+	for index, count := range proposalDuties {
+		if count <= proposed[index] {
+			continue
+		}
+		status := validators[index]
+		t.log.Printlnf("WARNING: validator %s missed a block proposal in epoch %d.", index, epoch)
+		err := alerting.AlertMissedProposal(t.cfg, index, status.Pubkey, epoch)
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't send missed proposal alert: %s", err.Error())
+		}
+	}
+
+	return nil
+
+}
+
+// Download the Beacon blocks in a range of slots that haven't been downloaded yet
+func (t *checkValidatorPerformance) loadBlocks(firstSlot uint64, slotCount uint64) error {
+
+	missingSlots := []uint64{}
+	for slot := firstSlot; slot < firstSlot+slotCount; slot++ {
+		if _, exists := t.blocks[slot]; !exists {
+			missingSlots = append(missingSlots, slot)
+		}
+	}
+
+	blocks := make([]*beacon.BeaconBlock, len(missingSlots))
+	var wg errgroup.Group
+	wg.SetLimit(maxConcurrentPerformanceRequests)
+	for i, slot := range missingSlots {
+		i := i
+		slot := slot
+		wg.Go(func() error {
+			block, found, err := t.bc.GetBeaconBlock(strconv.FormatUint(slot, 10))
+			if err != nil {
+				return err
+			}
+			if found {
+				blocks[i] = &block
+			}
+			return nil
+		})
+	}
+	err := wg.Wait()
+	if err != nil {
+		return err
+	}
+
+	for i, slot := range missingSlots {
+		t.blocks[slot] = blocks[i]
+	}
+	return nil
+
+}
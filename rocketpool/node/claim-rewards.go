@@ -0,0 +1,290 @@
+package node
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Claim rewards task
+type claimRewards struct {
+	c              *cli.Context
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              *wallet.Wallet
+	rp             *rocketpool.RocketPool
+	mode           cfgtypes.AutoClaimMode
+	restakePercent float64
+	targetRatio    float64
+	maxFeeCeiling  float64
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+}
+
+// Create claim rewards task
+func newClaimRewards(c *cli.Context, logger log.ColorLogger) (*claimRewards, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the auto-claim settings
+	mode := cfg.Smartnode.AutoClaimMode.Value.(cfgtypes.AutoClaimMode)
+	restakePercent := cfg.Smartnode.AutoClaimRestakePercent.Value.(float64)
+	if restakePercent < 0 || restakePercent > 100 {
+		return nil, fmt.Errorf("auto-claim restake percent must be between 0 and 100, but it was %.2f", restakePercent)
+	}
+	targetRatio := cfg.Smartnode.AutoClaimTargetRatio.Value.(float64)
+	if targetRatio < 0 {
+		return nil, fmt.Errorf("auto-claim target ratio can't be negative, but it was %.2f", targetRatio)
+	}
+	maxFeeCeiling := cfg.Smartnode.AutoClaimMaxFee.Value.(float64)
+	if mode != cfgtypes.AutoClaimMode_Disabled && maxFeeCeiling == 0 {
+		logger.Println("Auto-claim max fee is 0, disabling auto-claim.")
+		mode = cfgtypes.AutoClaimMode_Disabled
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &claimRewards{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		mode:           mode,
+		restakePercent: restakePercent,
+		targetRatio:    targetRatio,
+		maxFeeCeiling:  maxFeeCeiling,
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+	}, nil
+
+}
+
+// Claim any unclaimed rewards intervals and restake part of the RPL
+func (t *claimRewards) run(state *state.NetworkState) error {
+
+	// Check if auto-claim is disabled
+	if t.mode == cfgtypes.AutoClaimMode_Disabled {
+		return nil
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	nodeDetails, exists := state.NodeDetailsByAddress[nodeAccount.Address]
+	if !exists || !nodeDetails.Exists {
+		return nil
+	}
+
+	// Get the claimable intervals
+	indices, amountRPL, amountETH, proofs, totalRPL, totalETH, err := t.getClaimableIntervals(nodeAccount.Address)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
+	// Log
+	t.log.Printlnf("%d rewards interval(s) are ready to claim, for a total of %.6f RPL and %.6f ETH.", len(indices), eth.WeiToEth(totalRPL), eth.WeiToEth(totalETH))
+
+	// Only the node account can claim its rewards
+	if !api.CheckNodeKeyAvailable(t.cfg, t.w, "Claiming rewards", &t.log) {
+		return nil
+	}
+
+	// Get the amount to restake
+	stakeAmount := getAutoClaimStakeAmount(t.mode, t.restakePercent, t.targetRatio, totalRPL, nodeDetails.RplStake, nodeDetails.EthMatched, state.NetworkDetails.RplPrice)
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Get the gas limit
+	var gasInfo rocketpool.GasInfo
+	if stakeAmount.Sign() == 0 {
+		gasInfo, err = rewards.EstimateClaimGas(t.rp, nodeAccount.Address, indices, amountRPL, amountETH, proofs, opts)
+	} else {
+		gasInfo, err = rewards.EstimateClaimAndStakeGas(t.rp, nodeAccount.Address, indices, amountRPL, amountETH, proofs, stakeAmount, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("could not estimate the gas required to claim rewards: %w", err)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Print the gas info
+	if !api.PrintAndCheckGasInfo(gasInfo, true, t.maxFeeCeiling, &t.log, maxFee, 0) {
+		t.log.Println("Rewards will be claimed once the max fee drops below the auto-claim ceiling.")
+		return nil
+	}
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	// Claim
+	var hash common.Hash
+	if stakeAmount.Sign() == 0 {
+		t.log.Println("Claiming rewards without restaking...")
+		hash, err = rewards.Claim(t.rp, nodeAccount.Address, indices, amountRPL, amountETH, proofs, opts)
+	} else {
+		t.log.Printlnf("Claiming rewards and restaking %.6f RPL...", eth.WeiToEth(stakeAmount))
+		hash, err = rewards.ClaimAndStake(t.rp, nodeAccount.Address, indices, amountRPL, amountETH, proofs, stakeAmount, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("could not claim rewards: %w", err)
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log & return
+	t.log.Printlnf("Successfully claimed rewards for %d interval(s).", len(indices))
+	return nil
+
+}
+
+// Get the unclaimed intervals that have a valid tree file on disk
+func (t *claimRewards) getClaimableIntervals(nodeAddress common.Address) ([]*big.Int, []*big.Int, []*big.Int, [][]common.Hash, *big.Int, *big.Int, error) {
+
+	unclaimed, _, err := rprewards.GetClaimStatus(t.rp, nodeAddress)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, fmt.Errorf("error getting claim status: %w", err)
+	}
+
+	indices := []*big.Int{}
+	amountRPL := []*big.Int{}
+	amountETH := []*big.Int{}
+	proofs := [][]common.Hash{}
+	totalRPL := big.NewInt(0)
+	totalETH := big.NewInt(0)
+	for _, interval := range unclaimed {
+		intervalInfo, err := rprewards.GetIntervalInfo(t.rp, t.cfg, nodeAddress, interval, nil)
+		if err != nil {
+			return nil, nil, nil, nil, nil, nil, fmt.Errorf("error getting info for interval %d: %w", interval, err)
+		}
+		if !intervalInfo.TreeFileExists {
+			t.log.Printlnf("The rewards tree for interval %d hasn't been downloaded yet, skipping it.", interval)
+			continue
+		}
+		if !intervalInfo.MerkleRootValid {
+			t.log.Printlnf("WARNING: the rewards tree for interval %d has an invalid Merkle root, skipping it.", interval)
+			continue
+		}
+		if !intervalInfo.NodeExists {
+			continue
+		}
+
+		rpl := big.NewInt(0).Add(&intervalInfo.CollateralRplAmount.Int, &intervalInfo.ODaoRplAmount.Int)
+		ethAmount := &intervalInfo.SmoothingPoolEthAmount.Int
+		indices = append(indices, big.NewInt(0).SetUint64(interval))
+		amountRPL = append(amountRPL, rpl)
+		amountETH = append(amountETH, ethAmount)
+		proofs = append(proofs, intervalInfo.MerkleProof)
+		totalRPL.Add(totalRPL, rpl)
+		totalETH.Add(totalETH, ethAmount)
+	}
+
+	return indices, amountRPL, amountETH, proofs, totalRPL, totalETH, nil
+
+}
+
+// Get the amount of claimed RPL to restake for the given auto-claim mode
+func getAutoClaimStakeAmount(mode cfgtypes.AutoClaimMode, restakePercent float64, targetRatio float64, claimedRpl *big.Int, rplStake *big.Int, ethMatched *big.Int, rplPrice *big.Int) *big.Int {
+
+	stakeAmount := big.NewInt(0)
+	switch mode {
+	case cfgtypes.AutoClaimMode_RestakePercent:
+		// Work in basis points to keep the precision of fractional percentages
+		bps := big.NewInt(int64(math.Round(restakePercent * 100)))
+		stakeAmount.Mul(claimedRpl, bps)
+		stakeAmount.Div(stakeAmount, big.NewInt(10000))
+
+	case cfgtypes.AutoClaimMode_TargetRatio:
+		if rplPrice == nil || rplPrice.Sign() == 0 || ethMatched == nil {
+			return stakeAmount
+		}
+		// Target stake = borrowed ETH * ratio / RPL price
+		bps := big.NewInt(int64(math.Round(targetRatio * 100)))
+		targetStake := big.NewInt(0).Mul(ethMatched, bps)
+		targetStake.Mul(targetStake, eth.EthToWei(1))
+		targetStake.Div(targetStake, big.NewInt(10000))
+		targetStake.Div(targetStake, rplPrice)
+		if rplStake != nil {
+			stakeAmount.Sub(targetStake, rplStake)
+		} else {
+			stakeAmount.Set(targetStake)
+		}
+	}
+
+	// Clamp to the claimed amount
+	if stakeAmount.Sign() < 0 {
+		stakeAmount.SetUint64(0)
+	}
+	if stakeAmount.Cmp(claimedRpl) > 0 {
+		stakeAmount.Set(claimedRpl)
+	}
+	return stakeAmount
+
+}
@@ -0,0 +1,161 @@
+package node
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestGetAutoClaimStakeAmount(t *testing.T) {
+	// 1 RPL = 0.01 ETH, so a 10% ratio on 24 borrowed ETH targets a stake of 240 RPL
+	rplPrice := eth.EthToWei(0.01)
+	ethMatched := eth.EthToWei(24)
+
+	tests := []struct {
+		name           string
+		mode           cfgtypes.AutoClaimMode
+		restakePercent float64
+		targetRatio    float64
+		claimedRpl     *big.Int
+		rplStake       *big.Int
+		ethMatched     *big.Int
+		rplPrice       *big.Int
+		expected       *big.Int
+	}{
+		{
+			name:       "disabled",
+			mode:       cfgtypes.AutoClaimMode_Disabled,
+			claimedRpl: eth.EthToWei(100),
+			expected:   big.NewInt(0),
+		},
+		{
+			name:           "restake half",
+			mode:           cfgtypes.AutoClaimMode_RestakePercent,
+			restakePercent: 50,
+			claimedRpl:     eth.EthToWei(100),
+			expected:       eth.EthToWei(50),
+		},
+		{
+			name:           "restake all",
+			mode:           cfgtypes.AutoClaimMode_RestakePercent,
+			restakePercent: 100,
+			claimedRpl:     eth.EthToWei(100),
+			expected:       eth.EthToWei(100),
+		},
+		{
+			name:           "restake none",
+			mode:           cfgtypes.AutoClaimMode_RestakePercent,
+			restakePercent: 0,
+			claimedRpl:     eth.EthToWei(100),
+			expected:       big.NewInt(0),
+		},
+		{
+			name:           "restake fractional percent",
+			mode:           cfgtypes.AutoClaimMode_RestakePercent,
+			restakePercent: 0.29,
+			claimedRpl:     eth.EthToWei(100),
+			expected:       eth.EthToWei(0.29),
+		},
+		{
+			name:           "restake zero claim",
+			mode:           cfgtypes.AutoClaimMode_RestakePercent,
+			restakePercent: 50,
+			claimedRpl:     big.NewInt(0),
+			expected:       big.NewInt(0),
+		},
+		{
+			name:        "target below ratio",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  eth.EthToWei(100),
+			rplStake:    eth.EthToWei(200),
+			ethMatched:  ethMatched,
+			rplPrice:    rplPrice,
+			expected:    eth.EthToWei(40),
+		},
+		{
+			name:        "target gap larger than claim",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  eth.EthToWei(50),
+			rplStake:    eth.EthToWei(100),
+			ethMatched:  ethMatched,
+			rplPrice:    rplPrice,
+			expected:    eth.EthToWei(50),
+		},
+		{
+			name:        "target already reached",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  eth.EthToWei(100),
+			rplStake:    eth.EthToWei(240),
+			ethMatched:  ethMatched,
+			rplPrice:    rplPrice,
+			expected:    big.NewInt(0),
+		},
+		{
+			name:        "target exceeded",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  eth.EthToWei(100),
+			rplStake:    eth.EthToWei(300),
+			ethMatched:  ethMatched,
+			rplPrice:    rplPrice,
+			expected:    big.NewInt(0),
+		},
+		{
+			name:        "target zero claim",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  big.NewInt(0),
+			rplStake:    eth.EthToWei(100),
+			ethMatched:  ethMatched,
+			rplPrice:    rplPrice,
+			expected:    big.NewInt(0),
+		},
+		{
+			name:        "target without a stake",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  eth.EthToWei(500),
+			ethMatched:  ethMatched,
+			rplPrice:    rplPrice,
+			expected:    eth.EthToWei(240),
+		},
+		{
+			name:        "target without a price",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  eth.EthToWei(100),
+			rplStake:    eth.EthToWei(100),
+			ethMatched:  ethMatched,
+			rplPrice:    big.NewInt(0),
+			expected:    big.NewInt(0),
+		},
+		{
+			name:        "target without borrowed ETH",
+			mode:        cfgtypes.AutoClaimMode_TargetRatio,
+			targetRatio: 10,
+			claimedRpl:  eth.EthToWei(100),
+			rplStake:    eth.EthToWei(100),
+			rplPrice:    rplPrice,
+			expected:    big.NewInt(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claimedRpl := new(big.Int).Set(test.claimedRpl)
+			amount := getAutoClaimStakeAmount(test.mode, test.restakePercent, test.targetRatio, test.claimedRpl, test.rplStake, test.ethMatched, test.rplPrice)
+			if amount.Cmp(test.expected) != 0 {
+				t.Errorf("expected %s wei, got %s wei", test.expected.String(), amount.String())
+			}
+			if test.claimedRpl.Cmp(claimedRpl) != 0 {
+				t.Errorf("claimed amount was modified")
+			}
+		})
+	}
+}
@@ -47,23 +47,34 @@ type BeaconCollector struct {
 
 // Create a new BeaconCollector instance
 func NewBeaconCollector(rp *rocketpool.RocketPool, bc beacon.Client, ec rocketpool.ExecutionClient, nodeAddress common.Address, stateLocker *StateLocker) *BeaconCollector {
-	subsystem := "beacon"
+	return newBeaconCollector(rp, bc, ec, nodeAddress, stateLocker, "beacon", nil)
+}
+
+// Create a new BeaconCollector instance for a node that is only watched by this daemon, with separately named metrics labeled by its address
+func NewWatchedBeaconCollector(rp *rocketpool.RocketPool, bc beacon.Client, ec rocketpool.ExecutionClient, nodeAddress common.Address, stateLocker *StateLocker) *BeaconCollector {
+	collector := newBeaconCollector(rp, bc, ec, nodeAddress, stateLocker, "watched_beacon", prometheus.Labels{"address": nodeAddress.Hex()})
+	collector.logPrefix = fmt.Sprintf("Beacon Collector (%s)", nodeAddress.Hex())
+	return collector
+}
+
+// Create a BeaconCollector with the given metric subsystem and constant labels on all of its metrics
+func newBeaconCollector(rp *rocketpool.RocketPool, bc beacon.Client, ec rocketpool.ExecutionClient, nodeAddress common.Address, stateLocker *StateLocker, subsystem string, nodeLabels prometheus.Labels) *BeaconCollector {
 	return &BeaconCollector{
 		activeSyncCommittee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "active_sync_committee"),
 			"The number of validators on a current sync committee",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		upcomingSyncCommittee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "upcoming_sync_committee"),
 			"The number of validators on the next sync committee",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		upcomingProposals: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "upcoming_proposals"),
 			"The number of proposals assigned to validators in this epoch and the next",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		recentProposals: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "recent_proposals"),
 			"The number of block proposals made by validators in the most recent finalized epoch",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		rp:          rp,
 		bc:          bc,
@@ -18,6 +18,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
 	"github.com/rocket-pool/smartnode/shared/utils/eth2"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -83,6 +84,15 @@ type NodeCollector struct {
 	// The collateral ratio with respect to the amount of bonded ETH
 	bondedCollateralRatio *prometheus.Desc
 
+	// The RPL price below which the node falls under the minimum rewardable collateral
+	minimumCollateralRplPrice *prometheus.Desc
+
+	// The RPL price above which the node exceeds the maximum rewardable collateral
+	maximumCollateralRplPrice *prometheus.Desc
+
+	// The amount of RPL needed to restore the target collateral ratio
+	rplNeededForTargetRatio *prometheus.Desc
+
 	// The Rocket Pool contract manager
 	rp *rocketpool.RocketPool
 
@@ -95,6 +105,9 @@ type NodeCollector struct {
 	// The node's address
 	nodeAddress common.Address
 
+	// Whether this is a watched node rather than the one this daemon runs, so the local client status doesn't apply to it
+	watched bool
+
 	// The event log interval for the current eth1 client
 	eventLogInterval *big.Int
 
@@ -122,6 +135,23 @@ type NodeCollector struct {
 
 // Create a new NodeCollector instance
 func NewNodeCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManager, ec *services.ExecutionClientManager, nodeAddress common.Address, cfg *config.RocketPoolConfig, stateLocker *StateLocker) *NodeCollector {
+	return newNodeCollector(rp, bc, ec, nodeAddress, cfg, stateLocker, "node", nil)
+}
+
+// Create a new NodeCollector instance for a node that is only watched by this daemon. Its metrics have their own names
+// and are labeled with its address, so the metrics of the node running this daemon (and the dashboards using them) don't change.
+func NewWatchedNodeCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManager, ec *services.ExecutionClientManager, nodeAddress common.Address, cfg *config.RocketPoolConfig, stateLocker *StateLocker) *NodeCollector {
+	collector := newNodeCollector(rp, bc, ec, nodeAddress, cfg, stateLocker, "watched_node", prometheus.Labels{"address": nodeAddress.Hex()})
+	if collector == nil {
+		return nil
+	}
+	collector.watched = true
+	collector.logPrefix = fmt.Sprintf("Node Collector (%s)", nodeAddress.Hex())
+	return collector
+}
+
+// Create a NodeCollector with the given metric subsystem and constant labels on all of its metrics
+func newNodeCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManager, ec *services.ExecutionClientManager, nodeAddress common.Address, cfg *config.RocketPoolConfig, stateLocker *StateLocker, subsystem string, nodeLabels prometheus.Labels) *NodeCollector {
 
 	// Get the event log interval
 	eventLogInterval, err := cfg.GetEventLogInterval()
@@ -130,51 +160,50 @@ func NewNodeCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManage
 		return nil
 	}
 
-	subsystem := "node"
 	return &NodeCollector{
 		totalStakedRpl: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "total_staked_rpl"),
 			"The total amount of RPL staked on the node",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		effectiveStakedRpl: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "effective_staked_rpl"),
 			"The effective amount of RPL staked on the node (honoring the 150% collateral cap)",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		rewardableStakedRpl: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "rewardable_staked_rpl"),
 			"The amount of staked RPL that will be eligible for rewards (including Beacon Chain data and accounding for pending bond reductions)",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		cumulativeRplRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "cumulative_rpl_rewards"),
 			"The cumulative RPL rewards earned by the node",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		expectedRplRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "expected_rpl_rewards"),
 			"The expected RPL rewards for the node at the next rewards checkpoint",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		rplApr: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "rpl_apr"),
 			"The estimated APR of RPL for the node from the next rewards checkpoint",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		balances: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "balance"),
 			"How much ETH is in this node wallet",
-			[]string{"Token"}, nil,
+			[]string{"Token"}, nodeLabels,
 		),
 		activeMinipoolCount: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "active_minipool_count"),
 			"The number of active minipools owned by the node",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		depositedEth: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "deposited_eth"),
 			"The amount of ETH this node deposited into minipools",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		beaconShare: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "beacon_share"),
 			"The node's total share of its minipool's beacon chain balances",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		beaconBalance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "beacon_balance"),
 			"The total balances of all this node's validators on the beacon chain",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		clientSyncProgress: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sync_progress"),
 			"The sync progress of the beacon and execution clients",
@@ -182,35 +211,47 @@ func NewNodeCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManage
 		),
 		minipoolBalance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "minipool_balance"),
 			"The total EL balance of all minipools belonging to this node",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		minipoolShare: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "minipool_share"),
 			"The node's share of the total minipool EL balance",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		refundBalance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "refund_balance"),
 			"The amount of ETH waiting to be refunded for all minipools",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		unclaimedRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "unclaimed_rewards"),
 			"The RPL rewards from the last period that have not been claimed yet",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		claimedEthRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "claimed_eth_rewards"),
 			"The claimed ETH rewards from the smoothing pool",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		unclaimedEthRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "unclaimed_eth_rewards"),
 			"The unclaimed ETH rewards from the smoothing pool",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		borrowedCollateralRatio: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "borrowed_collateral_ratio"),
 			"The collateral ratio with respect to the amount of borrowed ETH",
-			nil, nil,
+			nil, nodeLabels,
 		),
 		bondedCollateralRatio: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "bonded_collateral_ratio"),
 			"The collateral ratio with respect to the amount of bonded ETH",
-			nil, nil,
+			nil, nodeLabels,
+		),
+		minimumCollateralRplPrice: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "minimum_collateral_rpl_price"),
+			"The RPL price (in ETH) below which the node falls under the minimum rewardable collateral",
+			nil, nodeLabels,
+		),
+		maximumCollateralRplPrice: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "maximum_collateral_rpl_price"),
+			"The RPL price (in ETH) above which the node exceeds the maximum rewardable collateral",
+			nil, nodeLabels,
+		),
+		rplNeededForTargetRatio: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "rpl_needed_for_target_ratio"),
+			"The amount of RPL the node needs to stake to restore its target collateral ratio",
+			nil, nodeLabels,
 		),
 		rp:               rp,
 		bc:               bc,
@@ -237,7 +278,9 @@ func (collector *NodeCollector) Describe(channel chan<- *prometheus.Desc) {
 	channel <- collector.depositedEth
 	channel <- collector.beaconBalance
 	channel <- collector.beaconShare
-	channel <- collector.clientSyncProgress
+	if !collector.watched {
+		channel <- collector.clientSyncProgress
+	}
 	channel <- collector.minipoolBalance
 	channel <- collector.minipoolShare
 	channel <- collector.refundBalance
@@ -246,6 +289,9 @@ func (collector *NodeCollector) Describe(channel chan<- *prometheus.Desc) {
 	channel <- collector.unclaimedEthRewards
 	channel <- collector.borrowedCollateralRatio
 	channel <- collector.bondedCollateralRatio
+	channel <- collector.minimumCollateralRplPrice
+	channel <- collector.maximumCollateralRplPrice
+	channel <- collector.rplNeededForTargetRatio
 }
 
 // Collect the latest metric values and pass them to Prometheus
@@ -256,7 +302,10 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 		return
 	}
 
-	nd := state.NodeDetailsByAddress[collector.nodeAddress]
+	nd, exists := state.NodeDetailsByAddress[collector.nodeAddress]
+	if !exists {
+		return
+	}
 	minipools := state.MinipoolDetailsByNode[collector.nodeAddress]
 
 	// Sync
@@ -371,36 +420,39 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 		return nil
 	})
 
-	// get the beacon client sync status:
-	wg.Go(func() error {
-		progress := float64(0)
+	// Get the client sync status, which only applies to the node running this daemon
+	if !collector.watched {
+		// get the beacon client sync status:
+		wg.Go(func() error {
+			progress := float64(0)
 
-		syncStatus, err := collector.bc.GetSyncStatus()
+			syncStatus, err := collector.bc.GetSyncStatus()
 
-		if err != nil {
-			// NOTE: returning here causes the metric to not be emitted. the endpoint stays responsive, but also slightly more accurate (progress=nothing instead of 0)
-			fmt.Printf("error getting beacon chain sync status: %s", err.Error())
-			return nil
-		} else {
-			progress = syncStatus.Progress
-			if !syncStatus.Syncing {
-				progress = 1.0
+			if err != nil {
+				// NOTE: returning here causes the metric to not be emitted. the endpoint stays responsive, but also slightly more accurate (progress=nothing instead of 0)
+				fmt.Printf("error getting beacon chain sync status: %s", err.Error())
+				return nil
+			} else {
+				progress = syncStatus.Progress
+				if !syncStatus.Syncing {
+					progress = 1.0
+				}
 			}
-		}
-		// note this metric is emitted asynchronously, while others in this file tend to be emitted at the end of the outer function (mostly due to dependencies between metrics). See https://github.com/rocket-pool/smartnode/issues/186
-		channel <- prometheus.MustNewConstMetric(
-			collector.clientSyncProgress, prometheus.GaugeValue, progress, "beacon")
-		return nil
-	})
-
-	// get the execution client sync status:
-	wg.Go(func() error {
-		syncStatus := collector.ec.CheckStatus(collector.cfg)
-		// note this metric is emitted asynchronously, while others in this file tend to be emitted at the end of the outer function (mostly due to dependencies between metrics). See https://github.com/rocket-pool/smartnode/issues/186
-		channel <- prometheus.MustNewConstMetric(
-			collector.clientSyncProgress, prometheus.GaugeValue, syncStatus.PrimaryClientStatus.SyncProgress, "execution")
-		return nil
-	})
+			// note this metric is emitted asynchronously, while others in this file tend to be emitted at the end of the outer function (mostly due to dependencies between metrics). See https://github.com/rocket-pool/smartnode/issues/186
+			channel <- prometheus.MustNewConstMetric(
+				collector.clientSyncProgress, prometheus.GaugeValue, progress, "beacon")
+			return nil
+		})
+
+		// get the execution client sync status:
+		wg.Go(func() error {
+			syncStatus := collector.ec.CheckStatus(collector.cfg)
+			// note this metric is emitted asynchronously, while others in this file tend to be emitted at the end of the outer function (mostly due to dependencies between metrics). See https://github.com/rocket-pool/smartnode/issues/186
+			channel <- prometheus.MustNewConstMetric(
+				collector.clientSyncProgress, prometheus.GaugeValue, syncStatus.PrimaryClientStatus.SyncProgress, "execution")
+			return nil
+		})
+	}
 
 	// Get the number of active minipools on the node
 	wg.Go(func() error {
@@ -592,6 +644,18 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 		borrowedCollateralRatio = rplPrice * stakedRpl / pendingBorrowedEthFloat
 	}
 
+	// Collateral projection; a missing threshold means no price would satisfy it
+	targetRatio := collector.cfg.Smartnode.CollateralTargetRatio.Value.(float64)
+	projection := rputils.ProjectCollateral(nd.RplStake, pendingBorrowedEth, pendingBondedEth, rplPriceRaw, state.NetworkDetails.MinCollateralFraction, state.NetworkDetails.MaxCollateralFraction, targetRatio)
+	minimumCollateralRplPrice := math.Inf(1)
+	if projection.MinimumRplPrice != nil {
+		minimumCollateralRplPrice = eth.WeiToEth(projection.MinimumRplPrice)
+	}
+	maximumCollateralRplPrice := float64(0)
+	if projection.MaximumRplPrice != nil {
+		maximumCollateralRplPrice = eth.WeiToEth(projection.MaximumRplPrice)
+	}
+
 	// Update all the metrics
 	channel <- prometheus.MustNewConstMetric(
 		collector.totalStakedRpl, prometheus.GaugeValue, stakedRpl)
@@ -637,6 +701,12 @@ func (collector *NodeCollector) Collect(channel chan<- prometheus.Metric) {
 		collector.borrowedCollateralRatio, prometheus.GaugeValue, borrowedCollateralRatio)
 	channel <- prometheus.MustNewConstMetric(
 		collector.bondedCollateralRatio, prometheus.GaugeValue, bondedCollateralRatio)
+	channel <- prometheus.MustNewConstMetric(
+		collector.minimumCollateralRplPrice, prometheus.GaugeValue, minimumCollateralRplPrice)
+	channel <- prometheus.MustNewConstMetric(
+		collector.maximumCollateralRplPrice, prometheus.GaugeValue, maximumCollateralRplPrice)
+	channel <- prometheus.MustNewConstMetric(
+		collector.rplNeededForTargetRatio, prometheus.GaugeValue, eth.WeiToEth(projection.RplNeededForTarget))
 }
 
 // Log error messages
@@ -138,6 +138,11 @@ func (t *defendPdaoProps) run(state *state.NetworkState) error {
 		return nil
 	}
 
+	// Only the proposer can respond to challenges
+	if !api.CheckNodeKeyAvailable(t.cfg, t.w, "Defending Protocol DAO proposals", t.log) {
+		return nil
+	}
+
 	// Defend props
 	for _, prop := range defendableProps {
 		err := t.defendProposal(prop)
@@ -168,23 +173,12 @@ func (t *defendPdaoProps) getDefendableProposals(state *state.NetworkState, opts
 	var startBlock *big.Int
 	endBlock := big.NewInt(int64(state.ElBlockNumber))
 	if t.lastScannedBlock == nil {
-		// Get the slot number the first proposal was created on
-		startTime := eligibleProps[0].CreatedTime
-		genesisTime := time.Unix(int64(state.BeaconConfig.GenesisTime), 0)
-		secondsPerSlot := time.Second * time.Duration(state.BeaconConfig.SecondsPerSlot)
-		startSlot := uint64(startTime.Sub(genesisTime) / secondsPerSlot)
-
-		// Get the Beacon block for the slot
-		block, exists, err := t.bc.GetBeaconBlock(fmt.Sprint(startSlot))
+		// Start at the block the first proposal was created on
+		var err error
+		startBlock, err = getPdaoProposalCreationBlock(t.bc, state, eligibleProps[0])
 		if err != nil {
-			return nil, fmt.Errorf("error getting Beacon block at slot %d: %w", startSlot, err)
+			return nil, err
 		}
-		if !exists {
-			return nil, fmt.Errorf("beacon block at slot %d was missing", startSlot)
-		}
-
-		// Get the EL block for this slot
-		startBlock = big.NewInt(int64(block.ExecutionBlockNumber))
 	} else {
 		startBlock = big.NewInt(0).Add(t.lastScannedBlock, common.Big1)
 	}
@@ -256,7 +250,7 @@ func (t *defendPdaoProps) defendProposal(prop defendableProposal) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return err
 		}
@@ -268,7 +262,7 @@ func (t *defendPdaoProps) defendProposal(prop defendableProposal) error {
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Respond to the challenge
@@ -289,3 +283,24 @@ func (t *defendPdaoProps) defendProposal(prop defendableProposal) error {
 	// Return
 	return nil
 }
+
+// Get the EL block a pDAO proposal was created on
+func getPdaoProposalCreationBlock(bc beacon.Client, state *state.NetworkState, prop protocol.ProtocolDaoProposalDetails) (*big.Int, error) {
+	// Get the slot number the proposal was created on
+	startTime := prop.CreatedTime
+	genesisTime := time.Unix(int64(state.BeaconConfig.GenesisTime), 0)
+	secondsPerSlot := time.Second * time.Duration(state.BeaconConfig.SecondsPerSlot)
+	startSlot := uint64(startTime.Sub(genesisTime) / secondsPerSlot)
+
+	// Get the Beacon block for the slot
+	block, exists, err := bc.GetBeaconBlock(fmt.Sprint(startSlot))
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon block at slot %d: %w", startSlot, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("beacon block at slot %d was missing", startSlot)
+	}
+
+	// Get the EL block for this slot
+	return big.NewInt(int64(block.ExecutionBlockNumber)), nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
@@ -159,6 +160,14 @@ func (t *distributeMinipools) run(state *state.NetworkState) error {
 	// Log
 	t.log.Printlnf("%d minipool(s) can have their balances distributed...", len(minipools))
 
+	// Bundle the minipools into batch transactions if requested
+	if t.cfg.Smartnode.BatchMinipoolTxs.Value == true {
+		minipools, err = t.distributeMinipoolBatches(minipools, opts)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Distribute minipools
 	successCount := 0
 	for _, mpd := range minipools {
@@ -217,8 +226,8 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 		return false, fmt.Errorf("cannot create binding for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
 	}
 
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	// Get transactor; anyone can distribute the rewards of a minipool below 8 ETH, so this can use the delegated hot key
+	opts, err := t.w.GetDelegateTransactor()
 	if err != nil {
 		return false, err
 	}
@@ -242,7 +251,7 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return false, err
 		}
@@ -254,7 +263,7 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Distribute minipool
@@ -276,3 +285,138 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	return true, nil
 
 }
+
+// Distribute the minipools that the multicall contract is allowed to distribute in batch transactions.
+// Returns the minipools that still need their own transactions.
+func (t *distributeMinipools) distributeMinipoolBatches(minipools []*rpstate.NativeMinipoolDetails, callOpts *bind.CallOpts) ([]*rpstate.NativeMinipoolDetails, error) {
+
+	// Nothing to bundle with a single minipool
+	if len(minipools) < 2 {
+		return minipools, nil
+	}
+
+	// Get the calls
+	calls := make([]eth1.BatchCall, len(minipools))
+	for i, mpd := range minipools {
+		mp, err := minipool.NewMinipoolFromVersion(t.rp, mpd.MinipoolAddress, mpd.Version, callOpts)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create binding for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
+		}
+		calls[i], err = eth1.NewBatchCall(mp.GetContract(), "distributeBalance", true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Check which ones the multicall contract can make
+	multicallAddress := common.HexToAddress(t.cfg.Smartnode.GetMulticallAddress())
+	batchable, err := eth1.GetBatchableCalls(t.rp.Client, multicallAddress, calls)
+	if err != nil {
+		return nil, err
+	}
+	remaining := []*rpstate.NativeMinipoolDetails{}
+	batched := []*rpstate.NativeMinipoolDetails{}
+	batchCalls := []eth1.BatchCall{}
+	for i, mpd := range minipools {
+		if batchable[i] {
+			batched = append(batched, mpd)
+			batchCalls = append(batchCalls, calls[i])
+		} else {
+			remaining = append(remaining, mpd)
+		}
+	}
+	if len(batched) < 2 {
+		return minipools, nil
+	}
+
+	// Distribute the batches
+	contract, err := eth1.GetBatchContract(t.rp.Client, multicallAddress)
+	if err != nil {
+		return nil, err
+	}
+	for bsi := 0; bsi < len(batchCalls); bsi += eth1.MaxBatchCalls {
+
+		// Get batch start & end index
+		msi := bsi
+		mei := bsi + eth1.MaxBatchCalls
+		if mei > len(batchCalls) {
+			mei = len(batchCalls)
+		}
+
+		err := t.distributeMinipoolBatch(contract, batched[msi:mei], batchCalls[msi:mei])
+		for _, mpd := range batched[msi:mei] {
+			alerting.AlertMinipoolBalanceDistributed(t.cfg, mpd.MinipoolAddress, err == nil)
+		}
+		if err != nil {
+			t.log.Println(fmt.Errorf("Could not distribute balances of %d minipools in a batch: %w", mei-msi, err))
+			return nil, err
+		}
+
+	}
+
+	// Return
+	return remaining, nil
+
+}
+
+// Distribute a batch of minipools in a single transaction
+func (t *distributeMinipools) distributeMinipoolBatch(contract *rocketpool.Contract, minipools []*rpstate.NativeMinipoolDetails, calls []eth1.BatchCall) error {
+
+	// Log
+	t.log.Printlnf("Distributing %d minipools in a single transaction...", len(minipools))
+
+	// Get transactor; the multicall contract makes the calls, so this can use the delegated hot key like the individual distributions
+	opts, err := t.w.GetDelegateTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Get the gas limit
+	gasInfo, err := eth1.EstimateBatchGas(contract, calls, opts)
+	if err != nil {
+		return fmt.Errorf("Could not estimate the gas required to distribute the batch: %w", err)
+	}
+	var gas *big.Int
+	if t.gasLimit != 0 {
+		gas = new(big.Int).SetUint64(t.gasLimit)
+	} else {
+		gas = new(big.Int).SetUint64(gasInfo.SafeGasLimit)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Print the gas info
+	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+		return nil
+	}
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = gas.Uint64()
+
+	// Distribute the minipools
+	hash, err := eth1.SendBatch(contract, calls, opts)
+	if err != nil {
+		return err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Printlnf("Successfully distributed balances of %d minipools.", len(minipools))
+
+	// Return
+	return nil
+
+}
@@ -6,10 +6,12 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
@@ -43,18 +45,21 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	}
 
 	// Return if metrics are disabled
+	if !isMetricsEnabled(cfg) {
+		return nil
+	}
 	if cfg.EnableMetrics.Value == false {
-		if strings.ToLower(os.Getenv("ENABLE_METRICS")) == "true" {
-			logger.Printlnf("ENABLE_METRICS override set to true, will start Metrics exporter anyway!")
-		} else {
-			return nil
-		}
+		logger.Printlnf("ENABLE_METRICS override set to true, will start Metrics exporter anyway!")
 	}
 
 	nodeAccount, err := w.GetNodeAccount()
 	if err != nil {
 		return fmt.Errorf("Error getting node account: %w", err)
 	}
+	watchedNodeAddresses, err := getWatchedNodeAddresses(cfg, nodeAccount.Address)
+	if err != nil {
+		return err
+	}
 
 	// Create the collectors
 	demandCollector := collectors.NewDemandCollector(rp, stateLocker)
@@ -79,6 +84,15 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	registry.MustRegister(beaconCollector)
 	registry.MustRegister(smoothingPoolCollector)
 
+	// Add the per-node metrics for any watched nodes
+	for _, address := range watchedNodeAddresses {
+		registry.MustRegister(collectors.NewWatchedNodeCollector(rp, bc, ec, address, cfg, stateLocker))
+		registry.MustRegister(collectors.NewWatchedBeaconCollector(rp, bc, ec, address, stateLocker))
+	}
+	if len(watchedNodeAddresses) > 0 {
+		logger.Printlnf("Watching %d additional node(s) for metrics.", len(watchedNodeAddresses))
+	}
+
 	// Set up snapshot checking if enabled
 	votingId := cfg.Smartnode.GetVotingSnapshotID()
 	if s != nil {
@@ -115,3 +129,26 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	return nil
 
 }
+
+// Check if the metrics exporter should run, either from the config or the ENABLE_METRICS override
+func isMetricsEnabled(cfg *config.RocketPoolConfig) bool {
+	return cfg.EnableMetrics.Value == true || strings.ToLower(os.Getenv("ENABLE_METRICS")) == "true"
+}
+
+// Get the additional nodes to include in the metrics, without the node running this daemon
+func getWatchedNodeAddresses(cfg *config.RocketPoolConfig, nodeAddress common.Address) ([]common.Address, error) {
+	addresses, err := cfg.GetWatchedNodeAddresses()
+	if err != nil {
+		return nil, err
+	}
+	watched := []common.Address{}
+	seen := map[common.Address]bool{nodeAddress: true}
+	for _, address := range addresses {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		watched = append(watched, address)
+	}
+	return watched, nil
+}
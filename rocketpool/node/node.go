@@ -17,10 +17,12 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
@@ -41,9 +43,17 @@ const (
 	DefendPdaoPropsColor         = color.FgYellow
 	VerifyPdaoPropsColor         = color.FgYellow
 	DistributeMinipoolsColor     = color.FgHiGreen
+	ValidatorPerformanceColor    = color.FgHiMagenta
+	SendDeferredTxsColor         = color.FgHiCyan
+	ClaimRewardsColor            = color.FgGreen
+	CheckCollateralColor         = color.FgHiRed
+	VotePdaoPropsColor           = color.FgHiYellow
+	AlertPdaoPropsColor          = color.FgHiBlue
 	ErrorColor                   = color.FgRed
 	WarningColor                 = color.FgYellow
 	UpdateColor                  = color.FgHiWhite
+	TxManagerColor               = color.FgCyan
+	ApiServerColor               = color.FgBlue
 )
 
 // Register node command
@@ -76,6 +86,14 @@ func run(c *cli.Context) error {
 	// Configure
 	configureHTTP()
 
+	// Serve the API to the CLI while the node daemon is running, including before the node is registered
+	go func() {
+		apiServerLog := log.NewColorLogger(ApiServerColor)
+		if err := runApiServer(c, apiServerLog); err != nil {
+			apiServerLog.Println(err)
+		}
+	}()
+
 	// Wait until node is registered
 	if err := services.WaitNodeRegistered(c, true); err != nil {
 		return err
@@ -110,11 +128,39 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error getting node account: %w", err)
 	}
+	watchOnly := w.IsWatchOnly()
+	if watchOnly {
+		fmt.Printf("Watch-only mode is enabled for node %s; tasks that send transactions will not run.\n", nodeAccount.Address.Hex())
+	}
+
+	// Automated tasks can't wait for an external signer to approve their transactions, so permissionless ones use the delegated hot key
+	// and the ones only the node account can do alert the operator to do them manually
+	if w.IsNodeAccountExternal() {
+		delegateOpts, err := w.GetDelegateTransactor()
+		if err != nil {
+			return fmt.Errorf("error getting delegated hot key: %w", err)
+		}
+		fmt.Printf("Node account %s is held by an external signer; permissionless tasks will send transactions from the delegated hot key %s, and the others will alert you to do them manually.\n", nodeAccount.Address.Hex(), delegateOpts.From.Hex())
+	}
 
 	// Initialize loggers
 	errorLog := log.NewColorLogger(ErrorColor)
 	updateLog := log.NewColorLogger(UpdateColor)
 
+	// Track the daemon's transactions so stuck ones can be replaced, including any left over from before a restart
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return err
+	}
+	txLog := log.NewColorLogger(TxManagerColor)
+	txManager, err := txmanager.NewTxManager(cfg, w, ec, txmanager.NodeJournalName, &txLog)
+	if err != nil {
+		return err
+	}
+	ec.SetTransactionListener(txManager.Record)
+	api.SetTransactionWaiter(txManager)
+	txManager.Resume()
+
 	// Create the state manager
 	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, &updateLog)
 	if err != nil {
@@ -122,6 +168,31 @@ func run(c *cli.Context) error {
 	}
 	stateLocker := collectors.NewStateLocker()
 
+	// Get the nodes to include in the network state; watched nodes are only needed for the metrics
+	stateNodeAddresses := []common.Address{nodeAccount.Address}
+	if isMetricsEnabled(cfg) {
+		watchedNodeAddresses, err := getWatchedNodeAddresses(cfg, nodeAccount.Address)
+		if err != nil {
+			return err
+		}
+		stateNodeAddresses = append(stateNodeAddresses, watchedNodeAddresses...)
+	}
+
+	// Create the incremental state updater if it's enabled
+	var stateUpdater *state.IncrementalStateUpdater
+	if cfg.Smartnode.IncrementalStateUpdates.Value.(bool) {
+		stateUpdater = state.NewIncrementalStateUpdater(m, stateNodeAddresses)
+	}
+
+	// Create the state snapshot store if snapshots are enabled
+	var snapshotStore *state.SnapshotStore
+	if cfg.Smartnode.StateSnapshotInterval.Value.(uint64) > 0 {
+		snapshotStore, err = state.NewSnapshotStoreFromConfig(cfg)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Initialize tasks
 	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
 	if err != nil {
@@ -143,14 +214,38 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	claimRewards, err := newClaimRewards(c, log.NewColorLogger(ClaimRewardsColor))
+	if err != nil {
+		return err
+	}
 	reduceBonds, err := newReduceBonds(c, log.NewColorLogger(ReduceBondAmountColor))
 	if err != nil {
 		return err
 	}
+	checkValidatorPerformance, err := newCheckValidatorPerformance(c, log.NewColorLogger(ValidatorPerformanceColor))
+	if err != nil {
+		return err
+	}
+	checkCollateral, err := newCheckCollateral(c, log.NewColorLogger(CheckCollateralColor))
+	if err != nil {
+		return err
+	}
+	sendDeferredTxs, err := newSendDeferredTxs(c, log.NewColorLogger(SendDeferredTxsColor))
+	if err != nil {
+		return err
+	}
 	defendPdaoProps, err := newDefendPdaoProps(c, log.NewColorLogger(DefendPdaoPropsColor))
 	if err != nil {
 		return err
 	}
+	votePdaoProps, err := newVotePdaoProps(c, log.NewColorLogger(VotePdaoPropsColor))
+	if err != nil {
+		return err
+	}
+	alertPdaoProps, err := newAlertPdaoProps(c, log.NewColorLogger(AlertPdaoPropsColor))
+	if err != nil {
+		return err
+	}
 	var verifyPdaoProps *verifyPdaoProps
 	// Make sure the user opted into this duty
 	verifyEnabled := cfg.Smartnode.VerifyProposals.Value.(bool)
@@ -212,7 +307,7 @@ func run(c *cli.Context) error {
 				updateTotalEffectiveStake = true
 				lastTotalEffectiveStakeTime = time.Now() // Even if the call below errors out, this will prevent contant errors related to this flag
 			}
-			state, totalEffectiveStake, err := updateNetworkState(m, &updateLog, nodeAccount.Address, updateTotalEffectiveStake)
+			state, totalEffectiveStake, err := updateNetworkState(m, stateUpdater, &updateLog, stateNodeAddresses, updateTotalEffectiveStake)
 			if err != nil {
 				errorLog.Println(err)
 				time.Sleep(taskCooldown)
@@ -220,6 +315,16 @@ func run(c *cli.Context) error {
 			}
 			stateLocker.UpdateState(state, totalEffectiveStake)
 
+			// Save a snapshot of the state
+			if snapshotStore != nil {
+				saved, err := snapshotStore.Save(state)
+				if err != nil {
+					errorLog.Printlnf("error saving state snapshot: %s", err.Error())
+				} else if saved {
+					updateLog.Printlnf("Saved a state snapshot for slot %d.", state.BeaconSlotNumber)
+				}
+			}
+
 			// Check for Houston
 			if !isHoustonDeployedMasterFlag && state.IsHoustonDeployed {
 				printHoustonMessage(&updateLog)
@@ -238,43 +343,86 @@ func run(c *cli.Context) error {
 			}
 			time.Sleep(taskCooldown)
 
-			if state.IsHoustonDeployed {
-				// Run the pDAO proposal defender
-				if err := defendPdaoProps.run(state); err != nil {
+			// Run the auto-claim check
+			if !watchOnly {
+				if err := claimRewards.run(state); err != nil {
 					errorLog.Println(err)
 				}
 				time.Sleep(taskCooldown)
-
-				// Run the pDAO proposal verifier
-				if verifyPdaoProps != nil {
-					if err := verifyPdaoProps.run(state); err != nil {
-						errorLog.Println(err)
-					}
-					time.Sleep(taskCooldown)
-				}
 			}
 
-			// Run the minipool stake check
-			if err := stakePrelaunchMinipools.run(state); err != nil {
+			// Check the validators for missed duties and balance decreases
+			if err := checkValidatorPerformance.run(state); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
-			// Run the balance distribution check
-			if err := distributeMinipools.run(state); err != nil {
+			// Check the node's collateral against its target ratio
+			if err := checkCollateral.run(state); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
-			// Run the reduce bond check
-			if err := reduceBonds.run(state); err != nil {
-				errorLog.Println(err)
+			// Vote on pDAO proposals according to the voting policy; watch-only nodes only do a dry run
+			if state.IsHoustonDeployed {
+				if err := votePdaoProps.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Send alerts for pDAO proposal events
+				if err := alertPdaoProps.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
 			}
-			time.Sleep(taskCooldown)
 
-			// Run the minipool promotion check
-			if err := promoteMinipools.run(state); err != nil {
-				errorLog.Println(err)
+			// Run the tasks that send transactions, unless the node is only being watched
+			if !watchOnly {
+				if state.IsHoustonDeployed {
+					// Run the pDAO proposal defender
+					if err := defendPdaoProps.run(state); err != nil {
+						errorLog.Println(err)
+					}
+					time.Sleep(taskCooldown)
+
+					// Run the pDAO proposal verifier
+					if verifyPdaoProps != nil {
+						if err := verifyPdaoProps.run(state); err != nil {
+							errorLog.Println(err)
+						}
+						time.Sleep(taskCooldown)
+					}
+				}
+
+				// Run the minipool stake check
+				if err := stakePrelaunchMinipools.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the balance distribution check
+				if err := distributeMinipools.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the reduce bond check
+				if err := reduceBonds.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the minipool promotion check
+				if err := promoteMinipools.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Send any deferred transactions that are ready
+				if err := sendDeferredTxs.run(); err != nil {
+					errorLog.Println(err)
+				}
 			}
 
 			time.Sleep(tasksInterval)
@@ -376,9 +524,20 @@ func removeLegacyFeeRecipientFiles(c *cli.Context) error {
 }
 
 // Update the latest network state at each cycle
-func updateNetworkState(m *state.NetworkStateManager, log *log.ColorLogger, nodeAddress common.Address, calculateTotalEffectiveStake bool) (*state.NetworkState, *big.Int, error) {
+func updateNetworkState(m *state.NetworkStateManager, updater *state.IncrementalStateUpdater, log *log.ColorLogger, nodeAddresses []common.Address, calculateTotalEffectiveStake bool) (*state.NetworkState, *big.Int, error) {
 	// Get the state of the network
-	state, totalEffectiveStake, err := m.GetHeadStateForNode(nodeAddress, calculateTotalEffectiveStake)
+	if updater != nil {
+		targetSlot, err := m.GetHeadSlot()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
+		}
+		state, totalEffectiveStake, err := updater.Update(targetSlot, calculateTotalEffectiveStake)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error updating network state: %w", err)
+		}
+		return state, totalEffectiveStake, nil
+	}
+	state, totalEffectiveStake, err := m.GetHeadStateForNodes(nodeAddresses, calculateTotalEffectiveStake)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error updating network state: %w", err)
 	}
@@ -127,6 +127,11 @@ func (t *promoteMinipools) run(state *state.NetworkState) error {
 	// Log
 	t.log.Printlnf("%d minipool(s) are ready for promotion...", len(minipools))
 
+	// Only the minipool owner can promote them
+	if !api.CheckNodeKeyAvailable(t.cfg, t.w, "Promoting minipools", &t.log) {
+		return nil
+	}
+
 	// Promote minipools
 	for _, mpd := range minipools {
 		_, err := t.promoteMinipool(mpd, opts)
@@ -213,7 +218,7 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return false, err
 		}
@@ -236,7 +241,7 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Promote minipool
@@ -162,6 +162,11 @@ func (t *reduceBonds) run(state *state.NetworkState) error {
 	// Log
 	t.log.Printlnf("%d minipool(s) are ready for bond reduction...", len(minipools))
 
+	// Only the minipool owner can reduce their bonds
+	if !api.CheckNodeKeyAvailable(t.cfg, t.w, "Reducing minipool bonds", &t.log) {
+		return nil
+	}
+
 	// Workaround for the fee distribution issue
 	success, err := t.forceFeeDistribution()
 	if err != nil {
@@ -269,7 +274,7 @@ func (t *reduceBonds) forceFeeDistribution() (bool, error) {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return false, err
 		}
@@ -281,7 +286,7 @@ func (t *reduceBonds) forceFeeDistribution() (bool, error) {
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Distribute
@@ -379,7 +384,7 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return false, err
 		}
@@ -400,7 +405,7 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Reduce bond
@@ -0,0 +1,341 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Send deferred transactions task
+type sendDeferredTxs struct {
+	c              *cli.Context
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              *wallet.Wallet
+	rp             *rocketpool.RocketPool
+	path           string
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+}
+
+// Create send deferred transactions task
+func newSendDeferredTxs(c *cli.Context, logger log.ColorLogger) (*sendDeferredTxs, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &sendDeferredTxs{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		path:           cfg.Smartnode.GetDeferredTxPath(),
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+	}, nil
+
+}
+
+// Send any deferred transactions whose conditions have been met
+func (t *sendDeferredTxs) run() error {
+
+	// Check on the transactions that were sent but never finished, e.g. because the daemon restarted while waiting for them
+	txs, err := txmanager.LoadDeferredTransactions(t.path)
+	if err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		if tx.Status == txmanager.DeferredTransactionStatus_Sent {
+			t.reconcileSentTx(tx)
+		}
+	}
+
+	// Get the queued transactions
+	txs, err = txmanager.LoadDeferredTransactions(t.path)
+	if err != nil {
+		return err
+	}
+	queued := 0
+	for _, tx := range txs {
+		if tx.Status == txmanager.DeferredTransactionStatus_Queued {
+			queued++
+		}
+	}
+	if queued == 0 {
+		return nil
+	}
+
+	// Log
+	t.log.Printlnf("Checking %d deferred transaction(s)...", queued)
+
+	// Deferred transactions are sent from the node account
+	if !api.CheckNodeKeyAvailable(t.cfg, t.w, "Sending deferred transactions", &t.log) {
+		return nil
+	}
+
+	// Get the current base fee, and the max fee capped at the user-requested one
+	suggestion, err := rpgas.GetHeadlessGasPrices(t.rp.Client)
+	if err != nil {
+		return err
+	}
+	baseFeeGwei := eth.WeiToGwei(suggestion.BaseFeeWei)
+	maxFee := big.NewInt(0).Add(suggestion.RapidWei, t.maxPriorityFee)
+	if t.maxFee != nil && t.maxFee.Uint64() != 0 && maxFee.Cmp(t.maxFee) > 0 {
+		maxFee = t.maxFee
+	}
+
+	// Send the ones that are ready
+	now := time.Now()
+	for _, tx := range txs {
+		if tx.Status != txmanager.DeferredTransactionStatus_Queued {
+			continue
+		}
+		if !tx.IsReady(baseFeeGwei, now) {
+			if tx.WhenGasBelowGwei > 0 {
+				t.log.Printlnf("Deferred transaction %d (%s) is waiting for a base fee below %.2f gwei; the current base fee is %.2f gwei.", tx.ID, tx.Description, tx.WhenGasBelowGwei, baseFeeGwei)
+			} else {
+				t.log.Printlnf("Deferred transaction %d (%s) is scheduled for %s.", tx.ID, tx.Description, tx.NotAfter.Format(time.RFC1123))
+			}
+			continue
+		}
+		t.sendDeferredTx(tx, maxFee)
+	}
+
+	// Return
+	return nil
+
+}
+
+// Record the outcome of a deferred transaction that was sent but never finished
+func (t *sendDeferredTxs) reconcileSentTx(deferredTx txmanager.DeferredTransaction) {
+
+	// Check every version of it, since the daemon may have replaced it with higher fees
+	hashes := []common.Hash{deferredTx.TxHash}
+	journal, err := txmanager.LoadJournal(t.cfg.Smartnode.GetTxJournalPath(txmanager.NodeJournalName))
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't check deferred transaction %d: %s", deferredTx.ID, err.Error())
+		return
+	}
+	pending := false
+	for _, entry := range journal.GetEntries() {
+		if entry.From == deferredTx.From && entry.Nonce == deferredTx.Nonce {
+			hashes = append(hashes, entry.Hashes...)
+			pending = true
+		}
+	}
+	for _, hash := range hashes {
+		receipt, err := t.rp.Client.TransactionReceipt(context.Background(), hash)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't check deferred transaction %d: %s", deferredTx.ID, err.Error())
+			return
+		}
+		succeeded := receipt.Status == types.ReceiptStatusSuccessful
+		t.updateSentTx(deferredTx, hash, succeeded, fmt.Sprintf("Transaction %s failed with status 0", hash.Hex()))
+		return
+	}
+
+	// Wait for it if it's still being watched
+	if pending {
+		return
+	}
+
+	// If its nonce was used by something else it'll never be included, otherwise it was dropped and can be sent again
+	latestNonce, err := t.rp.Client.NonceAt(context.Background(), deferredTx.From, nil)
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't check deferred transaction %d: %s", deferredTx.ID, err.Error())
+		return
+	}
+	if latestNonce > deferredTx.Nonce {
+		t.updateSentTx(deferredTx, deferredTx.TxHash, false, fmt.Sprintf("Transaction %s was never included, and its nonce was used by another transaction", deferredTx.TxHash.Hex()))
+		return
+	}
+	t.log.Printlnf("Deferred transaction %d (%s) was dropped before it was included, so it will be sent again.", deferredTx.ID, deferredTx.Description)
+	if err := txmanager.UpdateDeferredTransaction(t.path, deferredTx.ID, func(tx *txmanager.DeferredTransaction) {
+		tx.Status = txmanager.DeferredTransactionStatus_Queued
+	}); err != nil {
+		t.log.Printlnf("WARNING: couldn't update deferred transaction %d: %s", deferredTx.ID, err.Error())
+	}
+
+}
+
+// Record whether a sent deferred transaction succeeded
+func (t *sendDeferredTxs) updateSentTx(deferredTx txmanager.DeferredTransaction, hash common.Hash, succeeded bool, failure string) {
+	err := txmanager.UpdateDeferredTransaction(t.path, deferredTx.ID, func(tx *txmanager.DeferredTransaction) {
+		tx.TxHash = hash
+		if succeeded {
+			tx.Status = txmanager.DeferredTransactionStatus_Completed
+		} else {
+			tx.Status = txmanager.DeferredTransactionStatus_Failed
+			tx.Error = failure
+		}
+	})
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't update deferred transaction %d: %s", deferredTx.ID, err.Error())
+		return
+	}
+	if succeeded {
+		t.log.Printlnf("Deferred transaction %d (%s) was included in a block.", deferredTx.ID, deferredTx.Description)
+	} else {
+		t.log.Printlnf("Deferred transaction %d (%s) failed: %s", deferredTx.ID, deferredTx.Description, failure)
+	}
+	alerting.AlertDeferredTransactionSent(t.cfg, deferredTx.ID, deferredTx.Description, hash, succeeded)
+}
+
+// Send a deferred transaction and record the outcome
+func (t *sendDeferredTxs) sendDeferredTx(deferredTx txmanager.DeferredTransaction, maxFee *big.Int) {
+
+	// Log
+	if !deferredTx.NotAfter.IsZero() && !time.Now().Before(deferredTx.NotAfter) {
+		t.log.Printlnf("Deferred transaction %d (%s) reached its deadline of %s, sending it...", deferredTx.ID, deferredTx.Description, deferredTx.NotAfter.Format(time.RFC1123))
+	} else {
+		t.log.Printlnf("The base fee is below %.2f gwei, sending deferred transaction %d (%s)...", deferredTx.WhenGasBelowGwei, deferredTx.ID, deferredTx.Description)
+	}
+
+	hash, err := t.send(deferredTx, maxFee)
+	if err == nil {
+		err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	}
+
+	// Record the outcome
+	succeeded := err == nil
+	updateErr := txmanager.UpdateDeferredTransaction(t.path, deferredTx.ID, func(tx *txmanager.DeferredTransaction) {
+		tx.SentTime = time.Now()
+		tx.TxHash = hash
+		if succeeded {
+			tx.Status = txmanager.DeferredTransactionStatus_Completed
+		} else {
+			tx.Status = txmanager.DeferredTransactionStatus_Failed
+			tx.Error = err.Error()
+		}
+	})
+	if updateErr != nil {
+		t.log.Printlnf("WARNING: couldn't update deferred transaction %d: %s", deferredTx.ID, updateErr.Error())
+	}
+	if succeeded {
+		t.log.Printlnf("Successfully sent deferred transaction %d.", deferredTx.ID)
+	} else {
+		t.log.Printlnf("Deferred transaction %d failed: %s", deferredTx.ID, err.Error())
+	}
+	alerting.AlertDeferredTransactionSent(t.cfg, deferredTx.ID, deferredTx.Description, hash, succeeded)
+
+}
+
+// Sign and send a deferred transaction with the current nonce and fees
+func (t *sendDeferredTxs) send(deferredTx txmanager.DeferredTransaction, maxFee *big.Int) (common.Hash, error) {
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if opts.From != deferredTx.From {
+		return common.Hash{}, fmt.Errorf("the transaction must be sent by %s, but the daemon signs for %s", deferredTx.From.Hex(), opts.From.Hex())
+	}
+	tx := deferredTx.Tx
+	if tx == nil {
+		return common.Hash{}, fmt.Errorf("the deferred transaction is empty")
+	}
+
+	// Make sure it will still succeed
+	gas, err := t.rp.Client.EstimateGas(context.Background(), ethereum.CallMsg{
+		From:  deferredTx.From,
+		To:    tx.To(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("the transaction would fail: %w", err)
+	}
+	if tx.Gas() > gas {
+		gas = tx.Gas()
+	}
+
+	// Get the nonce and fees
+	nonce, err := t.rp.Client.PendingNonceAt(context.Background(), deferredTx.From)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error getting nonce: %w", err)
+	}
+	priorityFee := rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
+
+	// Sign and send it
+	signedTx, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:    t.w.GetChainID(),
+		Nonce:      nonce,
+		GasTipCap:  priorityFee,
+		GasFeeCap:  maxFee,
+		Gas:        gas,
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error signing transaction: %w", err)
+	}
+	if err := t.rp.Client.SendTransaction(context.Background(), signedTx); err != nil {
+		return common.Hash{}, fmt.Errorf("error sending transaction: %w", err)
+	}
+	if err := txmanager.UpdateDeferredTransaction(t.path, deferredTx.ID, func(tx *txmanager.DeferredTransaction) {
+		tx.Status = txmanager.DeferredTransactionStatus_Sent
+		tx.SentTime = time.Now()
+		tx.TxHash = signedTx.Hash()
+		tx.Nonce = nonce
+	}); err != nil {
+		t.log.Printlnf("WARNING: couldn't update deferred transaction %d: %s", deferredTx.ID, err.Error())
+	}
+	return signedTx.Hash(), nil
+
+}
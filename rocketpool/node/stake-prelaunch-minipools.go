@@ -140,6 +140,11 @@ func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
 	// Log
 	t.log.Printlnf("%d minipool(s) are ready for staking...", len(minipools))
 
+	// Only the minipool owner can stake them
+	if !api.CheckNodeKeyAvailable(t.cfg, t.w, "Staking prelaunch minipools", &t.log) {
+		return nil
+	}
+
 	// Stake minipools
 	successCount := 0
 	for _, mpd := range minipools {
@@ -216,12 +221,8 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	// Get minipool withdrawal credentials
 	withdrawalCredentials := mpd.WithdrawalCredentials
 
-	// Get the validator key for the minipool
+	// Get the validator pubkey for the minipool
 	validatorPubkey := mpd.Pubkey
-	validatorKey, err := t.w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return false, err
-	}
 
 	// Get the minipool type
 	depositType := mpd.DepositType
@@ -237,7 +238,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	}
 
 	// Get validator deposit data
-	depositData, depositDataRoot, err := validator.GetDepositData(validatorKey, withdrawalCredentials, state.BeaconConfig, depositAmount)
+	depositData, depositDataRoot, err := t.w.GetDepositData(validatorPubkey, withdrawalCredentials, state.BeaconConfig, depositAmount)
 	if err != nil {
 		return false, err
 	}
@@ -264,7 +265,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return false, err
 		}
@@ -287,7 +288,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Stake minipool
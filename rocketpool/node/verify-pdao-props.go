@@ -146,11 +146,13 @@ func (t *verifyPdaoProps) run(state *state.NetworkState) error {
 		return fmt.Errorf("error checking for challenges or defeats: %w", err)
 	}
 
-	// Submit challenges
-	for _, challenge := range challenges {
-		err := t.submitChallenge(challenge)
-		if err != nil {
-			return fmt.Errorf("error submitting challenge against proposal %d, index %d: %w", challenge.proposalID, challenge.challengedIndex, err)
+	// Submit challenges; only a registered node can challenge, so they need the node account
+	if len(challenges) > 0 && api.CheckNodeKeyAvailable(t.cfg, t.w, "Challenging Protocol DAO proposals", t.log) {
+		for _, challenge := range challenges {
+			err := t.submitChallenge(challenge)
+			if err != nil {
+				return fmt.Errorf("error submitting challenge against proposal %d, index %d: %w", challenge.proposalID, challenge.challengedIndex, err)
+			}
 		}
 	}
 
@@ -371,7 +373,7 @@ func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return err
 		}
@@ -383,7 +385,7 @@ func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Respond to the challenge
@@ -411,8 +413,8 @@ func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
 	challengedIndex := defeat.challengedIndex
 	t.log.Printlnf("Proposal %d has been defeated with node index %d, submitting defeat...", propID, challengedIndex)
 
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	// Get transactor; anyone can defeat a proposal, so this can use the delegated hot key
+	opts, err := t.w.GetDelegateTransactor()
 	if err != nil {
 		return err
 	}
@@ -427,7 +429,7 @@ func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei()
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
 		if err != nil {
 			return err
 		}
@@ -439,7 +441,7 @@ func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
 	}
 
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = t.maxPriorityFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
 
 	// Respond to the challenge
@@ -0,0 +1,367 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/dao/protocol"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	// Votes bypass the gas threshold once the voting phase ends within this window
+	pdaoVoteDeadlineBuffer = 24 * time.Hour
+)
+
+// Vote on pDAO proposals task
+type votePdaoProps struct {
+	c              *cli.Context
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              *wallet.Wallet
+	rp             *rocketpool.RocketPool
+	mode           cfgtypes.PdaoAutoVoteMode
+	gasThreshold   float64
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+	propMgr        *proposals.ProposalManager
+
+	// The last message logged for each proposal, so unchanged decisions aren't repeated every cycle or after a restart
+	lastMessages  proposals.VotingPolicyLog
+	logPath       string
+	policyMissing bool
+}
+
+// Create vote on pDAO proposals task
+func newVotePdaoProps(c *cli.Context, logger log.ColorLogger) (*votePdaoProps, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Make a proposal manager
+	propMgr, err := proposals.NewProposalManager(&logger, cfg, rp, bc)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proposal manager: %w", err)
+	}
+
+	// Load the messages logged before the last restart
+	logPath := cfg.Smartnode.GetPdaoVotingPolicyLogPath()
+	lastMessages, err := proposals.LoadVotingPolicyLog(logPath)
+	if err != nil {
+		logger.Printlnf("WARNING: %s; messages about proposals that were already handled will be logged again.", err.Error())
+		lastMessages = proposals.VotingPolicyLog{}
+	}
+
+	// Return task
+	return &votePdaoProps{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		mode:           cfg.Smartnode.PdaoAutoVoteMode.Value.(cfgtypes.PdaoAutoVoteMode),
+		gasThreshold:   cfg.Smartnode.AutoTxGasThreshold.Value.(float64),
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+		propMgr:        propMgr,
+		lastMessages:   lastMessages,
+		logPath:        logPath,
+	}, nil
+
+}
+
+// Vote on the pDAO proposals in their voting phases according to the voting policy
+func (t *votePdaoProps) run(state *state.NetworkState) error {
+
+	// Check if the voting policy is disabled
+	if t.mode == cfgtypes.PdaoAutoVoteMode_Disabled {
+		return nil
+	}
+
+	// Watch-only nodes can't sign anything, so they always do a dry run
+	dryRun := t.mode == cfgtypes.PdaoAutoVoteMode_DryRun || t.w.IsWatchOnly()
+
+	// Load the policy; it's reloaded every cycle so changes take effect without a restart
+	policyPath := t.cfg.Smartnode.GetPdaoVotingPolicyPath()
+	policy, err := proposals.LoadVotingPolicy(policyPath)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		if !t.policyMissing {
+			t.log.Printlnf("The Protocol DAO voting policy is enabled but %s doesn't exist, so no proposals will be voted on.", policyPath)
+			t.policyMissing = true
+		}
+		return nil
+	}
+	t.policyMissing = false
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Handle each proposal in a voting phase
+	for _, prop := range state.ProtocolDaoProposalDetails {
+		if prop.State != types.ProtocolDaoProposalState_ActivePhase1 && prop.State != types.ProtocolDaoProposalState_ActivePhase2 {
+			if _, exists := t.lastMessages[prop.ID]; exists {
+				delete(t.lastMessages, prop.ID)
+				t.saveLog()
+			}
+			continue
+		}
+		if err := t.handleProposal(policy, prop, nodeAccount.Address, dryRun); err != nil {
+			t.log.Printlnf("Error handling Protocol DAO proposal %d: %s", prop.ID, err.Error())
+		}
+	}
+
+	return nil
+
+}
+
+// Vote on a single proposal if the policy calls for it
+func (t *votePdaoProps) handleProposal(policy *proposals.VotingPolicy, prop protocol.ProtocolDaoProposalDetails, nodeAddress common.Address, dryRun bool) error {
+
+	// Skip proposals the node has already voted on
+	nodeVote, err := protocol.GetAddressVoteDirection(t.rp, prop.ID, nodeAddress, nil)
+	if err != nil {
+		return fmt.Errorf("error checking the node's vote: %w", err)
+	}
+	if nodeVote != types.VoteDirection_NoVote {
+		return nil
+	}
+
+	// Decode the payload; undecodable proposals are treated as the unknown type
+	payload, err := proposals.DecodeProposalPayload(t.rp, prop.Payload)
+	if err != nil {
+		t.logOnce(prop.ID, "Couldn't decode the payload of proposal %d, treating it as an unknown proposal: %s", prop.ID, err.Error())
+		payload = nil
+	}
+
+	// Get the policy delegate's vote
+	delegateVote := types.VoteDirection_NoVote
+	if delegate, ok := policy.GetDelegate(); ok {
+		delegateVote, err = protocol.GetAddressVoteDirection(t.rp, prop.ID, delegate, nil)
+		if err != nil {
+			return fmt.Errorf("error getting the vote of policy delegate %s: %w", delegate.Hex(), err)
+		}
+	}
+
+	// Evaluate the policy
+	decision := policy.Evaluate(payload, delegateVote)
+	if decision.Vote == types.VoteDirection_NoVote {
+		t.logOnce(prop.ID, "Not voting on proposal %d: %s.", prop.ID, decision.Reason)
+		return nil
+	}
+	voteString := types.VoteDirections[decision.Vote]
+
+	// Phase 1 votes use the voting power delegated to the node; phase 2 votes override the node's delegate
+	override := prop.State == types.ProtocolDaoProposalState_ActivePhase2
+	var votingPower *big.Int
+	var nodeIndex uint64
+	var proof []types.VotingTreeNode
+	deadline := prop.Phase1EndTime
+	if !override {
+		votingPower, nodeIndex, proof, err = t.propMgr.GetArtifactsForVoting(prop.TargetBlock, nodeAddress)
+		if err != nil {
+			return fmt.Errorf("error getting voting artifacts: %w", err)
+		}
+		if votingPower.Sign() == 0 {
+			t.logOnce(prop.ID, "The policy votes '%s' on proposal %d, but no voting power is delegated to the node in phase 1. It will be checked again in phase 2.", voteString, prop.ID)
+			return nil
+		}
+	} else {
+		deadline = prop.Phase2EndTime
+		votingPower, err = network.GetVotingPower(t.rp, nodeAddress, prop.TargetBlock, nil)
+		if err != nil {
+			return fmt.Errorf("error getting the node's voting power: %w", err)
+		}
+		if votingPower.Sign() == 0 {
+			t.logOnce(prop.ID, "The policy votes '%s' on proposal %d, but the node had no voting power when it was created.", voteString, prop.ID)
+			return nil
+		}
+
+		// A node that's its own delegate can't override itself, so it could only have voted in phase 1
+		onchainDelegate, err := network.GetVotingDelegate(t.rp, nodeAddress, prop.TargetBlock, nil)
+		if err != nil {
+			return fmt.Errorf("error getting the node's on-chain delegate: %w", err)
+		}
+		if onchainDelegate == nodeAddress {
+			t.logOnce(prop.ID, "The policy votes '%s' on proposal %d, but the node is its own delegate so it could only vote in phase 1, which has ended.", voteString, prop.ID)
+			return nil
+		}
+
+		// Only override the node's delegate if it voted differently
+		onchainDelegateVote, err := protocol.GetAddressVoteDirection(t.rp, prop.ID, onchainDelegate, nil)
+		if err != nil {
+			return fmt.Errorf("error getting the vote of on-chain delegate %s: %w", onchainDelegate.Hex(), err)
+		}
+		if onchainDelegateVote == decision.Vote {
+			t.logOnce(prop.ID, "The policy votes '%s' on proposal %d, which matches the vote of the node's delegate %s.", voteString, prop.ID, onchainDelegate.Hex())
+			return nil
+		}
+	}
+
+	// Log and alert what would happen in a dry run
+	if dryRun {
+		if t.logOnce(prop.ID, "DRY RUN: would vote '%s' on proposal %d (%s).", voteString, prop.ID, decision.Reason) {
+			alerting.AlertPdaoPolicyVote(t.cfg, prop.ID, voteString, decision.Reason, true, true)
+		}
+		return nil
+	}
+
+	// Only the node account can vote with its voting power
+	if !api.CheckNodeKeyAvailable(t.cfg, t.w, fmt.Sprintf("Voting '%s' on Protocol DAO proposal %d", voteString, prop.ID), &t.log) {
+		return nil
+	}
+
+	// Vote
+	t.log.Printlnf("Voting '%s' on proposal %d (%s)...", voteString, prop.ID, decision.Reason)
+	voted, err := t.vote(prop.ID, decision.Vote, override, votingPower, nodeIndex, proof, deadline)
+	if err != nil {
+		alerting.AlertPdaoPolicyVote(t.cfg, prop.ID, voteString, decision.Reason, false, false)
+		return err
+	}
+	if voted {
+		alerting.AlertPdaoPolicyVote(t.cfg, prop.ID, voteString, decision.Reason, false, true)
+	}
+	return nil
+
+}
+
+// Submit a vote or override on a proposal; returns false if it was postponed because gas is too high
+func (t *votePdaoProps) vote(propID uint64, direction types.VoteDirection, override bool, votingPower *big.Int, nodeIndex uint64, proof []types.VotingTreeNode, deadline time.Time) (bool, error) {
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return false, err
+	}
+
+	// Get the gas limit
+	var gasInfo rocketpool.GasInfo
+	if override {
+		gasInfo, err = protocol.EstimateOverrideVoteGas(t.rp, propID, direction, opts)
+	} else {
+		gasInfo, err = protocol.EstimateVoteOnProposalGas(t.rp, propID, direction, votingPower, nodeIndex, proof, opts)
+	}
+	if err != nil {
+		return false, fmt.Errorf("error estimating the gas required to vote: %w", err)
+	}
+	gas := big.NewInt(int64(gasInfo.SafeGasLimit))
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.rp.Client, t.maxPriorityFee)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// Print the gas info
+	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, 0) {
+		if time.Until(deadline) > pdaoVoteDeadlineBuffer {
+			return false, nil
+		}
+		t.log.Printlnf("NOTICE: The voting phase ends in less than %s, so the vote will bypass the automatic TX gas threshold.", pdaoVoteDeadlineBuffer)
+	}
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = rpgas.ClampPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = gas.Uint64()
+
+	// Vote
+	var hash common.Hash
+	if override {
+		hash, err = protocol.OverrideVote(t.rp, propID, direction, opts)
+	} else {
+		hash, err = protocol.VoteOnProposal(t.rp, propID, direction, votingPower, nodeIndex, proof, opts)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	if err != nil {
+		return false, err
+	}
+
+	// Log & return
+	t.log.Printlnf("Successfully voted on proposal %d.", propID)
+	return true, nil
+
+}
+
+// Log a message about a proposal unless it's the same as the last one; returns true if it was logged
+func (t *votePdaoProps) logOnce(propID uint64, format string, v ...interface{}) bool {
+	message := fmt.Sprintf(format, v...)
+	if t.lastMessages[propID] == message {
+		return false
+	}
+	t.lastMessages[propID] = message
+	t.log.Println(message)
+	t.saveLog()
+	return true
+}
+
+// Save the logged messages so they aren't repeated after a restart
+func (t *votePdaoProps) saveLog() {
+	if err := t.lastMessages.Save(t.logPath); err != nil {
+		t.log.Printlnf("WARNING: %s", err.Error())
+	}
+}
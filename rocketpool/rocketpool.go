@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/rocketpool/api"
+	"github.com/rocket-pool/smartnode/rocketpool/apiserver"
 	"github.com/rocket-pool/smartnode/rocketpool/node"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower"
 	"github.com/rocket-pool/smartnode/shared"
@@ -84,6 +85,10 @@ func main() {
 			Name:  "force-fallbacks",
 			Usage: "Set this to true if you know the primary EC or CC is offline and want to bypass its health checks, and just use the fallback EC and CC instead",
 		},
+		cli.BoolFlag{
+			Name:  "offline-export",
+			Usage: "Build the node account's transactions without signing or sending them, and return them with the API response so they can be signed on an offline machine",
+		},
 		cli.BoolFlag{
 			Name:  "use-protected-api",
 			Usage: "Set this to true to use the Flashbots Protect RPC instead of your local Execution Client. Useful to ensure your transactions aren't front-run.",
@@ -92,6 +97,7 @@ func main() {
 
 	// Register commands
 	api.RegisterCommands(app, "api", []string{"a"})
+	apiserver.RegisterCommands(app, "api-server", []string{"s"})
 	node.RegisterCommands(app, "node", []string{"n"})
 	watchtower.RegisterCommands(app, "watchtower", []string{"w"})
 
@@ -105,7 +111,7 @@ func main() {
 	// Run application
 	if err := app.Run(os.Args); err != nil {
 		if commandName == "api" {
-			apiutils.PrintErrorResponse(err)
+			apiutils.PrintErrorResponse(app, err)
 		} else {
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(1)
@@ -138,8 +138,8 @@ func (t *dissolveTimedOutMinipools) dissolveMinipool(mp minipool.Minipool) error
 	// Log
 	t.log.Printlnf("Dissolving minipool %s...", mp.GetAddress().Hex())
 
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	// Get transactor; anyone can dissolve a timed-out minipool, so this can use the delegated hot key
+	opts, err := t.w.GetDelegateTransactor()
 	if err != nil {
 		return err
 	}
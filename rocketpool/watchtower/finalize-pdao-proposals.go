@@ -108,8 +108,8 @@ func (t *finalizePdaoProposals) finalizeProposal(propID uint64) error {
 	// Log
 	t.log.Printlnf("Finalizing proposal %d...", propID)
 
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	// Get transactor; anyone can finalize a proposal, so this can use the delegated hot key
+	opts, err := t.w.GetDelegateTransactor()
 	if err != nil {
 		return err
 	}
@@ -1127,11 +1127,12 @@ func (t *submitRplPrice) submitArbitrumPrice(priceMessengerAddress string) error
 
 	if index == indexToSubmit {
 
-		// Get the current network recommended max fee
-		suggestedMaxFee, err := rpgas.GetHeadlessMaxFeeWei()
+		// Get the current network recommended base fee; the submission cost only depends on the base fee, not the priority fee
+		suggestion, err := rpgas.GetHeadlessGasPrices(t.ec)
 		if err != nil {
 			return fmt.Errorf("error getting recommended base fee from the network for Arbitrum price submission: %w", err)
 		}
+		suggestedMaxFee := suggestion.RapidWei
 
 		// Constants for Arbitrum
 		bufferMultiplier := big.NewInt(4)
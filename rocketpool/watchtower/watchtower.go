@@ -19,6 +19,8 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
@@ -45,6 +47,7 @@ const (
 	CheckSoloMigrationsColor       = color.FgCyan
 	FinalizeProposalsColor         = color.FgMagenta
 	UpdateColor                    = color.FgHiWhite
+	TxManagerColor                 = color.FgHiBlue
 )
 
 // Register watchtower command
@@ -95,6 +98,12 @@ func run(c *cli.Context) error {
 		fmt.Println("Starting watchtower daemon in Docker Mode.")
 	}
 
+	// Watch-only nodes can't submit anything, so there's nothing for the watchtower to do
+	if w.IsWatchOnly() {
+		fmt.Println("Watch-only mode is enabled; the watchtower will stay idle.")
+		select {}
+	}
+
 	// Check if rolling records are enabled
 	useRollingRecords := cfg.Smartnode.UseRollingRecords.Value.(bool)
 	if useRollingRecords {
@@ -110,6 +119,20 @@ func run(c *cli.Context) error {
 	errorLog := log.NewColorLogger(ErrorColor)
 	updateLog := log.NewColorLogger(UpdateColor)
 
+	// Track the daemon's transactions so stuck ones can be replaced, including any left over from before a restart
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return err
+	}
+	txLog := log.NewColorLogger(TxManagerColor)
+	txManager, err := txmanager.NewTxManager(cfg, w, ec, txmanager.WatchtowerJournalName, &txLog)
+	if err != nil {
+		return err
+	}
+	ec.SetTransactionListener(txManager.Record)
+	api.SetTransactionWaiter(txManager)
+	txManager.Resume()
+
 	// Create the state manager
 	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, &updateLog)
 	if err != nil {
@@ -232,11 +255,16 @@ func run(c *cli.Context) error {
 			time.Sleep(taskCooldown)
 
 			if isOnOdao {
+				// Oracle DAO submissions have to come from the node account, so they can't be made while it's held by an external signer
+				canSubmit := api.CheckNodeKeyAvailable(cfg, w, "Oracle DAO duties", &errorLog)
+
 				// Run the challenge check
-				if err := respondChallenges.run(); err != nil {
-					errorLog.Println(err)
+				if canSubmit {
+					if err := respondChallenges.run(); err != nil {
+						errorLog.Println(err)
+					}
+					time.Sleep(taskCooldown)
 				}
-				time.Sleep(taskCooldown)
 
 				// Update the network state
 				state, err := updateNetworkState(m, &updateLog, latestBlock)
@@ -252,32 +280,34 @@ func run(c *cli.Context) error {
 					isHoustonDeployedMasterFlag = true
 				}
 
-				// Run the network balance submission check
-				if err := submitNetworkBalances.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
-				if !useRollingRecords {
-					// Run the rewards tree submission check
-					if err := submitRewardsTree_Stateless.Run(isOnOdao, state, latestBlock.Slot); err != nil {
+				if canSubmit {
+					// Run the network balance submission check
+					if err := submitNetworkBalances.run(state); err != nil {
 						errorLog.Println(err)
 					}
 					time.Sleep(taskCooldown)
-				} else {
-					// Run the network balance and rewards tree submission check
-					if err := submitRewardsTree_Rolling.run(state); err != nil {
+
+					if !useRollingRecords {
+						// Run the rewards tree submission check
+						if err := submitRewardsTree_Stateless.Run(isOnOdao, state, latestBlock.Slot); err != nil {
+							errorLog.Println(err)
+						}
+						time.Sleep(taskCooldown)
+					} else {
+						// Run the network balance and rewards tree submission check
+						if err := submitRewardsTree_Rolling.run(state); err != nil {
+							errorLog.Println(err)
+						}
+						time.Sleep(taskCooldown)
+					}
+
+					// Run the price submission check
+					if err := submitRplPrice.run(state); err != nil {
 						errorLog.Println(err)
 					}
 					time.Sleep(taskCooldown)
 				}
 
-				// Run the price submission check
-				if err := submitRplPrice.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-
 				// Run the minipool dissolve check
 				if err := dissolveTimedOutMinipools.run(state); err != nil {
 					errorLog.Println(err)
@@ -292,21 +322,23 @@ func run(c *cli.Context) error {
 					time.Sleep(taskCooldown)
 				}
 
-				// Run the minipool scrub check
-				if err := submitScrubMinipools.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
+				if canSubmit {
+					// Run the minipool scrub check
+					if err := submitScrubMinipools.run(state); err != nil {
+						errorLog.Println(err)
+					}
+					time.Sleep(taskCooldown)
 
-				// Run the bond cancel check
-				if err := cancelBondReductions.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
+					// Run the bond cancel check
+					if err := cancelBondReductions.run(state); err != nil {
+						errorLog.Println(err)
+					}
+					time.Sleep(taskCooldown)
 
-				// Run the solo migration check
-				if err := checkSoloMigrations.run(state); err != nil {
-					errorLog.Println(err)
+					// Run the solo migration check
+					if err := checkSoloMigrations.run(state); err != nil {
+						errorLog.Println(err)
+					}
 				}
 				/*time.Sleep(taskCooldown)
 
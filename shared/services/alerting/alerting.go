@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-openapi/strfmt"
+	"github.com/rocket-pool/rocketpool-go/types"
 	apiclient "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client"
 	apialert "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client/alert"
 	"github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/models"
@@ -177,6 +178,286 @@ func AlertMinipoolStaked(cfg *config.RocketPoolConfig, minipoolAddress common.Ad
 	return sendAlert(alert, cfg)
 }
 
+// Sends an alert when one of the node's validators missed an attestation.
+// The alert is keyed on the validator, so repeated misses keep the same alert firing.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertMissedAttestation(cfg *config.RocketPoolConfig, validatorIndex string, pubkey types.ValidatorPubkey, epoch uint64) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertMissedAttestation.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_MissedAttestation.Value != true {
+		logMessage("alert for MissedAttestation is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("MissedAttestation-%s", validatorIndex),
+		fmt.Sprintf("Validator %s missed an attestation", validatorIndex),
+		fmt.Sprintf("The validator with index %s (pubkey %s) missed its attestation in epoch %d.", validatorIndex, pubkey.Hex(), epoch),
+		SeverityWarning,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{
+			"validator": validatorIndex,
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when one of the node's validators missed a scheduled block proposal.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertMissedProposal(cfg *config.RocketPoolConfig, validatorIndex string, pubkey types.ValidatorPubkey, epoch uint64) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertMissedProposal.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_MissedProposal.Value != true {
+		logMessage("alert for MissedProposal is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("MissedProposal-%s-%d", validatorIndex, epoch),
+		fmt.Sprintf("Validator %s missed a block proposal", validatorIndex),
+		fmt.Sprintf("The validator with index %s (pubkey %s) was scheduled to propose a block in epoch %d but the block is missing from the chain.", validatorIndex, pubkey.Hex(), epoch),
+		SeverityCritical,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{
+			"validator": validatorIndex,
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when the balance of one of the node's validators dropped between two epochs.
+// Balances are in gwei.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertValidatorBalanceDecreased(cfg *config.RocketPoolConfig, validatorIndex string, pubkey types.ValidatorPubkey, previousBalance uint64, newBalance uint64, previousEpoch uint64, epoch uint64) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertValidatorBalanceDecreased.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_ValidatorBalanceDecreased.Value != true {
+		logMessage("alert for ValidatorBalanceDecreased is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("ValidatorBalanceDecreased-%s", validatorIndex),
+		fmt.Sprintf("Validator %s balance decreased", validatorIndex),
+		fmt.Sprintf("The balance of the validator with index %s (pubkey %s) dropped by %d gwei, from %d gwei in epoch %d to %d gwei in epoch %d.", validatorIndex, pubkey.Hex(), previousBalance-newBalance, previousBalance, previousEpoch, newBalance, epoch),
+		SeverityWarning,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{
+			"validator": validatorIndex,
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when the node daemon sent a deferred transaction that was queued from the CLI (success or failure).
+// If alerting/metrics are disabled, this function does nothing.
+func AlertDeferredTransactionSent(cfg *config.RocketPoolConfig, id uint64, description string, txHash common.Hash, succeeded bool) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertDeferredTransactionSent.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_DeferredTransactionSent.Value != true {
+		logMessage("alert for DeferredTransactionSent is disabled, not sending.")
+		return nil
+	}
+
+	// prepare the alert information:
+	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
+	alert := createAlert(
+		fmt.Sprintf("DeferredTransactionSent-%s-%d", succeededOrFailedText, id),
+		fmt.Sprintf("Deferred transaction %d %s", id, succeededOrFailedText),
+		fmt.Sprintf("The deferred transaction %d (%s) was sent with hash %s and completed with status %s.", id, description, txHash.Hex(), succeededOrFailedText),
+		severity,
+		endsAt,
+		map[string]string{
+			"deferredTx": fmt.Sprint(id),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when the Protocol DAO voting policy votes on a proposal (success or failure).
+// In dry-run mode, the alert describes the vote that would have been cast.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertPdaoPolicyVote(cfg *config.RocketPoolConfig, proposalID uint64, vote string, reason string, dryRun bool, succeeded bool) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertPdaoPolicyVote.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_PdaoPolicyVote.Value != true {
+		logMessage("alert for PdaoPolicyVote is disabled, not sending.")
+		return nil
+	}
+
+	// prepare the alert information:
+	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
+	summary := fmt.Sprintf("Vote on Protocol DAO proposal %d %s", proposalID, succeededOrFailedText)
+	description := fmt.Sprintf("The voting policy voted '%s' on Protocol DAO proposal %d (%s) and the vote %s.", vote, proposalID, reason, succeededOrFailedText)
+	if dryRun {
+		succeededOrFailedText = "dryRun"
+		summary = fmt.Sprintf("Dry run vote on Protocol DAO proposal %d", proposalID)
+		description = fmt.Sprintf("The voting policy would vote '%s' on Protocol DAO proposal %d (%s). No vote was cast because the policy is in dry-run mode.", vote, proposalID, reason)
+	}
+	alert := createAlert(
+		fmt.Sprintf("PdaoPolicyVote-%s-%d", succeededOrFailedText, proposalID),
+		summary,
+		description,
+		severity,
+		endsAt,
+		map[string]string{
+			"proposal": fmt.Sprint(proposalID),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when a Protocol DAO proposal enters its voting phase.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertPdaoProposalVotingStarted(cfg *config.RocketPoolConfig, proposalID uint64, message string, phase1EndTime time.Time) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertPdaoProposalVotingStarted.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_PdaoProposalVotingStarted.Value != true {
+		logMessage("alert for PdaoProposalVotingStarted is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("PdaoProposalVotingStarted-%d", proposalID),
+		fmt.Sprintf("Protocol DAO proposal %d is open for voting", proposalID),
+		fmt.Sprintf("Protocol DAO proposal %d (%s) has entered its voting phase. Phase 1 ends at %s.", proposalID, message, phase1EndTime.Format(time.RFC1123)),
+		SeverityInfo,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityInfo)),
+		map[string]string{
+			"proposal": fmt.Sprint(proposalID),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when a voting phase of a Protocol DAO proposal is about to end and the node hasn't voted.
+// The alert stays active until the phase ends.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertPdaoProposalVoteDeadline(cfg *config.RocketPoolConfig, proposalID uint64, message string, phase int, endTime time.Time) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertPdaoProposalVoteDeadline.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_PdaoProposalVoteDeadline.Value != true {
+		logMessage("alert for PdaoProposalVoteDeadline is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("PdaoProposalVoteDeadline-%d-%d", proposalID, phase),
+		fmt.Sprintf("Phase %d of Protocol DAO proposal %d is ending soon", phase, proposalID),
+		fmt.Sprintf("Phase %d of Protocol DAO proposal %d (%s) ends at %s and the node hasn't voted on it yet. Use `rocketpool pdao proposals vote` to vote.", phase, proposalID, message, endTime.Format(time.RFC1123)),
+		SeverityWarning,
+		strfmt.DateTime(endTime),
+		map[string]string{
+			"proposal": fmt.Sprint(proposalID),
+			"phase":    fmt.Sprint(phase),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when the node's voting delegate voted differently than the node did on a Protocol DAO proposal.
+// Vote directions are their display names.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertPdaoDelegateVoteDiffers(cfg *config.RocketPoolConfig, proposalID uint64, delegate common.Address, delegateVote string, nodeVote string) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertPdaoDelegateVoteDiffers.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_PdaoDelegateVoteDiffers.Value != true {
+		logMessage("alert for PdaoDelegateVoteDiffers is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("PdaoDelegateVoteDiffers-%d", proposalID),
+		fmt.Sprintf("Delegate voted differently on Protocol DAO proposal %d", proposalID),
+		fmt.Sprintf("The node's voting delegate %s voted '%s' on Protocol DAO proposal %d, but the node overrode it with '%s'.", delegate.Hex(), delegateVote, proposalID, nodeVote),
+		SeverityWarning,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{
+			"proposal": fmt.Sprint(proposalID),
+			"delegate": delegate.Hex(),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when a Protocol DAO proposal submitted by the node has been challenged.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertPdaoProposalChallenged(cfg *config.RocketPoolConfig, proposalID uint64, index uint64, challenger common.Address) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertPdaoProposalChallenged.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_PdaoProposalChallenged.Value != true {
+		logMessage("alert for PdaoProposalChallenged is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("PdaoProposalChallenged-%d-%d", proposalID, index),
+		fmt.Sprintf("Protocol DAO proposal %d was challenged", proposalID),
+		fmt.Sprintf("Index %d of the node's Protocol DAO proposal %d was challenged by %s. If the node daemon can sign transactions it will respond automatically; if nobody responds before the challenge period ends, the proposal can be defeated and the node's proposal bond will be lost.", index, proposalID, challenger.Hex()),
+		SeverityCritical,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{
+			"proposal":   fmt.Sprint(proposalID),
+			"challenger": challenger.Hex(),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when a daemon task needs the node account to sign a transaction, but it's held by an external signer.
+// Tasks send this every interval until the operator does the task manually.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertNodeKeyUnavailable(cfg *config.RocketPoolConfig, task string) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertNodeKeyUnavailable.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_NodeKeyUnavailable.Value != true {
+		logMessage("alert for NodeKeyUnavailable is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("NodeKeyUnavailable-%s", task),
+		fmt.Sprintf("%s needs to be done manually", task),
+		fmt.Sprintf("The node daemon has a transaction to send for the task \"%s\", but only the node account can send it and it's held by an external signer. Please do it manually from the CLI.", task),
+		SeverityWarning,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{
+			"task": task,
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
 // Gets various settings for an alert based on whether a process succeeded or failed.
 func getAlertSettingsForEvent(succeeded bool) (strfmt.DateTime, Severity, string) {
 	endsAt := strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityInfo))
@@ -19,6 +19,7 @@ type SyncStatus struct {
 }
 type Eth2Config struct {
 	GenesisForkVersion           []byte
+	CapellaForkVersion           []byte
 	GenesisValidatorsRoot        []byte
 	GenesisEpoch                 uint64
 	GenesisTime                  uint64
@@ -120,6 +120,7 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 	// Return response
 	return beacon.Eth2Config{
 		GenesisForkVersion:           genesis.Data.GenesisForkVersion,
+		CapellaForkVersion:           eth2Config.Data.CapellaForkVersion,
 		GenesisValidatorsRoot:        genesis.Data.GenesisValidatorsRoot,
 		GenesisEpoch:                 0,
 		GenesisTime:                  uint64(genesis.Data.GenesisTime),
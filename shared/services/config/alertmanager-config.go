@@ -2,10 +2,14 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/mitchellh/go-homedir"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"gopkg.in/yaml.v2"
 
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool/template"
 	"github.com/rocket-pool/smartnode/shared/types/config"
@@ -24,6 +28,7 @@ const AlertingRulesConfigFile string = "alerting/rules/default.yml"
 const defaultAlertmanagerPort uint16 = 9093
 const defaultAlertmanagerHost string = "localhost"
 const defaultAlertmanagerOpenPort config.RPCMode = config.RPC_Closed
+const defaultNtfyServerURL string = "https://ntfy.sh"
 
 // Configuration for Alertmanager
 type AlertmanagerConfig struct {
@@ -53,6 +58,41 @@ type AlertmanagerConfig struct {
 	// The Discord webhook URL for alert notifications
 	DiscordWebhookURL config.Parameter `yaml:"discordWebhookURL,omitempty"`
 
+	// The minimum severity of alerts sent to Discord
+	DiscordMinSeverity config.Parameter `yaml:"discordMinSeverity,omitempty"`
+
+	// The Telegram bot token and chat ID for alert notifications
+	TelegramBotToken    config.Parameter `yaml:"telegramBotToken,omitempty"`
+	TelegramChatID      config.Parameter `yaml:"telegramChatID,omitempty"`
+	TelegramMinSeverity config.Parameter `yaml:"telegramMinSeverity,omitempty"`
+
+	// The Slack webhook URL and channel for alert notifications
+	SlackWebhookURL  config.Parameter `yaml:"slackWebhookURL,omitempty"`
+	SlackChannel     config.Parameter `yaml:"slackChannel,omitempty"`
+	SlackMinSeverity config.Parameter `yaml:"slackMinSeverity,omitempty"`
+
+	// The generic webhook URL for alert notifications
+	WebhookURL         config.Parameter `yaml:"webhookURL,omitempty"`
+	WebhookMinSeverity config.Parameter `yaml:"webhookMinSeverity,omitempty"`
+
+	// The email / SMTP settings for alert notifications
+	EmailTo          config.Parameter `yaml:"emailTo,omitempty"`
+	EmailFrom        config.Parameter `yaml:"emailFrom,omitempty"`
+	EmailSmarthost   config.Parameter `yaml:"emailSmarthost,omitempty"`
+	EmailUsername    config.Parameter `yaml:"emailUsername,omitempty"`
+	EmailPassword    config.Parameter `yaml:"emailPassword,omitempty"`
+	EmailMinSeverity config.Parameter `yaml:"emailMinSeverity,omitempty"`
+
+	// The Pushover settings for alert notifications
+	PushoverUserKey     config.Parameter `yaml:"pushoverUserKey,omitempty"`
+	PushoverToken       config.Parameter `yaml:"pushoverToken,omitempty"`
+	PushoverMinSeverity config.Parameter `yaml:"pushoverMinSeverity,omitempty"`
+
+	// The ntfy settings for alert notifications
+	NtfyServerURL   config.Parameter `yaml:"ntfyServerURL,omitempty"`
+	NtfyTopic       config.Parameter `yaml:"ntfyTopic,omitempty"`
+	NtfyMinSeverity config.Parameter `yaml:"ntfyMinSeverity,omitempty"`
+
 	// Alerts configured in prometheus rule configuration file:
 	AlertEnabled_ClientSyncStatusBeacon    config.Parameter `yaml:"alertEnabled_ClientSyncStatusBeacon,omitempty"`
 	AlertEnabled_ClientSyncStatusExecution config.Parameter `yaml:"alertEnabled_ClientSyncStatusBeacon,omitempty"`
@@ -72,6 +112,17 @@ type AlertmanagerConfig struct {
 	AlertEnabled_MinipoolStaked              config.Parameter `yaml:"alertEnabled_MinipoolStaked,omitempty"`
 	AlertEnabled_ExecutionClientSyncComplete config.Parameter `yaml:"alertEnabled_ExecutionClientSyncComplete,omitempty"`
 	AlertEnabled_BeaconClientSyncComplete    config.Parameter `yaml:"alertEnabled_BeaconClientSyncComplete,omitempty"`
+	AlertEnabled_MissedAttestation           config.Parameter `yaml:"alertEnabled_MissedAttestation,omitempty"`
+	AlertEnabled_MissedProposal              config.Parameter `yaml:"alertEnabled_MissedProposal,omitempty"`
+	AlertEnabled_ValidatorBalanceDecreased   config.Parameter `yaml:"alertEnabled_ValidatorBalanceDecreased,omitempty"`
+	AlertEnabled_DeferredTransactionSent     config.Parameter `yaml:"alertEnabled_DeferredTransactionSent,omitempty"`
+	AlertEnabled_CollateralLow               config.Parameter `yaml:"alertEnabled_CollateralLow,omitempty"`
+	AlertEnabled_PdaoPolicyVote              config.Parameter `yaml:"alertEnabled_PdaoPolicyVote,omitempty"`
+	AlertEnabled_PdaoProposalVotingStarted   config.Parameter `yaml:"alertEnabled_PdaoProposalVotingStarted,omitempty"`
+	AlertEnabled_PdaoProposalVoteDeadline    config.Parameter `yaml:"alertEnabled_PdaoProposalVoteDeadline,omitempty"`
+	AlertEnabled_PdaoDelegateVoteDiffers     config.Parameter `yaml:"alertEnabled_PdaoDelegateVoteDiffers,omitempty"`
+	AlertEnabled_PdaoProposalChallenged      config.Parameter `yaml:"alertEnabled_PdaoProposalChallenged,omitempty"`
+	AlertEnabled_NodeKeyUnavailable          config.Parameter `yaml:"alertEnabled_NodeKeyUnavailable,omitempty"`
 }
 
 func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
@@ -159,6 +210,175 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		DiscordMinSeverity: createParameterForChannelSeverity("discord", "Discord"),
+
+		TelegramBotToken: config.Parameter{
+			ID:                 "telegramBotToken",
+			Name:               "Telegram Bot Token",
+			Description:        "The token of the Telegram bot that will send alert notifications. Create a bot with Telegram's @BotFather to get one. Leave this blank to disable Telegram notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		TelegramChatID: config.Parameter{
+			ID:                 "telegramChatID",
+			Name:               "Telegram Chat ID",
+			Description:        "The ID of the Telegram chat that the bot will send alert notifications to. Group chat IDs are negative numbers.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			Regex:              "^-?[0-9]+$",
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		TelegramMinSeverity: createParameterForChannelSeverity("telegram", "Telegram"),
+
+		SlackWebhookURL: config.Parameter{
+			ID:                 "slackWebhookURL",
+			Name:               "Slack Webhook URL",
+			Description:        "Slack notifications are sent via Slack's incoming webhooks. See https://api.slack.com/messaging/webhooks to learn how to create one for a channel. Leave this blank to disable Slack notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		SlackChannel: config.Parameter{
+			ID:                 "slackChannel",
+			Name:               "Slack Channel",
+			Description:        "The Slack channel to send alert notifications to (e.g. #alerts). Leave this blank to use the webhook's default channel.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		SlackMinSeverity: createParameterForChannelSeverity("slack", "Slack"),
+
+		WebhookURL: config.Parameter{
+			ID:                 "webhookURL",
+			Name:               "Generic Webhook URL",
+			Description:        "A URL that will receive every alert notification as a JSON POST request, in Alertmanager's webhook format. Leave this blank to disable webhook notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		WebhookMinSeverity: createParameterForChannelSeverity("webhook", "Webhook"),
+
+		EmailTo: config.Parameter{
+			ID:                 "emailTo",
+			Name:               "Email Recipient",
+			Description:        "The email address to send alert notifications to. Leave this blank to disable email notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		EmailFrom: config.Parameter{
+			ID:                 "emailFrom",
+			Name:               "Email Sender",
+			Description:        "The email address that alert notifications will be sent from.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		EmailSmarthost: config.Parameter{
+			ID:                 "emailSmarthost",
+			Name:               "SMTP Server",
+			Description:        "The host and port of the SMTP server used to send alert emails, such as `smtp.gmail.com:587`.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		EmailUsername: config.Parameter{
+			ID:                 "emailUsername",
+			Name:               "SMTP Username",
+			Description:        "The username to log into the SMTP server with. Leave this blank if the server doesn't require authentication.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		EmailPassword: config.Parameter{
+			ID:                 "emailPassword",
+			Name:               "SMTP Password",
+			Description:        "The password to log into the SMTP server with.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		EmailMinSeverity: createParameterForChannelSeverity("email", "Email"),
+
+		PushoverUserKey: config.Parameter{
+			ID:                 "pushoverUserKey",
+			Name:               "Pushover User Key",
+			Description:        "The Pushover user key that alert notifications will be sent to. Leave this blank to disable Pushover notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		PushoverToken: config.Parameter{
+			ID:                 "pushoverToken",
+			Name:               "Pushover API Token",
+			Description:        "The API token of the Pushover application that will send alert notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		PushoverMinSeverity: createParameterForChannelSeverity("pushover", "Pushover"),
+
+		NtfyServerURL: config.Parameter{
+			ID:                 "ntfyServerURL",
+			Name:               "ntfy Server URL",
+			Description:        "The URL of the ntfy server to publish alert notifications to. ntfy.sh is the public server; you can also use a self-hosted one.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultNtfyServerURL},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		NtfyTopic: config.Parameter{
+			ID:                 "ntfyTopic",
+			Name:               "ntfy Topic",
+			Description:        "The ntfy topic to publish alert notifications to. Anyone who knows the topic name on a public server can read it, so pick something hard to guess. Leave this blank to disable ntfy notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NtfyMinSeverity: createParameterForChannelSeverity("ntfy", "ntfy"),
+
 		AlertEnabled_ClientSyncStatusBeacon: createParameterForAlertEnablement(
 			"ClientSyncStatusBeacon",
 			"beacon client is not synced"),
@@ -226,6 +446,50 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 		AlertEnabled_BeaconClientSyncComplete: createParameterForAlertEnablement(
 			"BeaconClientSyncComplete",
 			"beacon client is synced"),
+
+		AlertEnabled_MissedAttestation: createParameterForAlertEnablement(
+			"MissedAttestation",
+			"a validator misses an attestation"),
+
+		AlertEnabled_MissedProposal: createParameterForAlertEnablement(
+			"MissedProposal",
+			"a validator misses a block proposal"),
+
+		AlertEnabled_ValidatorBalanceDecreased: createParameterForAlertEnablement(
+			"ValidatorBalanceDecreased",
+			"a validator's balance drops sharply"),
+
+		AlertEnabled_DeferredTransactionSent: createParameterForAlertEnablement(
+			"DeferredTransactionSent",
+			"a deferred transaction is sent"),
+
+		AlertEnabled_CollateralLow: createParameterForAlertEnablement(
+			"CollateralLow",
+			"the node's RPL collateral drops below its target ratio"),
+
+		AlertEnabled_PdaoPolicyVote: createParameterForAlertEnablement(
+			"PdaoPolicyVote",
+			"the Protocol DAO voting policy votes on a proposal"),
+
+		AlertEnabled_PdaoProposalVotingStarted: createParameterForAlertEnablement(
+			"PdaoProposalVotingStarted",
+			"a Protocol DAO proposal enters its voting phase"),
+
+		AlertEnabled_PdaoProposalVoteDeadline: createParameterForAlertEnablement(
+			"PdaoProposalVoteDeadline",
+			"a Protocol DAO voting phase is about to end and the node hasn't voted"),
+
+		AlertEnabled_PdaoDelegateVoteDiffers: createParameterForAlertEnablement(
+			"PdaoDelegateVoteDiffers",
+			"the node's voting delegate votes differently than the node's override"),
+
+		AlertEnabled_PdaoProposalChallenged: createParameterForAlertEnablement(
+			"PdaoProposalChallenged",
+			"a Protocol DAO proposal submitted by the node is challenged"),
+
+		AlertEnabled_NodeKeyUnavailable: createParameterForAlertEnablement(
+			"NodeKeyUnavailable",
+			"a daemon task needs the node account to sign a transaction but it's held by an external signer"),
 	}
 }
 
@@ -243,6 +507,32 @@ func createParameterForAlertEnablement(uniqueName string, label string) config.P
 	}
 }
 
+func createParameterForChannelSeverity(channelID string, channelName string) config.Parameter {
+	return config.Parameter{
+		ID:                 fmt.Sprintf("%sMinSeverity", channelID),
+		Name:               fmt.Sprintf("Alerts Sent to %s", channelName),
+		Description:        fmt.Sprintf("Choose which alerts are sent to the %s notification channel, based on their severity.", channelName),
+		Type:               config.ParameterType_Choice,
+		Default:            map[config.Network]interface{}{config.Network_All: config.AlertSeverity_Info},
+		AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
+		CanBeBlank:         false,
+		OverwriteOnUpgrade: false,
+		Options: []config.ParameterOption{{
+			Name:        "All Alerts",
+			Description: "Send every alert, including informational ones.",
+			Value:       config.AlertSeverity_Info,
+		}, {
+			Name:        "Warning and Critical",
+			Description: "Only send warnings and critical alerts.",
+			Value:       config.AlertSeverity_Warning,
+		}, {
+			Name:        "Critical Only",
+			Description: "Only send critical alerts.",
+			Value:       config.AlertSeverity_Critical,
+		}},
+	}
+}
+
 func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 	return []*config.Parameter{
 		&cfg.EnableAlerting,
@@ -251,6 +541,27 @@ func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 		&cfg.NativeModeHost,
 		&cfg.NativeModePort,
 		&cfg.DiscordWebhookURL,
+		&cfg.DiscordMinSeverity,
+		&cfg.TelegramBotToken,
+		&cfg.TelegramChatID,
+		&cfg.TelegramMinSeverity,
+		&cfg.SlackWebhookURL,
+		&cfg.SlackChannel,
+		&cfg.SlackMinSeverity,
+		&cfg.WebhookURL,
+		&cfg.WebhookMinSeverity,
+		&cfg.EmailTo,
+		&cfg.EmailFrom,
+		&cfg.EmailSmarthost,
+		&cfg.EmailUsername,
+		&cfg.EmailPassword,
+		&cfg.EmailMinSeverity,
+		&cfg.PushoverUserKey,
+		&cfg.PushoverToken,
+		&cfg.PushoverMinSeverity,
+		&cfg.NtfyServerURL,
+		&cfg.NtfyTopic,
+		&cfg.NtfyMinSeverity,
 		&cfg.ContainerTag,
 		&cfg.AlertEnabled_ClientSyncStatusBeacon,
 		&cfg.AlertEnabled_ClientSyncStatusExecution,
@@ -269,6 +580,17 @@ func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 		&cfg.AlertEnabled_MinipoolStaked,
 		&cfg.AlertEnabled_ExecutionClientSyncComplete,
 		&cfg.AlertEnabled_BeaconClientSyncComplete,
+		&cfg.AlertEnabled_MissedAttestation,
+		&cfg.AlertEnabled_MissedProposal,
+		&cfg.AlertEnabled_ValidatorBalanceDecreased,
+		&cfg.AlertEnabled_DeferredTransactionSent,
+		&cfg.AlertEnabled_CollateralLow,
+		&cfg.AlertEnabled_PdaoPolicyVote,
+		&cfg.AlertEnabled_PdaoProposalVotingStarted,
+		&cfg.AlertEnabled_PdaoProposalVoteDeadline,
+		&cfg.AlertEnabled_PdaoDelegateVoteDiffers,
+		&cfg.AlertEnabled_PdaoProposalChallenged,
+		&cfg.AlertEnabled_NodeKeyUnavailable,
 	}
 }
 
@@ -285,17 +607,362 @@ func (cfg *AlertmanagerConfig) GetOpenPorts() string {
 	return fmt.Sprintf("\"%s\"", portMode.DockerPortMapping(cfg.Port.Value.(uint16)))
 }
 
+// A notification receiver in alertmanager.yml
+type alertmanagerReceiver struct {
+	Name             string                   `yaml:"name"`
+	DiscordConfigs   []map[string]interface{} `yaml:"discord_configs,omitempty"`
+	TelegramConfigs  []map[string]interface{} `yaml:"telegram_configs,omitempty"`
+	SlackConfigs     []map[string]interface{} `yaml:"slack_configs,omitempty"`
+	WebhookConfigs   []map[string]interface{} `yaml:"webhook_configs,omitempty"`
+	EmailConfigs     []map[string]interface{} `yaml:"email_configs,omitempty"`
+	PushoverConfigs  []map[string]interface{} `yaml:"pushover_configs,omitempty"`
+	minSeverityParam *config.Parameter
+}
+
+// A child route in alertmanager.yml
+type alertmanagerRoute struct {
+	Receiver string   `yaml:"receiver"`
+	Matchers []string `yaml:"matchers,omitempty"`
+	Continue bool     `yaml:"continue"`
+}
+
+// A group of Prometheus alerting rules in the rules file
+type alertingRuleGroup struct {
+	Name  string         `yaml:"name"`
+	Rules []alertingRule `yaml:"rules"`
+}
+
+// A Prometheus alerting rule
+type alertingRule struct {
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Get the rule groups for the alerts that are built from the node's metrics
+func (cfg *AlertmanagerConfig) getAlertingRuleGroups() []alertingRuleGroup {
+	groups := []alertingRuleGroup{}
+
+	// The node collector reports the RPL needed to restore the target ratio and the RPL price the node's stake needs to stay above the minimum
+	if cfg.AlertEnabled_CollateralLow.Value == true {
+		belowMinimum := "rocketpool_rpl_rpl_price < rocketpool_node_minimum_collateral_rpl_price"
+		groups = append(groups, alertingRuleGroup{
+			Name: "Collateral",
+			Rules: []alertingRule{
+				{
+					Alert: "CollateralLow",
+					Expr:  fmt.Sprintf("rocketpool_node_rpl_needed_for_target_ratio > 0 unless %s", belowMinimum),
+					For:   "15m",
+					Labels: map[string]string{
+						"severity": string(config.AlertSeverity_Warning),
+					},
+					Annotations: map[string]string{
+						"summary":     "Node collateral is below its target ratio",
+						"description": "The RPL staked by the node is worth less than its target collateral ratio. Staking {{ $value | printf \"%.6f\" }} more RPL would restore the target ratio.",
+					},
+				},
+				{
+					Alert: "CollateralLow",
+					Expr:  belowMinimum,
+					For:   "15m",
+					Labels: map[string]string{
+						"severity": string(config.AlertSeverity_Critical),
+					},
+					Annotations: map[string]string{
+						"summary":     "Node collateral is below the minimum",
+						"description": "The RPL price is {{ $value | printf \"%.6f\" }} ETH, which puts the node's staked RPL below the minimum collateral ratio, so the node is not earning RPL rewards. Run `rocketpool node collateral` to see how much RPL to stake.",
+					},
+				},
+			},
+		})
+	}
+
+	return groups
+}
+
+// Get the receivers for every notification channel that has been configured
+func (cfg *AlertmanagerConfig) getReceivers() []alertmanagerReceiver {
+	receivers := []alertmanagerReceiver{}
+
+	if url := cfg.DiscordWebhookURL.Value.(string); url != "" {
+		receivers = append(receivers, alertmanagerReceiver{
+			Name:             "discord",
+			DiscordConfigs:   []map[string]interface{}{{"webhook_url": url}},
+			minSeverityParam: &cfg.DiscordMinSeverity,
+		})
+	}
+
+	botToken := cfg.TelegramBotToken.Value.(string)
+	chatID := cfg.TelegramChatID.Value.(string)
+	if botToken != "" && chatID != "" {
+		// Alertmanager expects the chat ID as a number
+		chatIDNumber, err := strconv.ParseInt(chatID, 10, 64)
+		if err == nil {
+			receivers = append(receivers, alertmanagerReceiver{
+				Name:             "telegram",
+				TelegramConfigs:  []map[string]interface{}{{"bot_token": botToken, "chat_id": chatIDNumber}},
+				minSeverityParam: &cfg.TelegramMinSeverity,
+			})
+		}
+	}
+
+	if url := cfg.SlackWebhookURL.Value.(string); url != "" {
+		slackConfig := map[string]interface{}{"api_url": url}
+		if channel := cfg.SlackChannel.Value.(string); channel != "" {
+			slackConfig["channel"] = channel
+		}
+		receivers = append(receivers, alertmanagerReceiver{
+			Name:             "slack",
+			SlackConfigs:     []map[string]interface{}{slackConfig},
+			minSeverityParam: &cfg.SlackMinSeverity,
+		})
+	}
+
+	if url := cfg.WebhookURL.Value.(string); url != "" {
+		receivers = append(receivers, alertmanagerReceiver{
+			Name:             "webhook",
+			WebhookConfigs:   []map[string]interface{}{{"url": url}},
+			minSeverityParam: &cfg.WebhookMinSeverity,
+		})
+	}
+
+	if to := cfg.EmailTo.Value.(string); to != "" {
+		emailConfig := map[string]interface{}{
+			"to":        to,
+			"from":      cfg.EmailFrom.Value.(string),
+			"smarthost": cfg.EmailSmarthost.Value.(string),
+		}
+		if username := cfg.EmailUsername.Value.(string); username != "" {
+			emailConfig["auth_username"] = username
+			emailConfig["auth_password"] = cfg.EmailPassword.Value.(string)
+		}
+		receivers = append(receivers, alertmanagerReceiver{
+			Name:             "email",
+			EmailConfigs:     []map[string]interface{}{emailConfig},
+			minSeverityParam: &cfg.EmailMinSeverity,
+		})
+	}
+
+	userKey := cfg.PushoverUserKey.Value.(string)
+	token := cfg.PushoverToken.Value.(string)
+	if userKey != "" && token != "" {
+		receivers = append(receivers, alertmanagerReceiver{
+			Name:             "pushover",
+			PushoverConfigs:  []map[string]interface{}{{"user_key": userKey, "token": token}},
+			minSeverityParam: &cfg.PushoverMinSeverity,
+		})
+	}
+
+	// ntfy accepts Alertmanager's webhook payload directly when the alertmanager template is requested
+	if topic := cfg.NtfyTopic.Value.(string); topic != "" {
+		serverURL := strings.TrimSuffix(cfg.NtfyServerURL.Value.(string), "/")
+		receivers = append(receivers, alertmanagerReceiver{
+			Name:             "ntfy",
+			WebhookConfigs:   []map[string]interface{}{{"url": fmt.Sprintf("%s/%s?template=alertmanager", serverURL, topic)}},
+			minSeverityParam: &cfg.NtfyMinSeverity,
+		})
+	}
+
+	return receivers
+}
+
+// Get the severity matcher for a notification channel's minimum severity
+func getSeverityMatchers(minSeverity config.AlertSeverity) []string {
+	switch minSeverity {
+	case config.AlertSeverity_Warning:
+		return []string{fmt.Sprintf("severity=~\"%s|%s\"", config.AlertSeverity_Warning, config.AlertSeverity_Critical)}
+	case config.AlertSeverity_Critical:
+		return []string{fmt.Sprintf("severity=\"%s\"", config.AlertSeverity_Critical)}
+	default:
+		return nil
+	}
+}
+
+// Add the receivers for every configured notification channel to a rendered alertmanager.yml, with child routes that send
+// alerts to each one by severity. Receivers and routes from the template that use the same names are replaced.
+func addAlertmanagerReceivers(configBytes []byte, receivers []alertmanagerReceiver) ([]byte, error) {
+	if len(receivers) == 0 {
+		return configBytes, nil
+	}
+	names := map[string]bool{}
+	for _, receiver := range receivers {
+		names[receiver.Name] = true
+	}
+
+	var alertmanagerConfig yaml.MapSlice
+	err := yaml.Unmarshal(configBytes, &alertmanagerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing alertmanager config: %w", err)
+	}
+
+	// Replace the receivers
+	receiverList := []interface{}{}
+	for _, receiver := range getYamlList(alertmanagerConfig, "receivers") {
+		if !names[getYamlString(receiver, "name")] {
+			receiverList = append(receiverList, receiver)
+		}
+	}
+	for _, receiver := range receivers {
+		receiverList = append(receiverList, receiver)
+	}
+	alertmanagerConfig = setYamlValue(alertmanagerConfig, "receivers", receiverList)
+
+	// Replace the child routes
+	route, _ := getYamlValue(alertmanagerConfig, "route").(yaml.MapSlice)
+	routeList := []interface{}{}
+	for _, childRoute := range getYamlList(route, "routes") {
+		if !names[getYamlString(childRoute, "receiver")] {
+			routeList = append(routeList, childRoute)
+		}
+	}
+	for _, receiver := range receivers {
+		routeList = append(routeList, alertmanagerRoute{
+			Receiver: receiver.Name,
+			Matchers: getSeverityMatchers(receiver.minSeverityParam.Value.(config.AlertSeverity)),
+			Continue: true,
+		})
+	}
+	route = setYamlValue(route, "routes", routeList)
+	alertmanagerConfig = setYamlValue(alertmanagerConfig, "route", route)
+
+	configBytes, err = yaml.Marshal(alertmanagerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing alertmanager config: %w", err)
+	}
+	return configBytes, nil
+}
+
+// Get the value of a key in a YAML mapping, or nil if it isn't there
+func getYamlValue(mapping yaml.MapSlice, key string) interface{} {
+	for _, item := range mapping {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// Get the list under a key in a YAML mapping, or nil if it isn't a list
+func getYamlList(mapping yaml.MapSlice, key string) []interface{} {
+	list, _ := getYamlValue(mapping, key).([]interface{})
+	return list
+}
+
+// Get the string under a key of a value that should be a YAML mapping, or an empty string if it isn't there
+func getYamlString(value interface{}, key string) string {
+	mapping, _ := value.(yaml.MapSlice)
+	str, _ := getYamlValue(mapping, key).(string)
+	return str
+}
+
+// Set the value of a key in a YAML mapping, adding it to the end if it isn't there yet
+func setYamlValue(mapping yaml.MapSlice, key string, value interface{}) yaml.MapSlice {
+	for i, item := range mapping {
+		if item.Key == key {
+			mapping[i].Value = value
+			return mapping
+		}
+	}
+	return append(mapping, yaml.MapItem{Key: key, Value: value})
+}
+
+// Add rule groups to a rendered Prometheus rules file. Rule groups from the template that use the same names are replaced.
+func addAlertingRuleGroups(configBytes []byte, groups []alertingRuleGroup) ([]byte, error) {
+	if len(groups) == 0 {
+		return configBytes, nil
+	}
+	names := map[string]bool{}
+	for _, group := range groups {
+		names[group.Name] = true
+	}
+
+	var rulesConfig yaml.MapSlice
+	err := yaml.Unmarshal(configBytes, &rulesConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing alerting rules: %w", err)
+	}
+
+	groupList := []interface{}{}
+	for _, group := range getYamlList(rulesConfig, "groups") {
+		if !names[getYamlString(group, "name")] {
+			groupList = append(groupList, group)
+		}
+	}
+	for _, group := range groups {
+		groupList = append(groupList, group)
+	}
+	rulesConfig = setYamlValue(rulesConfig, "groups", groupList)
+
+	configBytes, err = yaml.Marshal(rulesConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing alerting rules: %w", err)
+	}
+	return configBytes, nil
+}
+
+// Add the rules for the metric-based alerts to the rendered rules file
+func (cfg *AlertmanagerConfig) addRulesToConfig(configPath string) error {
+	configFile, err := homedir.Expand(fmt.Sprintf("%s/%s", configPath, AlertingRulesConfigFile))
+	if err != nil {
+		return fmt.Errorf("error expanding alerting rules path: %w", err)
+	}
+	configBytes, err := os.ReadFile(configFile)
+	if err != nil {
+		return fmt.Errorf("error reading alerting rules: %w", err)
+	}
+	configBytes, err = addAlertingRuleGroups(configBytes, cfg.getAlertingRuleGroups())
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(configFile, configBytes, 0664)
+	if err != nil {
+		return fmt.Errorf("error writing alerting rules: %w", err)
+	}
+	return nil
+}
+
+// Add the configured notification channels to the rendered alertmanager.yml
+func (cfg *AlertmanagerConfig) addReceiversToConfig(configPath string) error {
+	configFile, err := homedir.Expand(fmt.Sprintf("%s/%s", configPath, AlertmanagerConfigFile))
+	if err != nil {
+		return fmt.Errorf("error expanding alertmanager config path: %w", err)
+	}
+	configBytes, err := os.ReadFile(configFile)
+	if err != nil {
+		return fmt.Errorf("error reading alertmanager config: %w", err)
+	}
+	configBytes, err = addAlertmanagerReceivers(configBytes, cfg.getReceivers())
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(configFile, configBytes, 0664)
+	if err != nil {
+		return fmt.Errorf("error writing alertmanager config: %w", err)
+	}
+	return nil
+}
+
 // Load the alerting configuration templates, do the template variable substitutions, and save them.
 func (cfg *AlertmanagerConfig) UpdateConfigurationFiles(configPath string) error {
 	err := cfg.processTemplate(configPath, AlertmanagerConfigTemplate, AlertmanagerConfigFile, "{{", "}}")
 	if err != nil {
 		return fmt.Errorf("error processing alertmanager config template: %w", err)
 	}
+	err = cfg.addReceiversToConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("error adding notification channels to alertmanager config: %w", err)
+	}
 	// NOTE: we use unique delimiters here because there are nested go templates in the alert messages
 	err = cfg.processTemplate(configPath, AlertingRulesConfigTemplate, AlertingRulesConfigFile, "{{{", "}}}")
 	if err != nil {
 		return fmt.Errorf("error processing alerting rules template: %w", err)
 	}
+	err = cfg.addRulesToConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("error adding metric-based rules to alerting rules: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+const testAlertmanagerConfig = `
+global:
+  resolve_timeout: 5m
+route:
+  receiver: discord
+  group_by: [alertname]
+  routes:
+    - receiver: discord
+      continue: true
+    - receiver: "null"
+      matchers:
+        - alertname="Watchdog"
+receivers:
+  - name: discord
+    discord_configs:
+      - webhook_url: https://old.example
+  - name: "null"
+`
+
+func TestAddAlertmanagerReceivers(t *testing.T) {
+	warning := config.Parameter{Value: config.AlertSeverity_Warning}
+	info := config.Parameter{Value: config.AlertSeverity_Info}
+	receivers := []alertmanagerReceiver{
+		{
+			Name:             "discord",
+			DiscordConfigs:   []map[string]interface{}{{"webhook_url": "https://new.example"}},
+			minSeverityParam: &info,
+		},
+		{
+			Name:             "slack",
+			SlackConfigs:     []map[string]interface{}{{"api_url": "https://slack.example"}},
+			minSeverityParam: &warning,
+		},
+	}
+
+	configBytes, err := addAlertmanagerReceivers([]byte(testAlertmanagerConfig), receivers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result struct {
+		Global map[string]string `yaml:"global"`
+		Route  struct {
+			Receiver string `yaml:"receiver"`
+			Routes   []struct {
+				Receiver string   `yaml:"receiver"`
+				Matchers []string `yaml:"matchers"`
+				Continue bool     `yaml:"continue"`
+			} `yaml:"routes"`
+		} `yaml:"route"`
+		Receivers []struct {
+			Name           string              `yaml:"name"`
+			DiscordConfigs []map[string]string `yaml:"discord_configs"`
+			SlackConfigs   []map[string]string `yaml:"slack_configs"`
+		} `yaml:"receivers"`
+	}
+	if err := yaml.Unmarshal(configBytes, &result); err != nil {
+		t.Fatalf("result isn't valid alertmanager config: %s\n%s", err, configBytes)
+	}
+
+	// Settings from the template are kept
+	if result.Global["resolve_timeout"] != "5m" || result.Route.Receiver != "discord" {
+		t.Errorf("template settings were lost:\n%s", configBytes)
+	}
+
+	// Template receivers with the same name are replaced, others are kept
+	if len(result.Receivers) != 3 || result.Receivers[0].Name != "null" || result.Receivers[1].Name != "discord" || result.Receivers[2].Name != "slack" {
+		t.Fatalf("unexpected receivers:\n%s", configBytes)
+	}
+	if result.Receivers[1].DiscordConfigs[0]["webhook_url"] != "https://new.example" {
+		t.Errorf("discord receiver wasn't replaced:\n%s", configBytes)
+	}
+
+	// Each channel gets one child route with its severity matcher
+	if len(result.Route.Routes) != 3 {
+		t.Fatalf("unexpected routes:\n%s", configBytes)
+	}
+	if result.Route.Routes[0].Receiver != "null" {
+		t.Errorf("template route wasn't kept:\n%s", configBytes)
+	}
+	discordRoute := result.Route.Routes[1]
+	if discordRoute.Receiver != "discord" || len(discordRoute.Matchers) != 0 || !discordRoute.Continue {
+		t.Errorf("unexpected discord route: %+v", discordRoute)
+	}
+	slackRoute := result.Route.Routes[2]
+	if slackRoute.Receiver != "slack" || len(slackRoute.Matchers) != 1 || slackRoute.Matchers[0] != `severity=~"warning|critical"` || !slackRoute.Continue {
+		t.Errorf("unexpected slack route: %+v", slackRoute)
+	}
+
+	// Nothing changes if no channels are configured
+	unchanged, err := addAlertmanagerReceivers([]byte(testAlertmanagerConfig), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(unchanged) != testAlertmanagerConfig {
+		t.Errorf("config was changed without any channels")
+	}
+}
+
+const testAlertingRules = `
+groups:
+  - name: Node
+    rules:
+      - alert: NodeDown
+        expr: up == 0
+  - name: Collateral
+    rules:
+      - alert: OldCollateralRule
+        expr: vector(1)
+`
+
+func TestAddAlertingRuleGroups(t *testing.T) {
+	cfg := NewRocketPoolConfig("", false)
+	groups := cfg.Alertmanager.getAlertingRuleGroups()
+
+	configBytes, err := addAlertingRuleGroups([]byte(testAlertingRules), groups)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result struct {
+		Groups []struct {
+			Name  string `yaml:"name"`
+			Rules []struct {
+				Alert  string            `yaml:"alert"`
+				Expr   string            `yaml:"expr"`
+				Labels map[string]string `yaml:"labels"`
+			} `yaml:"rules"`
+		} `yaml:"groups"`
+	}
+	if err := yaml.Unmarshal(configBytes, &result); err != nil {
+		t.Fatalf("result isn't valid rules config: %s\n%s", err, configBytes)
+	}
+
+	// Template groups with the same name are replaced, others are kept
+	if len(result.Groups) != 2 || result.Groups[0].Name != "Node" || result.Groups[1].Name != "Collateral" {
+		t.Fatalf("unexpected groups:\n%s", configBytes)
+	}
+
+	// The collateral alert is a warning below the target ratio, and critical below the minimum
+	rules := result.Groups[1].Rules
+	if len(rules) != 2 {
+		t.Fatalf("unexpected collateral rules:\n%s", configBytes)
+	}
+	for i, severity := range []config.AlertSeverity{config.AlertSeverity_Warning, config.AlertSeverity_Critical} {
+		if rules[i].Alert != "CollateralLow" || rules[i].Labels["severity"] != string(severity) || rules[i].Expr == "" {
+			t.Errorf("unexpected collateral rule %d: %+v", i, rules[i])
+		}
+	}
+
+	// The rules aren't added when the alert is disabled
+	cfg.Alertmanager.AlertEnabled_CollateralLow.Value = false
+	unchanged, err := addAlertingRuleGroups([]byte(testAlertingRules), cfg.Alertmanager.getAlertingRuleGroups())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(unchanged) != testAlertingRules {
+		t.Errorf("rules were changed with the alert disabled")
+	}
+}
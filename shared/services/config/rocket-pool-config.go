@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/alessio/shellescape"
+	"github.com/ethereum/go-ethereum/common"
 	externalip "github.com/glendc/go-external-ip"
 	"github.com/pbnjay/memory"
 	"github.com/rocket-pool/smartnode/addons"
@@ -75,6 +76,7 @@ type RocketPoolConfig struct {
 	// Metrics settings
 	EnableMetrics           config.Parameter `yaml:"enableMetrics,omitempty"`
 	EnableODaoMetrics       config.Parameter `yaml:"enableODaoMetrics,omitempty"`
+	WatchedNodeAddresses    config.Parameter `yaml:"watchedNodeAddresses,omitempty"`
 	EcMetricsPort           config.Parameter `yaml:"ecMetricsPort,omitempty"`
 	BnMetricsPort           config.Parameter `yaml:"bnMetricsPort,omitempty"`
 	VcMetricsPort           config.Parameter `yaml:"vcMetricsPort,omitempty"`
@@ -162,6 +164,13 @@ func LoadFromFile(path string) (*RocketPoolConfig, error) {
 		return nil, fmt.Errorf("could not read Rocket Pool settings file at %s: %w", shellescape.Quote(path), err)
 	}
 
+	return LoadFromBytes(configBytes, filepath.Dir(path))
+
+}
+
+// Load a configuration from the contents of a settings file that lives in the provided directory
+func LoadFromBytes(configBytes []byte, configPath string) (*RocketPoolConfig, error) {
+
 	// Attempt to parse it out into a settings map
 	var settings map[string]map[string]string
 	if err := yaml.Unmarshal(configBytes, &settings); err != nil {
@@ -169,8 +178,8 @@ func LoadFromFile(path string) (*RocketPoolConfig, error) {
 	}
 
 	// Deserialize it into a config object
-	cfg := NewRocketPoolConfig(filepath.Dir(path), false)
-	err = cfg.Deserialize(settings)
+	cfg := NewRocketPoolConfig(configPath, false)
+	err := cfg.Deserialize(settings)
 	if err != nil {
 		return nil, fmt.Errorf("could not deserialize settings file: %w", err)
 	}
@@ -357,6 +366,17 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		WatchedNodeAddresses: config.Parameter{
+			ID:                 "watchedNodeAddresses",
+			Name:               "Watched Node Addresses",
+			Description:        "A comma-separated list of additional node addresses to export metrics for. These nodes are only watched; this node can't do anything on their behalf.\n\nTheir metrics are named `rocketpool_watched_node_*` and `rocketpool_watched_beacon_*` and labelled by address, so this node's own metrics and dashboards are unchanged. Use this to chart a whole fleet of nodes from one Prometheus without running a metrics exporter for each of them.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
 		EnableBitflyNodeMetrics: config.Parameter{
 			ID:                 "enableBitflyNodeMetrics",
 			Name:               "Enable Beaconcha.in Node Metrics",
@@ -542,6 +562,7 @@ func (cfg *RocketPoolConfig) GetParameters() []*config.Parameter {
 		&cfg.ExternalConsensusClient,
 		&cfg.EnableMetrics,
 		&cfg.EnableODaoMetrics,
+		&cfg.WatchedNodeAddresses,
 		&cfg.EnableBitflyNodeMetrics,
 		&cfg.EcMetricsPort,
 		&cfg.BnMetricsPort,
@@ -648,6 +669,22 @@ func (cfg *RocketPoolConfig) GetEventLogInterval() (int, error) {
 	}
 }
 
+// Get the additional node addresses to include in the node metrics
+func (cfg *RocketPoolConfig) GetWatchedNodeAddresses() ([]common.Address, error) {
+	addresses := []common.Address{}
+	for _, address := range strings.Split(cfg.WatchedNodeAddresses.Value.(string), ",") {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("watched node address [%s] is not a valid address", address)
+		}
+		addresses = append(addresses, common.HexToAddress(address))
+	}
+	return addresses, nil
+}
+
 // Get the selected CC and mode
 func (cfg *RocketPoolConfig) GetSelectedConsensusClient() (config.ConsensusClient, config.Mode) {
 	mode := cfg.ConsensusClientMode.Value.(config.Mode)
@@ -1111,6 +1148,19 @@ func (cfg *RocketPoolConfig) VcAdditionalFlags() (string, error) {
 	return out, nil
 }
 
+// Used by text/template to format validator.yml
+func (cfg *RocketPoolConfig) RemoteSignerEnabled() bool {
+	return cfg.Smartnode.IsRemoteSignerEnabled()
+}
+
+// Used by text/template to format validator.yml
+func (cfg *RocketPoolConfig) Web3SignerUrl() string {
+	if !cfg.Smartnode.IsRemoteSignerEnabled() {
+		return ""
+	}
+	return cfg.Smartnode.Web3SignerUrl.Value.(string)
+}
+
 // Used by text/template to format validator.yml
 func (cfg *RocketPoolConfig) FeeRecipientFile() string {
 	return FeeRecipientFilename
@@ -1464,6 +1514,11 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		errors = append(errors, "The Reth client is currently an alpha release and not to be used on Mainnet")
 	}
 
+	// Ensure the watched node addresses are valid
+	if _, err := cfg.GetWatchedNodeAddresses(); err != nil {
+		errors = append(errors, fmt.Sprintf("Invalid Watched Node Addresses: %s", err.Error()))
+	}
+
 	// Ensure there's a MEV-boost URL
 	if cfg.Smartnode.Network.Value == config.Network_Holesky || cfg.Smartnode.Network.Value == config.Network_Devnet {
 		// Disabled on Holesky
@@ -1487,6 +1542,36 @@ func (cfg *RocketPoolConfig) Validate() []string {
 		}
 	}
 
+	// Ensure there's a remote signer URL, and that the Validator Client can use it
+	if cfg.Smartnode.IsRemoteSignerEnabled() {
+		if cfg.Smartnode.Web3SignerUrl.Value.(string) == "" {
+			errors = append(errors, "You have the Web3Signer validator signer selected but don't have a URL set. Please enter the URL of your Web3Signer instance to use it.")
+		}
+		if cc, _ := cfg.GetSelectedConsensusClient(); cc != config.ConsensusClient_Lighthouse {
+			errors = append(errors, fmt.Sprintf("You have the Web3Signer validator signer selected, but it's only supported with Lighthouse and your consensus client is %s. Please switch to Lighthouse or use the local validator signer.", cc))
+		}
+	}
+
+	// Ensure the external node signer is fully configured
+	if cfg.Smartnode.IsNodeSignerExternal() {
+		if cfg.Smartnode.NodeSignerUrl.Value.(string) == "" {
+			errors = append(errors, "You have an external node account signer selected but don't have a URL set. Please enter the URL of your signer to use it.")
+		}
+		if cfg.Smartnode.NodeSignerAddress.Value.(string) == "" {
+			errors = append(errors, "You have an external node account signer selected but don't have its address set. Please enter the address of your node account to use it.")
+		}
+	}
+
+	// Watch-only mode has no wallet to back an external node signer with
+	if cfg.Smartnode.IsWatchOnly() {
+		if !common.IsHexAddress(cfg.Smartnode.WatchOnlyAddress.Value.(string)) {
+			errors = append(errors, "The watch-only node address is not a valid address.")
+		}
+		if cfg.Smartnode.IsNodeSignerExternal() {
+			errors = append(errors, "You have a watch-only node address set along with an external node account signer. Watch-only mode can't sign anything, so please set the Node Account Signer back to Local or clear the watch-only address.")
+		}
+	}
+
 	// Technically not required since native mode doesn't support addons, but defensively check to make sure a native mode
 	// user hasn't tried to configure the rescue node via the TUI
 	if cfg.RescueNode.GetEnabledParameter().Value.(bool) {
@@ -33,6 +33,10 @@ const (
 	GithubRewardsFileUrl               string = "https://github.com/rocket-pool/rewards-trees/raw/main/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	ApiSocketFilename                  string = "api.sock"
+	PdaoVotingPolicyFilename           string = "pdao-voting-policy.yml"
+	PdaoVotingPolicyLogFilename        string = "pdao-voting-policy-log.json"
+	PdaoProposalAlertLogFilename       string = "pdao-proposal-alert-log.json"
 )
 
 // Defaults
@@ -77,6 +81,42 @@ type SmartnodeConfig struct {
 	// The amount of ETH in a minipool's balance before auto-distribute kicks in
 	DistributeThreshold config.Parameter `yaml:"distributeThreshold,omitempty"`
 
+	// Whether to bundle automatic minipool distributions into a single multicall transaction
+	BatchMinipoolTxs config.Parameter `yaml:"batchMinipoolTxs,omitempty"`
+
+	// The highest max fee the daemons will bump a stuck transaction to
+	TxFeeBumpCeiling config.Parameter `yaml:"txFeeBumpCeiling,omitempty"`
+
+	// How the node daemon should claim and restake new rewards intervals
+	AutoClaimMode config.Parameter `yaml:"autoClaimMode,omitempty"`
+
+	// The percentage of claimed RPL to restake in the restake-percent mode
+	AutoClaimRestakePercent config.Parameter `yaml:"autoClaimRestakePercent,omitempty"`
+
+	// The collateral ratio to restake up to in the target-ratio mode
+	AutoClaimTargetRatio config.Parameter `yaml:"autoClaimTargetRatio,omitempty"`
+
+	// The highest max fee the node daemon will pay for an automatic claim
+	AutoClaimMaxFee config.Parameter `yaml:"autoClaimMaxFee,omitempty"`
+
+	// The collateral ratio the node should stay above before it gets collateral warnings
+	CollateralTargetRatio config.Parameter `yaml:"collateralTargetRatio,omitempty"`
+
+	// The number of slots between saved network state snapshots
+	StateSnapshotInterval config.Parameter `yaml:"stateSnapshotInterval,omitempty"`
+
+	// How many days to keep network state snapshots for
+	StateSnapshotRetentionDays config.Parameter `yaml:"stateSnapshotRetentionDays,omitempty"`
+
+	// The most network state snapshots to keep
+	StateSnapshotMaxCount config.Parameter `yaml:"stateSnapshotMaxCount,omitempty"`
+
+	// Only refresh the parts of the network state that changed since the last update
+	IncrementalStateUpdates config.Parameter `yaml:"incrementalStateUpdates,omitempty"`
+
+	// How the node daemon should apply the Protocol DAO voting policy
+	PdaoAutoVoteMode config.Parameter `yaml:"pdaoAutoVoteMode,omitempty"`
+
 	// Mode for acquiring Merkle rewards trees
 	RewardsTreeMode config.Parameter `yaml:"rewardsTreeMode,omitempty"`
 
@@ -110,6 +150,24 @@ type SmartnodeConfig struct {
 	// The toggle for enabling pDAO proposal verification duties
 	VerifyProposals config.Parameter `yaml:"verifyProposals,omitempty"`
 
+	// Where validator keys are stored and used for signing
+	ValidatorSigner config.Parameter `yaml:"validatorSigner,omitempty"`
+
+	// The URL of the Web3Signer instance that holds the validator keys
+	Web3SignerUrl config.Parameter `yaml:"web3SignerUrl,omitempty"`
+
+	// What signs transactions and messages for the node account
+	NodeSigner config.Parameter `yaml:"nodeSigner,omitempty"`
+
+	// The URL of the external node account signer
+	NodeSignerUrl config.Parameter `yaml:"nodeSignerUrl,omitempty"`
+
+	// The address of the node account held by the external signer
+	NodeSignerAddress config.Parameter `yaml:"nodeSignerAddress,omitempty"`
+
+	// The node address to watch without a node wallet
+	WatchOnlyAddress config.Parameter `yaml:"watchOnlyAddress,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -318,6 +376,164 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		BatchMinipoolTxs: config.Parameter{
+			ID:                 "batchMinipoolTxs",
+			Name:               "Batch Minipool Distributions",
+			Description:        "Check this box to have the Smartnode bundle its automatic minipool distributions into a single transaction through the network's multicall contract, instead of sending one transaction per minipool. This saves the base fee of each separate transaction if you have many minipools.\n\nAnyone can distribute a minipool with less than 8 ETH, which is what lets the multicall contract do it; minipools it can't distribute will still get their own transactions.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		TxFeeBumpCeiling: config.Parameter{
+			ID:                 "txFeeBumpCeiling",
+			Name:               "Transaction Fee Bump Ceiling",
+			Description:        "The Smartnode's daemons keep track of the transactions they send. If one of them is still pending after a few minutes because the network's base fee rose above its max fee, the daemon will replace it with a copy that has a higher max fee and priority fee so it doesn't block your node's later transactions.\n\nThis is the highest max fee (in gwei) the daemons will bump a transaction to. Set this to 0 to disable fee bumping.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(150)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AutoClaimMode: config.Parameter{
+			ID:                 "autoClaimMode",
+			Name:               "Auto-Claim Rewards",
+			Description:        "Select whether the Smartnode should automatically claim your rewards once the Merkle Tree for a new rewards interval is available, and how much of the claimed RPL it should restake.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.AutoClaimMode_Disabled},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Disabled",
+				Description: "Don't claim rewards automatically. You can still claim them manually with `rocketpool node claim-rewards`.",
+				Value:       config.AutoClaimMode_Disabled,
+			}, {
+				Name:        "Restake Percent",
+				Description: "Claim new intervals automatically and restake a fixed percentage of the claimed RPL. The rest of the RPL and all of the ETH will be sent to your withdrawal address.",
+				Value:       config.AutoClaimMode_RestakePercent,
+			}, {
+				Name:        "Target Ratio",
+				Description: "Claim new intervals automatically and restake just enough of the claimed RPL to bring your collateral up to a target ratio of your borrowed ETH. The rest of the RPL and all of the ETH will be sent to your withdrawal address.",
+				Value:       config.AutoClaimMode_TargetRatio,
+			}},
+		},
+
+		AutoClaimRestakePercent: config.Parameter{
+			ID:                 "autoClaimRestakePercent",
+			Name:               "Auto-Claim Restake Percent",
+			Description:        "The percentage (0 to 100) of the claimed RPL to restake when Auto-Claim Rewards is set to `Restake Percent`.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(100)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AutoClaimTargetRatio: config.Parameter{
+			ID:                 "autoClaimTargetRatio",
+			Name:               "Auto-Claim Target Ratio",
+			Description:        "The collateral ratio (as a percentage of your borrowed ETH) to maintain when Auto-Claim Rewards is set to `Target Ratio`. The Smartnode will restake claimed RPL until your staked RPL is worth this much of your borrowed ETH; anything beyond that is sent to your withdrawal address.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(10)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AutoClaimMaxFee: config.Parameter{
+			ID:                 "autoClaimMaxFee",
+			Name:               "Auto-Claim Max Fee",
+			Description:        "The highest max fee (in gwei) the Smartnode will pay for an automatic rewards claim. If the current max fee is above this, the claim will be retried on a later cycle.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(30)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		CollateralTargetRatio: config.Parameter{
+			ID:                 "collateralTargetRatio",
+			Name:               "Collateral Target Ratio",
+			Description:        "The collateral ratio (as a percentage of your borrowed ETH) you want your staked RPL to stay above. The Smartnode will report how much RPL you need to get back to this ratio, and will send a warning alert when your collateral drops below it so you can act before you fall under the minimum and stop earning RPL rewards.\n\nSet this to 0 to disable collateral warnings.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(15)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		StateSnapshotInterval: config.Parameter{
+			ID:                 "stateSnapshotInterval",
+			Name:               "State Snapshot Interval",
+			Description:        "The number of Beacon slots between snapshots of your node's network state, which the node daemon saves to disk so commands like `rocketpool node status --at-slot` can show what your node looked like in the past (300 slots is one hour).\n\nSet this to 0 to disable state snapshots.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		StateSnapshotRetentionDays: config.Parameter{
+			ID:                 "stateSnapshotRetentionDays",
+			Name:               "State Snapshot Retention",
+			Description:        "The number of days to keep state snapshots for. Older snapshots are deleted when a new one is saved.\n\nSet this to 0 to keep them regardless of their age.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(30)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		StateSnapshotMaxCount: config.Parameter{
+			ID:                 "stateSnapshotMaxCount",
+			Name:               "State Snapshot Limit",
+			Description:        "The most state snapshots to keep. The oldest ones are deleted when a new one is saved.\n\nSet this to 0 to keep any number of snapshots.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		IncrementalStateUpdates: config.Parameter{
+			ID:                 "incrementalStateUpdates",
+			Name:               "Incremental State Updates",
+			Description:        "Enable this to have the node daemon update its view of the Rocket Pool network incrementally instead of rebuilding it every cycle. It will watch Rocket Pool's contract events and balances, and only query the nodes and minipools that changed since the last update.\n\nThis greatly reduces the load on your Execution client. A full refresh is still done every few hours as a safety net. The watchtower always does a full refresh.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		PdaoAutoVoteMode: config.Parameter{
+			ID:                 "pdaoAutoVoteMode",
+			Name:               "Protocol DAO Voting Policy",
+			Description:        "Select whether the Smartnode should vote on Protocol DAO proposals automatically, following the rules in the `pdao-voting-policy.yml` file in your Smartnode's data directory. Use `rocketpool pdao voting-policy` to see how the policy would vote on the current proposals.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.PdaoAutoVoteMode_Disabled},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Disabled",
+				Description: "Don't vote automatically. You can still vote manually with `rocketpool pdao proposals vote`.",
+				Value:       config.PdaoAutoVoteMode_Disabled,
+			}, {
+				Name:        "Dry Run",
+				Description: "Evaluate the voting policy against each proposal in its voting phase and log (and alert) how it would vote, without submitting any transactions.",
+				Value:       config.PdaoAutoVoteMode_DryRun,
+			}, {
+				Name:        "Enabled",
+				Description: "Vote on each proposal in its voting phase as the voting policy decides. In phase 2, this overrides your delegate's vote if it doesn't match the policy.",
+				Value:       config.PdaoAutoVoteMode_Enabled,
+			}},
+		},
+
 		VerifyProposals: config.Parameter{
 			ID:                 "verifyProposals",
 			Name:               "Enable PDAO Proposal Checker",
@@ -329,6 +545,96 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		ValidatorSigner: config.Parameter{
+			ID:                 "validatorSigner",
+			Name:               "Validator Signer",
+			Description:        "Select where your minipool validator keys are stored and used for signing.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.ValidatorSigner_Local},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Local",
+				Description: "Store the validator keys in keystore files in your node's data folder and load them into your Validator Client directly.",
+				Value:       config.ValidatorSigner_Local,
+			}, {
+				Name:        "Web3Signer",
+				Description: "Import new validator keys into a remote signer that supports the Web3Signer API instead of saving them to disk. Your Validator Client, voluntary exits and deposit signatures will all use the remote signer, so no validator keystores are kept on this machine.\n\nOnly supported with Lighthouse.\n\n[orange]NOTE: Existing keystores are not migrated automatically. Run `rocketpool wallet rebuild` after switching to import them into your signer. Each key is checked in the signer after it's imported, and only then is Lighthouse's local copy securely deleted, so make sure your signer's keys are backed up.",
+				Value:       config.ValidatorSigner_Web3Signer,
+			}},
+		},
+
+		Web3SignerUrl: config.Parameter{
+			ID:                 "web3SignerUrl",
+			Name:               "Web3Signer URL",
+			Description:        "The URL of your remote signer's HTTP API (for example: `http://192.168.1.50:9000`). Its keymanager API must be enabled so the Smartnode can import new validator keys into it.\n\nOnly used if the Validator Signer is set to Web3Signer.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NodeSigner: config.Parameter{
+			ID:                 "nodeSigner",
+			Name:               "Node Account Signer",
+			Description:        "Select what signs transactions and messages for your node account.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.NodeSigner_Local},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Local",
+				Description: "Use the node wallet's key, derived from your mnemonic, for everything.",
+				Value:       config.NodeSigner_Local,
+			}, {
+				Name:        "Clef",
+				Description: "Sign the node account's manual transactions and messages with a Clef-compatible external signer over its JSON-RPC API.",
+				Value:       config.NodeSigner_Clef,
+			}, {
+				Name:        "EIP-1193 Bridge",
+				Description: "Sign the node account's manual transactions and messages with a hardware wallet (such as a Ledger or Trezor) through a JSON-RPC bridge that supports `eth_signTransaction` and `personal_sign`.",
+				Value:       config.NodeSigner_Eip1193,
+			}},
+		},
+
+		NodeSignerUrl: config.Parameter{
+			ID:                 "nodeSignerUrl",
+			Name:               "Node Signer URL",
+			Description:        "The URL of your external signer's JSON-RPC API (for example: `http://192.168.1.50:8550`).\n\nOnly used if the Node Account Signer is not set to Local.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NodeSignerAddress: config.Parameter{
+			ID:                 "nodeSignerAddress",
+			Name:               "Node Signer Address",
+			Description:        "The address of your node account, as held by the external signer.\n\n[orange]Your node's automated daemon tasks can't wait for the external signer to approve their transactions. Tasks that anyone can do (such as distributing minipools under 8 ETH, defeating invalid pDAO proposals, finalizing proposals and dissolving timed-out minipools) will be sent from a delegated hot key derived from your node wallet, which needs ETH for gas; see `rocketpool wallet status` for its address. Tasks that only the node account can do (such as auto-claiming rewards, staking and promoting minipools, reducing bonds, and pDAO voting and defense) will log an error and send an alert each time they're due, so you can do them manually from the CLI.\n\n[white]Only used if the Node Account Signer is not set to Local.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+			Regex:              "^(0x[0-9a-fA-F]{40})?$",
+		},
+
+		WatchOnlyAddress: config.Parameter{
+			ID:                 "watchOnlyAddress",
+			Name:               "Watch-Only Node Address",
+			Description:        "Run the Smartnode in watch-only mode for this node address. It will monitor the node, export its metrics, send its alerts and answer every read-only command without a node wallet. [orange]Anything that needs to sign a transaction or message will be refused.\n\n[white]Use this for monitoring replicas of a node that should never hold its keys. Leave it blank to use the node wallet as normal.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+			Regex:              "^(0x[0-9a-fA-F]{40})?$",
+		},
+
 		RewardsTreeMode: config.Parameter{
 			ID:                 "rewardsTreeMode",
 			Name:               "Rewards Tree Mode",
@@ -685,7 +991,25 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.PriorityFee,
 		&cfg.AutoTxGasThreshold,
 		&cfg.DistributeThreshold,
+		&cfg.BatchMinipoolTxs,
+		&cfg.TxFeeBumpCeiling,
+		&cfg.AutoClaimMode,
+		&cfg.AutoClaimRestakePercent,
+		&cfg.AutoClaimTargetRatio,
+		&cfg.AutoClaimMaxFee,
+		&cfg.CollateralTargetRatio,
+		&cfg.StateSnapshotInterval,
+		&cfg.StateSnapshotRetentionDays,
+		&cfg.StateSnapshotMaxCount,
+		&cfg.IncrementalStateUpdates,
+		&cfg.PdaoAutoVoteMode,
 		&cfg.VerifyProposals,
+		&cfg.ValidatorSigner,
+		&cfg.Web3SignerUrl,
+		&cfg.NodeSigner,
+		&cfg.NodeSignerUrl,
+		&cfg.NodeSignerAddress,
+		&cfg.WatchOnlyAddress,
 		&cfg.RewardsTreeMode,
 		&cfg.PriceBalanceSubmissionReferenceTimestamp,
 		&cfg.RewardsTreeCustomUrl,
@@ -737,6 +1061,22 @@ func (cfg *SmartnodeConfig) GetValidatorKeychainPath() string {
 	return filepath.Join(DaemonDataPath, "validators")
 }
 
+func (cfg *SmartnodeConfig) IsRemoteSignerEnabled() bool {
+	return cfg.ValidatorSigner.Value.(config.ValidatorSigner) == config.ValidatorSigner_Web3Signer
+}
+
+func (cfg *SmartnodeConfig) IsNodeSignerExternal() bool {
+	return cfg.NodeSigner.Value.(config.NodeSigner) != config.NodeSigner_Local
+}
+
+func (cfg *SmartnodeConfig) IsWatchOnly() bool {
+	return cfg.WatchOnlyAddress.Value.(string) != ""
+}
+
+func (cfg *SmartnodeConfig) GetWatchOnlyAddress() common.Address {
+	return common.HexToAddress(cfg.WatchOnlyAddress.Value.(string))
+}
+
 func (cfg *SmartnodeConfig) GetRecordsPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "records")
@@ -745,6 +1085,54 @@ func (cfg *SmartnodeConfig) GetRecordsPath() string {
 	return filepath.Join(DaemonDataPath, "records")
 }
 
+func (cfg *SmartnodeConfig) GetTxJournalPath(daemonName string) string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "tx-journal", daemonName+".json")
+	}
+
+	return filepath.Join(DaemonDataPath, "tx-journal", daemonName+".json")
+}
+
+func (cfg *SmartnodeConfig) GetDeferredTxPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "tx-journal", "deferred.json")
+	}
+
+	return filepath.Join(DaemonDataPath, "tx-journal", "deferred.json")
+}
+
+func (cfg *SmartnodeConfig) GetStateSnapshotPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "state-snapshots", string(cfg.Network.Value.(config.Network)))
+	}
+
+	return filepath.Join(DaemonDataPath, "state-snapshots", string(cfg.Network.Value.(config.Network)))
+}
+
+func (cfg *SmartnodeConfig) GetPdaoVotingPolicyPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), PdaoVotingPolicyFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, PdaoVotingPolicyFilename)
+}
+
+func (cfg *SmartnodeConfig) GetPdaoVotingPolicyLogPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), PdaoVotingPolicyLogFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, PdaoVotingPolicyLogFilename)
+}
+
+func (cfg *SmartnodeConfig) GetPdaoProposalAlertLogPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), PdaoProposalAlertLogFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, PdaoProposalAlertLogFilename)
+}
+
 func (cfg *SmartnodeConfig) GetVotingPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "voting", string(cfg.Network.Value.(config.Network)))
@@ -878,6 +1266,14 @@ func (cfg *SmartnodeConfig) GetWatchtowerFolder(daemon bool) string {
 	return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder)
 }
 
+func (cfg *SmartnodeConfig) GetApiSocketPath(daemon bool) string {
+	if daemon && !cfg.parent.IsNativeMode {
+		return filepath.Join(DaemonDataPath, ApiSocketFilename)
+	}
+
+	return filepath.Join(cfg.DataPath.Value.(string), ApiSocketFilename)
+}
+
 func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	if !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, "validators", FeeRecipientFilename)
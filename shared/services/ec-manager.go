@@ -29,6 +29,7 @@ type ExecutionClientManager struct {
 	primaryReady    bool
 	fallbackReady   bool
 	ignoreSyncCheck bool
+	txListener      func(*types.Transaction)
 }
 
 // This is a signature for a wrapped ethclient.Client function
@@ -190,6 +191,17 @@ func (p *ExecutionClientManager) SuggestGasTipCap(ctx context.Context) (*big.Int
 	return result.(*big.Int), err
 }
 
+// FeeHistory retrieves the fee market history.
+func (p *ExecutionClientManager) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ethereum.FeeHistory), err
+}
+
 // EstimateGas tries to estimate the gas needed to execute a specific
 // transaction based on the current pending state of the backend blockchain.
 // There is no guarantee that this is the true gas limit requirement as other
@@ -210,6 +222,9 @@ func (p *ExecutionClientManager) SendTransaction(ctx context.Context, tx *types.
 	_, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
 		return nil, client.SendTransaction(ctx, tx)
 	})
+	if err == nil && p.txListener != nil {
+		p.txListener(tx)
+	}
 	return err
 }
 
@@ -328,10 +343,24 @@ func (p *ExecutionClientManager) SyncProgress(ctx context.Context) (*ethereum.Sy
 	return result.(*ethereum.SyncProgress), err
 }
 
+// CallContext performs a raw JSON-RPC call, for methods that ethclient doesn't wrap
+// (such as debug_traceCall). The result is unmarshalled into the provided pointer.
+func (p *ExecutionClientManager) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	_, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+		return nil, client.Client().CallContext(ctx, result, method, args...)
+	})
+	return err
+}
+
 /// ==================
 /// Internal functions
 /// ==================
 
+// Set a function to call with every transaction that's sent successfully through this manager
+func (p *ExecutionClientManager) SetTransactionListener(listener func(*types.Transaction)) {
+	p.txListener = listener
+}
+
 func (p *ExecutionClientManager) CheckStatus(cfg *config.RocketPoolConfig) *api.ClientManagerStatus {
 
 	status := &api.ClientManagerStatus{
@@ -2,10 +2,43 @@ package services
 
 import (
 	"context"
+	"sync"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 )
 
+// An execution client that remembers the last call it estimated the gas of, so that transaction can be simulated before it's sent
+type estimateRecorder struct {
+	rocketpool.ExecutionClient
+	lock     sync.Mutex
+	lastCall *ethereum.CallMsg
+}
+
+func (r *estimateRecorder) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	r.lock.Lock()
+	r.lastCall = &call
+	r.lock.Unlock()
+	return r.ExecutionClient.EstimateGas(ctx, call)
+}
+
+// Take the call of the last transaction whose gas was estimated with a Rocket Pool manager from GetRocketPool.
+// Each estimate can only be taken once, so a command that skipped an estimate never simulates the one before it.
+func TakeEstimatedCall(rp *rocketpool.RocketPool) (ethereum.CallMsg, bool) {
+	recorder, ok := rp.Client.(*estimateRecorder)
+	if !ok {
+		return ethereum.CallMsg{}, false
+	}
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.lastCall == nil {
+		return ethereum.CallMsg{}, false
+	}
+	call := *recorder.lastCall
+	recorder.lastCall = nil
+	return call, true
+}
+
 func GetEthClientLatestBlockTimestamp(ec rocketpool.ExecutionClient) (uint64, error) {
 	// Get latest block
 	header, err := ec.HeaderByNumber(context.Background(), nil)
@@ -0,0 +1,144 @@
+package feehistory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// Config
+const (
+	// The number of recent blocks to base the suggestions on
+	historyBlocks uint64 = 20
+
+	// The number of upcoming blocks to predict the base fee for
+	DefaultPredictionBlocks uint64 = 10
+
+	// The number of consecutive full blocks each tier's max fee can absorb before the transaction is priced out
+	rapidFullBlocks    int = 6
+	fastFullBlocks     int = 3
+	standardFullBlocks int = 1
+
+	// EIP-1559: the base fee changes by at most 1/8 per block
+	maxBaseFeeChange float64 = 0.125
+)
+
+// The percentiles of recent priority fees to report for the slow, standard and fast tiers
+var rewardPercentiles = []float64{10, 50, 90}
+
+// A client that supports eth_feeHistory
+type Client interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// Max fee suggestions, excluding the priority fee.
+// Each tier is the highest base fee it will still pay after the base fee rises for a number of full blocks in a row.
+type GasFeeSuggestion struct {
+	BaseFeeWei  *big.Int `json:"baseFeeWei"`
+	RapidWei    *big.Int `json:"rapidWei"`
+	FastWei     *big.Int `json:"fastWei"`
+	StandardWei *big.Int `json:"standardWei"`
+	SlowWei     *big.Int `json:"slowWei"`
+
+	// The priority fees recent transactions paid to be included
+	SlowPriorityFeeWei     *big.Int `json:"slowPriorityFeeWei"`
+	StandardPriorityFeeWei *big.Int `json:"standardPriorityFeeWei"`
+	FastPriorityFeeWei     *big.Int `json:"fastPriorityFeeWei"`
+
+	// The expected base fee of each of the next blocks, extrapolated from how full recent blocks were
+	PredictedBaseFeesWei []*big.Int `json:"predictedBaseFeesWei"`
+}
+
+// Get gas prices from the execution client's fee history
+func GetGasPrices(client Client, predictionBlocks uint64) (GasFeeSuggestion, error) {
+
+	history, err := client.FeeHistory(context.Background(), historyBlocks, nil, rewardPercentiles)
+	if err != nil {
+		return GasFeeSuggestion{}, fmt.Errorf("error getting fee history: %w", err)
+	}
+	return getSuggestion(history, predictionBlocks)
+
+}
+
+// Build the suggestion from a fee history
+func getSuggestion(history *ethereum.FeeHistory, predictionBlocks uint64) (GasFeeSuggestion, error) {
+
+	// The last base fee in the history is the one for the next block
+	if history == nil || len(history.BaseFee) == 0 {
+		return GasFeeSuggestion{}, fmt.Errorf("the execution client returned an empty fee history")
+	}
+	baseFee := history.BaseFee[len(history.BaseFee)-1]
+	if baseFee == nil {
+		return GasFeeSuggestion{}, fmt.Errorf("the execution client didn't return a base fee; it may not support EIP-1559")
+	}
+
+	suggestion := GasFeeSuggestion{
+		BaseFeeWei:  new(big.Int).Set(baseFee),
+		RapidWei:    scaleWei(baseFee, math.Pow(1+maxBaseFeeChange, float64(rapidFullBlocks))),
+		FastWei:     scaleWei(baseFee, math.Pow(1+maxBaseFeeChange, float64(fastFullBlocks))),
+		StandardWei: scaleWei(baseFee, math.Pow(1+maxBaseFeeChange, float64(standardFullBlocks))),
+	}
+
+	// Extrapolate the base fee from the average block usage
+	var totalUsage float64
+	for _, usage := range history.GasUsedRatio {
+		totalUsage += usage
+	}
+	averageUsage := 0.5
+	if len(history.GasUsedRatio) > 0 {
+		averageUsage = totalUsage / float64(len(history.GasUsedRatio))
+	}
+	change := (averageUsage - 0.5) / 0.5 * maxBaseFeeChange
+	suggestion.PredictedBaseFeesWei = make([]*big.Int, predictionBlocks)
+	for i := range suggestion.PredictedBaseFeesWei {
+		suggestion.PredictedBaseFeesWei[i] = scaleWei(baseFee, math.Pow(1+change, float64(i+1)))
+	}
+
+	// Slow transactions can wait for the base fee to come down, but shouldn't count on it going up
+	suggestion.SlowWei = new(big.Int).Set(baseFee)
+	if predictionBlocks > 0 {
+		predicted := suggestion.PredictedBaseFeesWei[predictionBlocks-1]
+		if predicted.Cmp(suggestion.SlowWei) < 0 {
+			suggestion.SlowWei.Set(predicted)
+		}
+	}
+
+	// Get the median of each priority fee percentile
+	suggestion.SlowPriorityFeeWei = getMedianReward(history.Reward, 0)
+	suggestion.StandardPriorityFeeWei = getMedianReward(history.Reward, 1)
+	suggestion.FastPriorityFeeWei = getMedianReward(history.Reward, 2)
+
+	return suggestion, nil
+
+}
+
+// Get the median of a reward percentile across the blocks in a fee history
+func getMedianReward(rewards [][]*big.Int, percentileIndex int) *big.Int {
+	values := []*big.Int{}
+	for _, blockRewards := range rewards {
+		if percentileIndex < len(blockRewards) && blockRewards[percentileIndex] != nil {
+			values = append(values, blockRewards[percentileIndex])
+		}
+	}
+	if len(values) == 0 {
+		return big.NewInt(0)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) < 0
+	})
+	return new(big.Int).Set(values[len(values)/2])
+}
+
+// Multiply a wei amount by a factor, rounding up
+func scaleWei(wei *big.Int, factor float64) *big.Int {
+	scaled := new(big.Float).Mul(new(big.Float).SetInt(wei), big.NewFloat(factor))
+	result, accuracy := scaled.Int(nil)
+	if accuracy == big.Below {
+		result.Add(result, big.NewInt(1))
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package feehistory
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+func gwei(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e9))
+}
+
+func TestSuggestionFromFullBlocks(t *testing.T) {
+	history := &ethereum.FeeHistory{
+		BaseFee:      []*big.Int{gwei(8), gwei(9), gwei(10)},
+		GasUsedRatio: []float64{1, 1},
+		Reward: [][]*big.Int{
+			{gwei(1), gwei(2), gwei(5)},
+			{gwei(1), gwei(3), gwei(7)},
+		},
+	}
+	suggestion, err := getSuggestion(history, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The next block's base fee is the last one in the history
+	if suggestion.BaseFeeWei.Cmp(gwei(10)) != 0 {
+		t.Errorf("expected a base fee of 10 gwei, got %s", suggestion.BaseFeeWei)
+	}
+
+	// Full blocks raise the base fee by 12.5% each
+	expected := []*big.Int{big.NewInt(11250000000), big.NewInt(12656250000)}
+	for i, fee := range suggestion.PredictedBaseFeesWei {
+		if fee.Cmp(expected[i]) != 0 {
+			t.Errorf("expected a predicted base fee of %s in block %d, got %s", expected[i], i+1, fee)
+		}
+	}
+	if suggestion.StandardWei.Cmp(expected[0]) != 0 {
+		t.Errorf("expected a standard max fee of %s, got %s", expected[0], suggestion.StandardWei)
+	}
+	if suggestion.SlowWei.Cmp(gwei(10)) != 0 {
+		t.Errorf("expected a slow max fee of 10 gwei when the base fee is rising, got %s", suggestion.SlowWei)
+	}
+	if !(suggestion.SlowWei.Cmp(suggestion.StandardWei) < 0 && suggestion.StandardWei.Cmp(suggestion.FastWei) < 0 && suggestion.FastWei.Cmp(suggestion.RapidWei) < 0) {
+		t.Error("expected the tiers to be in increasing order")
+	}
+
+	// Priority fees are the median of each percentile
+	if suggestion.StandardPriorityFeeWei.Cmp(gwei(3)) != 0 || suggestion.FastPriorityFeeWei.Cmp(gwei(7)) != 0 {
+		t.Errorf("unexpected priority fees: standard %s, fast %s", suggestion.StandardPriorityFeeWei, suggestion.FastPriorityFeeWei)
+	}
+}
+
+func TestSuggestionFromEmptyBlocks(t *testing.T) {
+	history := &ethereum.FeeHistory{
+		BaseFee:      []*big.Int{gwei(16), gwei(16)},
+		GasUsedRatio: []float64{0},
+	}
+	suggestion, err := getSuggestion(history, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Empty blocks lower the base fee by 12.5%, which slow transactions can wait for
+	if suggestion.SlowWei.Cmp(gwei(14)) != 0 {
+		t.Errorf("expected a slow max fee of 14 gwei, got %s", suggestion.SlowWei)
+	}
+	if suggestion.FastPriorityFeeWei.Sign() != 0 {
+		t.Errorf("expected no priority fee without rewards, got %s", suggestion.FastPriorityFeeWei)
+	}
+}
@@ -7,8 +7,7 @@ import (
 
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
-	"github.com/rocket-pool/smartnode/shared/services/gas/etherchain"
-	"github.com/rocket-pool/smartnode/shared/services/gas/etherscan"
+	"github.com/rocket-pool/smartnode/shared/services/gas/feehistory"
 	rpsvc "github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
@@ -50,6 +49,15 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 		}
 	}
 
+	// Deferred transactions are priced by the node daemon when it sends them, so only the target matters here
+	if rp.IsDeferred() {
+		headless = true
+		whenGasBelow, _ := rp.GetDeferConditions()
+		if maxFeeGwei == 0 {
+			maxFeeGwei = whenGasBelow
+		}
+	}
+
 	// Use the requested max fee and priority fee if provided
 	if maxFeeGwei != 0 {
 		fmt.Printf("%sUsing the requested max fee of %.2f gwei (including a max priority fee of %.2f gwei).\n", colorYellow, maxFeeGwei, maxPriorityFeeGwei)
@@ -66,30 +74,16 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 		fmt.Printf("Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, colorReset)
 
 	} else {
+		// Get the latest gas prices from the execution client's fee history
+		response, err := rp.GetGasFeeSuggestion()
+		if err != nil {
+			return fmt.Errorf("Error getting gas price suggestions: %w", err)
+		}
 		if headless {
-			maxFeeWei, err := GetHeadlessMaxFeeWei()
-			if err != nil {
-				return err
-			}
-			maxFeeGwei = eth.WeiToGwei(maxFeeWei)
+			maxFeeGwei = math.RoundUp(eth.WeiToGwei(response.Suggestion.RapidWei)+maxPriorityFeeGwei, 0)
 		} else {
-			// Try to get the latest gas prices from Etherchain
-			etherchainData, err := etherchain.GetGasPrices()
-			if err == nil {
-				// Print the Etherchain data and ask for an amount
-				maxFeeGwei = handleEtherchainGasPrices(etherchainData, gasInfo, maxPriorityFeeGwei, gasLimit)
-
-			} else {
-				// Fallback to Etherscan
-				fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", colorYellow, err.Error(), colorReset)
-				etherscanData, err := etherscan.GetGasPrices()
-				if err == nil {
-					// Print the Etherscan data and ask for an amount
-					maxFeeGwei = handleEtherscanGasPrices(etherscanData, gasInfo, maxPriorityFeeGwei, gasLimit)
-				} else {
-					return fmt.Errorf("Error getting gas price suggestions: %w", err)
-				}
-			}
+			// Print the suggestions and ask for an amount
+			maxFeeGwei = handleGasPrices(response.Suggestion, gasInfo, maxPriorityFeeGwei, gasLimit)
 		}
 		fmt.Printf("%sUsing a max fee of %.2f gwei and a priority fee of %.2f gwei.\n%s", colorBlue, maxFeeGwei, maxPriorityFeeGwei, colorReset)
 	}
@@ -99,7 +93,8 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 		fmt.Printf("Using the requested gas limit of %d units.\n%sNOTE: if you set this too low, your transaction may fail but you will still have to pay the gas fee!%s\n", gasLimit, colorYellow, colorReset)
 	}
 
-	if maxPriorityFeeGwei > maxFeeGwei {
+	// Deferred transactions get their priority fee clamped to the max fee when they're sent
+	if maxPriorityFeeGwei > maxFeeGwei && !rp.IsDeferred() {
 		return fmt.Errorf("Priority fee cannot be greater than max fee.")
 	}
 
@@ -123,89 +118,59 @@ func AssignMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless
 
 }
 
-// Get the suggested max fee for service operations
-func GetHeadlessMaxFeeWei() (*big.Int, error) {
-	etherchainData, err := etherchain.GetGasPrices()
-	if err == nil {
-		return etherchainData.RapidWei, nil
+// Get the suggested max fee for service operations, which covers the priority fee on top of the base fee
+func GetHeadlessMaxFeeWei(ec rocketpool.ExecutionClient, priorityFee *big.Int) (*big.Int, error) {
+	suggestion, err := GetHeadlessGasPrices(ec)
+	if err != nil {
+		return nil, err
 	}
+	return big.NewInt(0).Add(suggestion.RapidWei, priorityFee), nil
+}
 
-	fmt.Printf("%sWarning: couldn't get gas estimates from Etherchain - %s\nFalling back to Etherscan%s\n", colorYellow, err.Error(), colorReset)
-	etherscanData, err := etherscan.GetGasPrices()
-	if err == nil {
-		return eth.GweiToWei(etherscanData.FastGwei), nil
+// Get the priority fee to send with a max fee, since a transaction can't offer a higher priority fee than its max fee
+func ClampPriorityFee(priorityFee *big.Int, maxFee *big.Int) *big.Int {
+	if priorityFee.Cmp(maxFee) > 0 {
+		return maxFee
 	}
+	return priorityFee
+}
 
-	return nil, fmt.Errorf("Error getting gas price suggestions: %w", err)
+// Get the gas price suggestions for service operations, including the current base fee
+func GetHeadlessGasPrices(ec rocketpool.ExecutionClient) (feehistory.GasFeeSuggestion, error) {
+	client, ok := ec.(feehistory.Client)
+	if !ok {
+		return feehistory.GasFeeSuggestion{}, fmt.Errorf("Error getting gas price suggestions: the execution client doesn't support eth_feeHistory")
+	}
+	suggestion, err := feehistory.GetGasPrices(client, feehistory.DefaultPredictionBlocks)
+	if err != nil {
+		return feehistory.GasFeeSuggestion{}, fmt.Errorf("Error getting gas price suggestions: %w", err)
+	}
+	return suggestion, nil
 }
 
-func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
+func handleGasPrices(gasSuggestion feehistory.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
 
 	rapidGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.RapidWei)+priorityFee, 0)
-	rapidEth := eth.WeiToEth(gasSuggestion.RapidWei)
-
-	var rapidLowLimit float64
-	var rapidHighLimit float64
-	if gasLimit == 0 {
-		rapidLowLimit = rapidEth * float64(gasInfo.EstGasLimit)
-		rapidHighLimit = rapidEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		rapidLowLimit = rapidEth * float64(gasLimit)
-		rapidHighLimit = rapidLowLimit
-	}
-
 	fastGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.FastWei)+priorityFee, 0)
-	fastEth := eth.WeiToEth(gasSuggestion.FastWei)
-
-	var fastLowLimit float64
-	var fastHighLimit float64
-	if gasLimit == 0 {
-		fastLowLimit = fastEth * float64(gasInfo.EstGasLimit)
-		fastHighLimit = fastEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		fastLowLimit = fastEth * float64(gasLimit)
-		fastHighLimit = fastLowLimit
-	}
-
 	standardGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.StandardWei)+priorityFee, 0)
-	standardEth := eth.WeiToEth(gasSuggestion.StandardWei)
-
-	var standardLowLimit float64
-	var standardHighLimit float64
-	if gasLimit == 0 {
-		standardLowLimit = standardEth * float64(gasInfo.EstGasLimit)
-		standardHighLimit = standardEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		standardLowLimit = standardEth * float64(gasLimit)
-		standardHighLimit = standardLowLimit
-	}
-
 	slowGwei := math.RoundUp(eth.WeiToGwei(gasSuggestion.SlowWei)+priorityFee, 0)
-	slowEth := eth.WeiToEth(gasSuggestion.SlowWei)
-
-	var slowLowLimit float64
-	var slowHighLimit float64
-	if gasLimit == 0 {
-		slowLowLimit = slowEth * float64(gasInfo.EstGasLimit)
-		slowHighLimit = slowEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		slowLowLimit = slowEth * float64(gasLimit)
-		slowHighLimit = slowLowLimit
-	}
 
-	fmt.Printf("%s+============== Suggested Gas Prices ==============+\n", colorBlue)
-	fmt.Println("| Avg Wait Time |  Max Fee  |    Total Gas Cost    |")
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.RapidTime, fmt.Sprintf("%d gwei", int(rapidGwei)), rapidLowLimit, rapidHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.FastTime, fmt.Sprintf("%d gwei", int(fastGwei)), fastLowLimit, fastHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.StandardTime, fmt.Sprintf("%d gwei", int(standardGwei)), standardLowLimit, standardHighLimit)
-	fmt.Printf("| %-13s | %-9s | %.4f to %.4f ETH |\n",
-		gasSuggestion.SlowTime, fmt.Sprintf("%d gwei", int(slowGwei)), slowLowLimit, slowHighLimit)
-	fmt.Printf("+==================================================+\n\n%s", colorReset)
+	fmt.Printf("%s+============ Suggested Gas Prices ============+\n", colorBlue)
+	fmt.Println("|   Speed   |  Max Fee  |    Total Gas Cost    |")
+	printGasPriceRow("Rapid", rapidGwei, gasInfo, gasLimit)
+	printGasPriceRow("Fast", fastGwei, gasInfo, gasLimit)
+	printGasPriceRow("Standard", standardGwei, gasInfo, gasLimit)
+	printGasPriceRow("Slow", slowGwei, gasInfo, gasLimit)
+	fmt.Printf("+==============================================+\n\n%s", colorReset)
 
 	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
+	fmt.Printf("The current base fee is %.2f gwei", eth.WeiToGwei(gasSuggestion.BaseFeeWei))
+	if len(gasSuggestion.PredictedBaseFeesWei) > 0 {
+		fmt.Printf(", and is predicted to be %.2f gwei in %d blocks", eth.WeiToGwei(gasSuggestion.PredictedBaseFeesWei[len(gasSuggestion.PredictedBaseFeesWei)-1]), len(gasSuggestion.PredictedBaseFeesWei))
+	}
+	fmt.Println(".")
+	fmt.Printf("Recent blocks included transactions with priority fees of %.2f (slow), %.2f (standard) and %.2f (fast) gwei.\n",
+		eth.WeiToGwei(gasSuggestion.SlowPriorityFeeWei), eth.WeiToGwei(gasSuggestion.StandardPriorityFeeWei), eth.WeiToGwei(gasSuggestion.FastPriorityFeeWei))
 
 	for {
 		desiredPrice := cliutils.Prompt(
@@ -232,80 +197,19 @@ func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInf
 
 }
 
-func handleEtherscanGasPrices(gasSuggestion etherscan.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
-
-	fastGwei := math.RoundUp(gasSuggestion.FastGwei+priorityFee, 0)
-	fastEth := gasSuggestion.FastGwei / eth.WeiPerGwei
-
-	var fastLowLimit float64
-	var fastHighLimit float64
-	if gasLimit == 0 {
-		fastLowLimit = fastEth * float64(gasInfo.EstGasLimit)
-		fastHighLimit = fastEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		fastLowLimit = fastEth * float64(gasLimit)
-		fastHighLimit = fastLowLimit
-	}
-
-	standardGwei := math.RoundUp(gasSuggestion.StandardGwei+priorityFee, 0)
-	standardEth := gasSuggestion.StandardGwei / eth.WeiPerGwei
-
-	var standardLowLimit float64
-	var standardHighLimit float64
-	if gasLimit == 0 {
-		standardLowLimit = standardEth * float64(gasInfo.EstGasLimit)
-		standardHighLimit = standardEth * float64(gasInfo.SafeGasLimit)
-	} else {
-		standardLowLimit = standardEth * float64(gasLimit)
-		standardHighLimit = standardLowLimit
-	}
-
-	slowGwei := math.RoundUp(gasSuggestion.SlowGwei+priorityFee, 0)
-	slowEth := gasSuggestion.SlowGwei / eth.WeiPerGwei
+// Print a row of the suggested gas prices table
+func printGasPriceRow(speed string, maxFeeGwei float64, gasInfo rocketpool.GasInfo, gasLimit uint64) {
+	maxFeeEth := maxFeeGwei / eth.WeiPerGwei
 
-	var slowLowLimit float64
-	var slowHighLimit float64
+	var lowLimit float64
+	var highLimit float64
 	if gasLimit == 0 {
-		slowLowLimit = slowEth * float64(gasInfo.EstGasLimit)
-		slowHighLimit = slowEth * float64(gasInfo.SafeGasLimit)
+		lowLimit = maxFeeEth * float64(gasInfo.EstGasLimit)
+		highLimit = maxFeeEth * float64(gasInfo.SafeGasLimit)
 	} else {
-		slowLowLimit = slowEth * float64(gasLimit)
-		slowHighLimit = slowLowLimit
-	}
-
-	fmt.Printf("%s+============ Suggested Gas Prices ============+\n", colorBlue)
-	fmt.Println("|   Speed   |  Max Fee  |    Total Gas Cost    |")
-	fmt.Printf("| Fast      | %-9s | %.4f to %.4f ETH |\n",
-		fmt.Sprintf("%d gwei", int(fastGwei)), fastLowLimit, fastHighLimit)
-	fmt.Printf("| Standard  | %-9s | %.4f to %.4f ETH |\n",
-		fmt.Sprintf("%d gwei", int(standardGwei)), standardLowLimit, standardHighLimit)
-	fmt.Printf("| Slow      | %-9s | %.4f to %.4f ETH |\n",
-		fmt.Sprintf("%d gwei", int(slowGwei)), slowLowLimit, slowHighLimit)
-	fmt.Printf("+==============================================+\n\n%s", colorReset)
-
-	fmt.Printf("These prices include a maximum priority fee of %.2f gwei.\n", priorityFee)
-
-	for {
-		desiredPrice := cliutils.Prompt(
-			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fastGwei)),
-			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
-			"Not a valid gas price, try again:")
-
-		if desiredPrice == "" {
-			return fastGwei
-		}
-
-		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
-		if err != nil {
-			fmt.Printf("Not a valid gas price (%s), try again.\n", err.Error())
-			continue
-		}
-		if desiredPriceFloat <= 0 {
-			fmt.Println("Max fee must be greater than zero.")
-			continue
-		}
-
-		return desiredPriceFloat
+		lowLimit = maxFeeEth * float64(gasLimit)
+		highLimit = lowLimit
 	}
 
+	fmt.Printf("| %-9s | %-9s | %.4f to %.4f ETH |\n", speed, fmt.Sprintf("%d gwei", int(maxFeeGwei)), lowLimit, highLimit)
 }
@@ -0,0 +1,64 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+// A client whose fee history has a constant base fee in empty blocks
+type testFeeHistoryClient struct {
+	rocketpool.ExecutionClient
+	baseFee *big.Int
+}
+
+func (c testFeeHistoryClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	history := &ethereum.FeeHistory{}
+	for i := uint64(0); i < blockCount; i++ {
+		history.BaseFee = append(history.BaseFee, c.baseFee)
+		history.GasUsedRatio = append(history.GasUsedRatio, 0)
+	}
+	history.BaseFee = append(history.BaseFee, c.baseFee)
+	return history, nil
+}
+
+func TestHeadlessMaxFeeWithSubGweiBaseFee(t *testing.T) {
+	client := testFeeHistoryClient{baseFee: eth.GweiToWei(0.3)}
+	priorityFee := eth.GweiToWei(2)
+
+	// The max fee has to cover the priority fee as well as the base fee, or the priority fee can't be paid
+	maxFee, err := GetHeadlessMaxFeeWei(client, priorityFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minimum := big.NewInt(0).Add(client.baseFee, priorityFee)
+	if maxFee.Cmp(minimum) < 0 {
+		t.Errorf("expected a max fee of at least %s wei, got %s", minimum, maxFee)
+	}
+	if tip := ClampPriorityFee(priorityFee, maxFee); tip.Cmp(priorityFee) != 0 {
+		t.Errorf("expected the full priority fee of %s wei, got %s", priorityFee, tip)
+	}
+}
+
+func TestClampPriorityFee(t *testing.T) {
+	tests := []struct {
+		name        string
+		priorityFee float64
+		maxFee      float64
+		expected    float64
+	}{
+		{"below the max fee", 2, 10, 2},
+		{"equal to the max fee", 2, 2, 2},
+		{"above a low manual max fee", 2, 0.5, 0.5},
+	}
+	for _, test := range tests {
+		tip := ClampPriorityFee(eth.GweiToWei(test.priorityFee), eth.GweiToWei(test.maxFee))
+		if tip.Cmp(eth.GweiToWei(test.expected)) != 0 {
+			t.Errorf("%s: expected %.2f gwei, got %.2f gwei", test.name, test.expected, eth.WeiToGwei(tip))
+		}
+	}
+}
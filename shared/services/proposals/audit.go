@@ -0,0 +1,98 @@
+package proposals
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/dao/protocol"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// The result of checking a root submitted for a proposal (or a challenge response) against the locally generated voting trees
+type RootSubmissionAudit struct {
+	Index            uint64                 `json:"index"`
+	Submitter        common.Address         `json:"submitter"`
+	Timestamp        time.Time              `json:"timestamp"`
+	SubmittedRoot    types.VotingTreeNode   `json:"submittedRoot"`
+	SubmittedPollard []types.VotingTreeNode `json:"submittedPollard"`
+	LocalRoot        *types.VotingTreeNode  `json:"localRoot,omitempty"`
+	LocalPollard     []types.VotingTreeNode `json:"localPollard,omitempty"`
+	Matches          bool                   `json:"matches"`
+	Error            string                 `json:"error,omitempty"`
+
+	// The first node of the submitted pollard that doesn't match the local tree, and a Merkle proof that it belongs to the submitted root
+	MismatchIndex uint64                 `json:"mismatchIndex,omitempty"`
+	SubmittedNode *types.VotingTreeNode  `json:"submittedNode,omitempty"`
+	LocalNode     *types.VotingTreeNode  `json:"localNode,omitempty"`
+	Proof         []types.VotingTreeNode `json:"proof,omitempty"`
+}
+
+// Compares a RootSubmitted event with the local trees, without creating any challenges
+func (m *ProposalManager) AuditRootSubmission(event protocol.RootSubmitted) *RootSubmissionAudit {
+	index := event.Index.Uint64()
+	audit := &RootSubmissionAudit{
+		Index:            index,
+		Submitter:        event.Proposer,
+		Timestamp:        event.Timestamp,
+		SubmittedRoot:    event.Root,
+		SubmittedPollard: event.TreeNodes,
+	}
+
+	// Get the proper tree
+	tree, err := m.getTreeForIndex(event.BlockNumber, index)
+	if err != nil {
+		audit.Error = fmt.Sprintf("error getting local tree: %s", err.Error())
+		return audit
+	}
+
+	// Get the local root and pollard
+	localRoot, localPollard := tree.GetArtifactsForChallengeResponse(index)
+	audit.LocalRoot = localRoot
+	audit.LocalPollard = make([]types.VotingTreeNode, len(localPollard))
+	for i := range localPollard {
+		audit.LocalPollard[i] = *localPollard[i]
+	}
+
+	// Compare the pollards
+	mismatchIndex, submittedNode, proofPtrs, err := tree.CheckForChallengeableArtifacts(index, event.TreeNodes)
+	if err != nil {
+		audit.Error = err.Error()
+		return audit
+	}
+	if mismatchIndex == 0 {
+		audit.Matches = localRoot.Hash == event.Root.Hash && localRoot.Sum.Cmp(event.Root.Sum) == 0
+		return audit
+	}
+
+	// Get the artifacts for the mismatched node
+	localNode, _ := tree.getArtifactsForChallenge(mismatchIndex)
+	audit.MismatchIndex = mismatchIndex
+	audit.SubmittedNode = submittedNode
+	audit.LocalNode = localNode
+	audit.Proof = make([]types.VotingTreeNode, len(proofPtrs))
+	for i := range proofPtrs {
+		audit.Proof[i] = *proofPtrs[i]
+	}
+	return audit
+}
+
+// Checks that a Merkle summation proof for the tree node at an index leads to the root node at rootIndex
+func VerifyMerkleProof(node types.VotingTreeNode, index uint64, proof []types.VotingTreeNode, root types.VotingTreeNode, rootIndex uint64) bool {
+	current := &node
+	for _, partner := range proof {
+		if index <= rootIndex {
+			return false
+		}
+		partner := partner
+		if index%2 == 0 {
+			// The current node is on the left
+			current = getParentNodeFromChildren(current, &partner)
+		} else {
+			// The current node is on the right
+			current = getParentNodeFromChildren(&partner, current)
+		}
+		index /= 2
+	}
+	return index == rootIndex && current.Hash == root.Hash && current.Sum.Cmp(root.Sum) == 0
+}
@@ -0,0 +1,52 @@
+package proposals
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func createTestLeaves(balances ...int64) []*types.VotingTreeNode {
+	leaves := make([]*types.VotingTreeNode, len(balances))
+	for i, balance := range balances {
+		sum := big.NewInt(balance)
+		leaves[i] = &types.VotingTreeNode{
+			Sum:  sum,
+			Hash: getHashForBalance(sum),
+		}
+	}
+	return leaves
+}
+
+func TestVerifyMerkleProof(t *testing.T) {
+	// A subtree rooted at virtual index 17, like a pollard submitted in response to a challenge
+	tree := CreateTreeFromLeaves(1, cfgtypes.Network_Devnet, createTestLeaves(1, 2, 3, 4, 5), 17, 5)
+	root := *tree.Nodes[0]
+
+	for _, virtualIndex := range []uint64{34, 35, 69, 138, 140} {
+		node, proofPtrs := tree.getArtifactsForChallenge(virtualIndex)
+		proof := make([]types.VotingTreeNode, len(proofPtrs))
+		for i := range proofPtrs {
+			proof[i] = *proofPtrs[i]
+		}
+		if !VerifyMerkleProof(*node, virtualIndex, proof, root, 17) {
+			t.Errorf("proof for index %d did not verify", virtualIndex)
+		}
+
+		// Tampering with the node has to break the proof
+		tampered := types.VotingTreeNode{
+			Sum:  big.NewInt(0).Add(node.Sum, big.NewInt(1)),
+			Hash: node.Hash,
+		}
+		if VerifyMerkleProof(tampered, virtualIndex, proof, root, 17) {
+			t.Errorf("tampered proof for index %d verified", virtualIndex)
+		}
+
+		// So does claiming the node is at a different index
+		if VerifyMerkleProof(*node, virtualIndex^1, proof, root, 17) {
+			t.Errorf("proof for index %d verified at index %d", virtualIndex, virtualIndex^1)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package proposals
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// Read the current on-chain values of the settings and rewards percentages a proposal changes.
+// Anyone can propose a setting that doesn't exist, so a failed lookup doesn't stop the others from loading;
+// every failure is returned together once all of them have been tried.
+func (p *ProposalPayload) LoadCurrentValues(rp *rocketpool.RocketPool, opts *bind.CallOpts) error {
+	errs := []error{}
+	for i := range p.Settings {
+		setting := &p.Settings[i]
+		value, err := getCurrentSettingValue(rp, setting, opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting current value of setting %s.%s: %w", setting.ContractName, setting.Path, err))
+			continue
+		}
+		setting.CurrentValue = value
+	}
+
+	if p.Type == ProposalPayloadType_RewardsPercentages {
+		percentages, err := protocol.GetRewardsPercentages(rp, opts)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error getting current rewards percentages: %w", err))
+		} else {
+			p.CurrentRewardsPercentages = []*big.Int{percentages.OdaoPercentage, percentages.PdaoPercentage, percentages.NodePercentage}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Get the current value of a setting from its settings contract
+func getCurrentSettingValue(rp *rocketpool.RocketPool, setting *ProposalSettingChange, opts *bind.CallOpts) (string, error) {
+	contract, err := rp.GetContract(setting.ContractName, opts)
+	if err != nil {
+		return "", fmt.Errorf("error getting settings contract: %w", err)
+	}
+	switch setting.Type {
+	case types.ProposalSettingType_Uint256:
+		value := new(*big.Int)
+		if err := contract.Call(opts, value, "getSettingUint", setting.Path); err != nil {
+			return "", err
+		}
+		return (*value).String(), nil
+	case types.ProposalSettingType_Bool:
+		value := new(bool)
+		if err := contract.Call(opts, value, "getSettingBool", setting.Path); err != nil {
+			return "", err
+		}
+		return fmt.Sprint(*value), nil
+	case types.ProposalSettingType_Address:
+		value := new(common.Address)
+		if err := contract.Call(opts, value, "getSettingAddress", setting.Path); err != nil {
+			return "", err
+		}
+		return value.Hex(), nil
+	default:
+		return "", fmt.Errorf("unknown setting type %d", setting.Type)
+	}
+}
@@ -0,0 +1,202 @@
+package proposals
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// The kind of change a Protocol DAO proposal makes
+type ProposalPayloadType string
+
+const (
+	ProposalPayloadType_Unknown            ProposalPayloadType = "unknown"
+	ProposalPayloadType_Settings           ProposalPayloadType = "settings"
+	ProposalPayloadType_RewardsPercentages ProposalPayloadType = "rewards-percentages"
+	ProposalPayloadType_TreasurySpend      ProposalPayloadType = "treasury-spend"
+	ProposalPayloadType_SecurityCouncil    ProposalPayloadType = "security-council"
+)
+
+// A setting changed by a Protocol DAO proposal
+type ProposalSettingChange struct {
+	ContractName string                    `json:"contractName"`
+	Path         string                    `json:"path"`
+	Type         types.ProposalSettingType `json:"type"`
+	Value        string                    `json:"value"`
+	CurrentValue string                    `json:"currentValue,omitempty"`
+}
+
+// The decoded payload of a Protocol DAO proposal
+type ProposalPayload struct {
+	Method string              `json:"method"`
+	Type   ProposalPayloadType `json:"type"`
+
+	// Settings changes
+	Settings []ProposalSettingChange `json:"settings,omitempty"`
+
+	// New rewards percentages for the oDAO, pDAO and node operators
+	RewardsPercentages        []*big.Int `json:"rewardsPercentages,omitempty"`
+	CurrentRewardsPercentages []*big.Int `json:"currentRewardsPercentages,omitempty"`
+
+	// Treasury spends; Amount is the total RPL the proposal can pay out, including every period of a recurring spend
+	ContractName    string         `json:"contractName,omitempty"`
+	Recipient       common.Address `json:"recipient,omitempty"`
+	Amount          *big.Int       `json:"amount,omitempty"`
+	AmountPerPeriod *big.Int       `json:"amountPerPeriod,omitempty"`
+	PeriodLength    time.Duration  `json:"periodLength,omitempty"`
+	StartTime       time.Time      `json:"startTime,omitempty"`
+	NumberOfPeriods uint64         `json:"numberOfPeriods,omitempty"`
+
+	// Security council changes
+	MemberID string           `json:"memberId,omitempty"`
+	Members  []common.Address `json:"members,omitempty"`
+}
+
+// Decode the payload of a Protocol DAO proposal into the change it makes
+func DecodeProposalPayload(rp *rocketpool.RocketPool, payload []byte) (*ProposalPayload, error) {
+	rocketDAOProtocolProposals, err := rp.GetContract("rocketDAOProtocolProposals", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rocketDAOProtocolProposals contract: %w", err)
+	}
+	return decodeProposalPayload(rocketDAOProtocolProposals.ABI, payload)
+}
+
+// Decode a proposal payload with the rocketDAOProtocolProposals ABI
+func decodeProposalPayload(contractAbi *abi.ABI, payload []byte) (*ProposalPayload, error) {
+	if len(payload) < 4 {
+		return nil, fmt.Errorf("payload is too short to contain a method ID")
+	}
+	method, err := contractAbi.MethodById(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposal payload method: %w", err)
+	}
+	args, err := method.Inputs.UnpackValues(payload[4:])
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposal payload arguments: %w", err)
+	}
+
+	decoded := &ProposalPayload{
+		Method: method.RawName,
+		Type:   ProposalPayloadType_Unknown,
+	}
+	switch method.RawName {
+	case "proposalSettingMulti":
+		contractNames := args[0].([]string)
+		paths := args[1].([]string)
+		settingTypes := args[2].([]uint8)
+		values := args[3].([][]byte)
+		if len(paths) != len(contractNames) || len(settingTypes) != len(contractNames) || len(values) != len(contractNames) {
+			return nil, fmt.Errorf("proposalSettingMulti arguments have mismatched lengths")
+		}
+		decoded.Type = ProposalPayloadType_Settings
+		for i := range contractNames {
+			settingType := types.ProposalSettingType(settingTypes[i])
+			value, err := decodeSettingValue(settingType, values[i])
+			if err != nil {
+				return nil, fmt.Errorf("error decoding value of setting %s.%s: %w", contractNames[i], paths[i], err)
+			}
+			decoded.Settings = append(decoded.Settings, ProposalSettingChange{
+				ContractName: contractNames[i],
+				Path:         paths[i],
+				Type:         settingType,
+				Value:        value,
+			})
+		}
+
+	case "proposalSettingUint":
+		decoded.Type = ProposalPayloadType_Settings
+		decoded.Settings = []ProposalSettingChange{{
+			ContractName: args[0].(string),
+			Path:         args[1].(string),
+			Type:         types.ProposalSettingType_Uint256,
+			Value:        args[2].(*big.Int).String(),
+		}}
+
+	case "proposalSettingBool":
+		decoded.Type = ProposalPayloadType_Settings
+		decoded.Settings = []ProposalSettingChange{{
+			ContractName: args[0].(string),
+			Path:         args[1].(string),
+			Type:         types.ProposalSettingType_Bool,
+			Value:        fmt.Sprint(args[2].(bool)),
+		}}
+
+	case "proposalSettingAddress":
+		decoded.Type = ProposalPayloadType_Settings
+		decoded.Settings = []ProposalSettingChange{{
+			ContractName: args[0].(string),
+			Path:         args[1].(string),
+			Type:         types.ProposalSettingType_Address,
+			Value:        args[2].(common.Address).Hex(),
+		}}
+
+	case "proposalSettingRewardsClaimers":
+		decoded.Type = ProposalPayloadType_RewardsPercentages
+		decoded.RewardsPercentages = []*big.Int{args[0].(*big.Int), args[1].(*big.Int), args[2].(*big.Int)}
+
+	case "proposalTreasuryOneTimeSpend":
+		decoded.Type = ProposalPayloadType_TreasurySpend
+		decoded.ContractName = args[0].(string)
+		decoded.Recipient = args[1].(common.Address)
+		decoded.Amount = args[2].(*big.Int)
+
+	case "proposalTreasuryNewContract", "proposalTreasuryUpdateContract":
+		// The new contract method has a start time before the number of periods
+		numPeriodsIndex := 4
+		if method.RawName == "proposalTreasuryNewContract" {
+			numPeriodsIndex = 5
+		}
+		decoded.Type = ProposalPayloadType_TreasurySpend
+		decoded.ContractName = args[0].(string)
+		decoded.Recipient = args[1].(common.Address)
+		decoded.AmountPerPeriod = args[2].(*big.Int)
+		decoded.PeriodLength = time.Duration(args[3].(*big.Int).Int64()) * time.Second
+		decoded.NumberOfPeriods = args[numPeriodsIndex].(*big.Int).Uint64()
+		if method.RawName == "proposalTreasuryNewContract" {
+			decoded.StartTime = time.Unix(args[4].(*big.Int).Int64(), 0)
+		}
+		decoded.Amount = big.NewInt(0).Mul(decoded.AmountPerPeriod, args[numPeriodsIndex].(*big.Int))
+
+	case "proposalSecurityInvite":
+		decoded.Type = ProposalPayloadType_SecurityCouncil
+		decoded.MemberID = args[0].(string)
+		decoded.Members = []common.Address{args[1].(common.Address)}
+
+	case "proposalSecurityKick":
+		decoded.Type = ProposalPayloadType_SecurityCouncil
+		decoded.Members = []common.Address{args[0].(common.Address)}
+
+	case "proposalSecurityKickMulti":
+		decoded.Type = ProposalPayloadType_SecurityCouncil
+		decoded.Members = args[0].([]common.Address)
+
+	case "proposalSecurityReplace":
+		decoded.Type = ProposalPayloadType_SecurityCouncil
+		decoded.MemberID = args[1].(string)
+		decoded.Members = []common.Address{args[0].(common.Address), args[2].(common.Address)}
+	}
+
+	return decoded, nil
+}
+
+// Decode an ABI-encoded setting value from a multi-setting proposal
+func decodeSettingValue(settingType types.ProposalSettingType, value []byte) (string, error) {
+	if len(value) != common.HashLength {
+		return "", fmt.Errorf("expected %d bytes but got %d", common.HashLength, len(value))
+	}
+	switch settingType {
+	case types.ProposalSettingType_Uint256:
+		return big.NewInt(0).SetBytes(value).String(), nil
+	case types.ProposalSettingType_Bool:
+		return fmt.Sprint(value[common.HashLength-1] != 0), nil
+	case types.ProposalSettingType_Address:
+		return common.BytesToAddress(value).Hex(), nil
+	default:
+		return "", fmt.Errorf("unknown setting type %d", settingType)
+	}
+}
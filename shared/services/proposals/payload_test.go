@@ -0,0 +1,154 @@
+package proposals
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+const testProposalsAbi = `[
+	{"name":"proposalSettingMulti","type":"function","inputs":[{"name":"_settingContractNames","type":"string[]"},{"name":"_settingPaths","type":"string[]"},{"name":"_types","type":"uint8[]"},{"name":"_data","type":"bytes[]"}],"outputs":[]},
+	{"name":"proposalSettingAddress","type":"function","inputs":[{"name":"_settingContractName","type":"string"},{"name":"_settingPath","type":"string"},{"name":"_value","type":"address"}],"outputs":[]},
+	{"name":"proposalSettingRewardsClaimers","type":"function","inputs":[{"name":"_trustedNodePercent","type":"uint256"},{"name":"_protocolPercent","type":"uint256"},{"name":"_nodePercent","type":"uint256"}],"outputs":[]},
+	{"name":"proposalTreasuryOneTimeSpend","type":"function","inputs":[{"name":"_invoiceID","type":"string"},{"name":"_recipientAddress","type":"address"},{"name":"_amount","type":"uint256"}],"outputs":[]},
+	{"name":"proposalTreasuryNewContract","type":"function","inputs":[{"name":"_contractName","type":"string"},{"name":"_recipientAddress","type":"address"},{"name":"_amountPerPeriod","type":"uint256"},{"name":"_periodLength","type":"uint256"},{"name":"_startTime","type":"uint256"},{"name":"_numPeriods","type":"uint256"}],"outputs":[]},
+	{"name":"proposalSecurityReplace","type":"function","inputs":[{"name":"_existingMemberAddress","type":"address"},{"name":"_newMemberId","type":"string"},{"name":"_newMemberAddress","type":"address"}],"outputs":[]},
+	{"name":"proposalSecurityKickMulti","type":"function","inputs":[{"name":"_memberAddresses","type":"address[]"}],"outputs":[]}
+]`
+
+func TestDecodeProposalPayload(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(testProposalsAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipient := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	member := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	// Multi-setting proposals decode each value by its type
+	payload, err := contractAbi.Pack("proposalSettingMulti",
+		[]string{"rocketDAOProtocolSettingsDeposit", "rocketDAOProtocolSettingsNode", "rocketDAOProtocolSettingsNetwork"},
+		[]string{"deposit.enabled", "node.per.minipool.stake.minimum", "network.reth.collateral.target"},
+		[]uint8{uint8(types.ProposalSettingType_Bool), uint8(types.ProposalSettingType_Uint256), uint8(types.ProposalSettingType_Address)},
+		[][]byte{common.LeftPadBytes([]byte{1}, 32), common.LeftPadBytes(big.NewInt(1000).Bytes(), 32), common.LeftPadBytes(recipient.Bytes(), 32)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := decodeProposalPayload(&contractAbi, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != ProposalPayloadType_Settings || len(decoded.Settings) != 3 {
+		t.Fatalf("unexpected decoded payload: %+v", decoded)
+	}
+	if decoded.Settings[0].Value != "true" || decoded.Settings[1].Value != "1000" || decoded.Settings[2].Value != recipient.Hex() {
+		t.Errorf("unexpected setting values: %+v", decoded.Settings)
+	}
+
+	// A multi-setting value that isn't a single word can't be decoded
+	payload, err = contractAbi.Pack("proposalSettingMulti",
+		[]string{"rocketDAOProtocolSettingsDeposit"},
+		[]string{"deposit.enabled"},
+		[]uint8{uint8(types.ProposalSettingType_Bool)},
+		[][]byte{{1}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodeProposalPayload(&contractAbi, payload); err == nil {
+		t.Error("expected a short setting value to fail to decode")
+	}
+
+	// Single settings keep their contract and path
+	payload, err = contractAbi.Pack("proposalSettingAddress", "rocketDAOProtocolSettingsNetwork", "network.reth.deposit.delay", recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = decodeProposalPayload(&contractAbi, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Settings) != 1 || decoded.Settings[0].ContractName != "rocketDAOProtocolSettingsNetwork" || decoded.Settings[0].Type != types.ProposalSettingType_Address {
+		t.Errorf("unexpected decoded payload: %+v", decoded)
+	}
+
+	// Rewards percentages are in oDAO, pDAO, node operator order
+	payload, err = contractAbi.Pack("proposalSettingRewardsClaimers", eth.EthToWei(0.1), eth.EthToWei(0.2), eth.EthToWei(0.7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = decodeProposalPayload(&contractAbi, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != ProposalPayloadType_RewardsPercentages || len(decoded.RewardsPercentages) != 3 || decoded.RewardsPercentages[2].Cmp(eth.EthToWei(0.7)) != 0 {
+		t.Errorf("unexpected decoded payload: %+v", decoded)
+	}
+
+	// One-time spends report their amount directly
+	payload, err = contractAbi.Pack("proposalTreasuryOneTimeSpend", "invoice-1", recipient, eth.EthToWei(50))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = decodeProposalPayload(&contractAbi, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != ProposalPayloadType_TreasurySpend || decoded.Recipient != recipient || decoded.Amount.Cmp(eth.EthToWei(50)) != 0 || decoded.NumberOfPeriods != 0 {
+		t.Errorf("unexpected decoded payload: %+v", decoded)
+	}
+
+	// Recurring spends report the total amount over every period
+	payload, err = contractAbi.Pack("proposalTreasuryNewContract", "grants", recipient,
+		eth.EthToWei(100), big.NewInt(86400), big.NewInt(1700000000), big.NewInt(12))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = decodeProposalPayload(&contractAbi, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != ProposalPayloadType_TreasurySpend || decoded.NumberOfPeriods != 12 || decoded.Amount.Cmp(eth.EthToWei(1200)) != 0 {
+		t.Errorf("unexpected decoded payload: %+v", decoded)
+	}
+	if decoded.PeriodLength.Hours() != 24 || decoded.StartTime.Unix() != 1700000000 {
+		t.Errorf("unexpected spend schedule: %+v", decoded)
+	}
+
+	// Security council changes list every member they affect
+	payload, err = contractAbi.Pack("proposalSecurityReplace", member, "new member", recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = decodeProposalPayload(&contractAbi, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != ProposalPayloadType_SecurityCouncil || decoded.MemberID != "new member" || len(decoded.Members) != 2 || decoded.Members[0] != member || decoded.Members[1] != recipient {
+		t.Errorf("unexpected decoded payload: %+v", decoded)
+	}
+	payload, err = contractAbi.Pack("proposalSecurityKickMulti", []common.Address{member, recipient})
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err = decodeProposalPayload(&contractAbi, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Type != ProposalPayloadType_SecurityCouncil || len(decoded.Members) != 2 {
+		t.Errorf("unexpected decoded payload: %+v", decoded)
+	}
+
+	// Payloads that aren't calls to a proposal method can't be decoded
+	if _, err := decodeProposalPayload(&contractAbi, []byte{0x01, 0x02}); err == nil {
+		t.Error("expected a short payload to fail to decode")
+	}
+	if _, err := decodeProposalPayload(&contractAbi, []byte{0xde, 0xad, 0xbe, 0xef}); err == nil {
+		t.Error("expected an unknown method to fail to decode")
+	}
+}
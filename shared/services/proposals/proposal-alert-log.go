@@ -0,0 +1,103 @@
+package proposals
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// The Protocol DAO proposal events the node daemon has already sent alerts for, by proposal ID.
+// It's saved between restarts so alerts for proposals that are still active aren't sent again.
+type ProposalAlertLog struct {
+	// Proposals that were announced as open for voting
+	VotingStarted map[uint64]bool `json:"votingStarted"`
+
+	// The voting phase of each proposal that the node was reminded to vote in
+	Deadlines map[uint64]types.ProtocolDaoProposalState `json:"deadlines"`
+
+	// Proposals where the node's delegate voted differently than the node
+	DelegateVotes map[uint64]bool `json:"delegateVotes"`
+
+	// The challenged indices of the node's own proposals
+	Challenges map[uint64]map[uint64]bool `json:"challenges"`
+}
+
+// Create an empty proposal alert log
+func NewProposalAlertLog() *ProposalAlertLog {
+	return &ProposalAlertLog{
+		VotingStarted: map[uint64]bool{},
+		Deadlines:     map[uint64]types.ProtocolDaoProposalState{},
+		DelegateVotes: map[uint64]bool{},
+		Challenges:    map[uint64]map[uint64]bool{},
+	}
+}
+
+// Load the proposal alert log; returns an empty log if it doesn't exist yet
+func LoadProposalAlertLog(path string) (*ProposalAlertLog, error) {
+	log := NewProposalAlertLog()
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return log, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading proposal alert log from %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, log); err != nil {
+		return nil, fmt.Errorf("error parsing proposal alert log from %s: %w", path, err)
+	}
+
+	// Sections that were missing from the file are left empty
+	if log.VotingStarted == nil {
+		log.VotingStarted = map[uint64]bool{}
+	}
+	if log.Deadlines == nil {
+		log.Deadlines = map[uint64]types.ProtocolDaoProposalState{}
+	}
+	if log.DelegateVotes == nil {
+		log.DelegateVotes = map[uint64]bool{}
+	}
+	if log.Challenges == nil {
+		log.Challenges = map[uint64]map[uint64]bool{}
+	}
+	return log, nil
+}
+
+// Save the proposal alert log
+func (l *ProposalAlertLog) Save(path string) error {
+	bytes, err := json.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("error serializing proposal alert log: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("error saving proposal alert log to %s: %w", path, err)
+	}
+	return nil
+}
+
+// Record an alert for a challenge against an index of a proposal
+func (l *ProposalAlertLog) AddChallenge(proposalID uint64, index uint64) {
+	if l.Challenges[proposalID] == nil {
+		l.Challenges[proposalID] = map[uint64]bool{}
+	}
+	l.Challenges[proposalID][index] = true
+}
+
+// Forget the alerts for a proposal's voting phases once it's out of them; returns true if anything was removed
+func (l *ProposalAlertLog) PruneVoting(proposalID uint64) bool {
+	_, votingStarted := l.VotingStarted[proposalID]
+	_, deadline := l.Deadlines[proposalID]
+	_, delegateVote := l.DelegateVotes[proposalID]
+	delete(l.VotingStarted, proposalID)
+	delete(l.Deadlines, proposalID)
+	delete(l.DelegateVotes, proposalID)
+	return votingStarted || deadline || delegateVote
+}
+
+// Forget the challenge alerts for a proposal once it's out of its challenge phase; returns true if anything was removed
+func (l *ProposalAlertLog) PruneChallenges(proposalID uint64) bool {
+	_, exists := l.Challenges[proposalID]
+	delete(l.Challenges, proposalID)
+	return exists
+}
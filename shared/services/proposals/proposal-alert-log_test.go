@@ -0,0 +1,45 @@
+package proposals
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+func TestProposalAlertLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pdao-proposal-alert-log.json")
+
+	// A missing log is empty
+	log, err := LoadProposalAlertLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log.VotingStarted) != 0 || len(log.Deadlines) != 0 || len(log.DelegateVotes) != 0 || len(log.Challenges) != 0 {
+		t.Fatalf("expected an empty log, got %+v", log)
+	}
+
+	// Sent alerts survive a reload
+	log.VotingStarted[7] = true
+	log.Deadlines[7] = types.ProtocolDaoProposalState_ActivePhase2
+	log.DelegateVotes[7] = true
+	log.AddChallenge(12, 3)
+	if err := log.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadProposalAlertLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.VotingStarted[7] || loaded.Deadlines[7] != types.ProtocolDaoProposalState_ActivePhase2 || !loaded.DelegateVotes[7] || !loaded.Challenges[12][3] {
+		t.Fatalf("expected %+v, got %+v", log, loaded)
+	}
+
+	// Proposals are forgotten once they leave the phases that send alerts
+	if !loaded.PruneVoting(7) || loaded.PruneVoting(7) {
+		t.Error("expected the voting alerts to be pruned once")
+	}
+	if !loaded.PruneChallenges(12) || loaded.PruneChallenges(12) {
+		t.Error("expected the challenge alerts to be pruned once")
+	}
+}
@@ -229,31 +229,12 @@ func (m *ProposalManager) GetArtifactsForVoting(blockNumber uint32, nodeAddress
 
 // Gets the root node and pollard for a proposer's response to a challenge against a tree node
 func (m *ProposalManager) GetArtifactsForChallengeResponse(blockNumber uint32, challengedIndex uint64) (types.VotingTreeNode, []types.VotingTreeNode, error) {
-	// Load the voting info snapshot
-	snapshot, err := m.GetVotingInfoSnapshot(blockNumber)
+	// Get the proper tree
+	tree, err := m.getTreeForIndex(blockNumber, challengedIndex)
 	if err != nil {
 		return types.VotingTreeNode{}, nil, err
 	}
 
-	// Get the proper tree
-	rpNodeIndex := getRPNodeIndexFromTreeNodeIndex(snapshot, challengedIndex)
-	var tree *VotingTree
-	if rpNodeIndex == nil {
-		// This is a node in the network tree
-		networkTree, err := m.GetNetworkTree(blockNumber, snapshot)
-		if err != nil {
-			return types.VotingTreeNode{}, nil, err
-		}
-		tree = networkTree.VotingTree
-	} else {
-		// This is a node in a node tree
-		nodeTree, err := m.GetNodeTree(blockNumber, *rpNodeIndex, snapshot)
-		if err != nil {
-			return types.VotingTreeNode{}, nil, err
-		}
-		tree = nodeTree.VotingTree
-	}
-
 	// Create the artifacts
 	rootPtr, pollardPtrs := tree.GetArtifactsForChallengeResponse(challengedIndex)
 	pollard := make([]types.VotingTreeNode, len(pollardPtrs))
@@ -265,33 +246,13 @@ func (m *ProposalManager) GetArtifactsForChallengeResponse(blockNumber uint32, c
 
 // Checks a RootSubmitted event against the local artifacts to see if there's a mismatch at an index; if so, returns the index, the node, and the proof
 func (m *ProposalManager) CheckForChallengeableArtifacts(event protocol.RootSubmitted) (uint64, types.VotingTreeNode, []types.VotingTreeNode, error) {
-	// Load the voting info snapshot
-	blockNumber := event.BlockNumber
+	// Get the proper tree
 	index := event.Index.Uint64()
-	snapshot, err := m.GetVotingInfoSnapshot(blockNumber)
+	tree, err := m.getTreeForIndex(event.BlockNumber, index)
 	if err != nil {
 		return 0, types.VotingTreeNode{}, nil, err
 	}
 
-	// Get the proper tree
-	rpNodeIndex := getRPNodeIndexFromTreeNodeIndex(snapshot, index)
-	var tree *VotingTree
-	if rpNodeIndex == nil {
-		// This is a node in the network tree
-		networkTree, err := m.GetNetworkTree(blockNumber, snapshot)
-		if err != nil {
-			return 0, types.VotingTreeNode{}, nil, err
-		}
-		tree = networkTree.VotingTree
-	} else {
-		// This is a node in a node tree
-		nodeTree, err := m.GetNodeTree(blockNumber, *rpNodeIndex, snapshot)
-		if err != nil {
-			return 0, types.VotingTreeNode{}, nil, err
-		}
-		tree = nodeTree.VotingTree
-	}
-
 	// Check for artifacts
 	challengedIndex, challengedNode, proofPtrs, err := tree.CheckForChallengeableArtifacts(index, event.TreeNodes)
 	if err != nil {
@@ -309,6 +270,32 @@ func (m *ProposalManager) CheckForChallengeableArtifacts(event protocol.RootSubm
 	return challengedIndex, *challengedNode, proof, nil
 }
 
+// Get the network or node tree that contains the tree node with the provided index
+func (m *ProposalManager) getTreeForIndex(blockNumber uint32, index uint64) (*VotingTree, error) {
+	// Load the voting info snapshot
+	snapshot, err := m.GetVotingInfoSnapshot(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	rpNodeIndex := getRPNodeIndexFromTreeNodeIndex(snapshot, index)
+	if rpNodeIndex == nil {
+		// This is a node in the network tree
+		networkTree, err := m.GetNetworkTree(blockNumber, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		return networkTree.VotingTree, nil
+	}
+
+	// This is a node in a node tree
+	nodeTree, err := m.GetNodeTree(blockNumber, *rpNodeIndex, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return nodeTree.VotingTree, nil
+}
+
 // Log a message to the logger
 func (m *ProposalManager) logMessage(message string, args ...any) {
 	if m.log != nil {
@@ -0,0 +1,40 @@
+package proposals
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-json"
+)
+
+// The last message the node daemon logged about each proposal while applying the voting policy, by proposal ID.
+// It's saved between restarts so unchanged decisions (and their dry run alerts) aren't repeated.
+type VotingPolicyLog map[uint64]string
+
+// Load the voting policy log; returns an empty log if it doesn't exist yet
+func LoadVotingPolicyLog(path string) (VotingPolicyLog, error) {
+	log := VotingPolicyLog{}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return log, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading voting policy log from %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, &log); err != nil {
+		return nil, fmt.Errorf("error parsing voting policy log from %s: %w", path, err)
+	}
+	return log, nil
+}
+
+// Save the voting policy log
+func (l VotingPolicyLog) Save(path string) error {
+	bytes, err := json.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("error serializing voting policy log: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("error saving voting policy log to %s: %w", path, err)
+	}
+	return nil
+}
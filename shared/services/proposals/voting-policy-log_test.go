@@ -0,0 +1,33 @@
+package proposals
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestVotingPolicyLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pdao-voting-policy-log.json")
+
+	// A missing log is empty
+	log, err := LoadVotingPolicyLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log) != 0 {
+		t.Fatalf("expected an empty log, got %v", log)
+	}
+
+	// Saved messages survive a reload
+	log[7] = "DRY RUN: would vote 'For' on proposal 7 (default)."
+	log[12] = "Not voting on proposal 12: rule 'security council'."
+	if err := log.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadVotingPolicyLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != 2 || loaded[7] != log[7] || loaded[12] != log[12] {
+		t.Fatalf("expected %v, got %v", log, loaded)
+	}
+}
@@ -0,0 +1,218 @@
+package proposals
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"gopkg.in/yaml.v2"
+)
+
+// A vote a voting policy rule can cast
+type PolicyVote string
+
+const (
+	PolicyVote_None     PolicyVote = "none"
+	PolicyVote_Abstain  PolicyVote = "abstain"
+	PolicyVote_For      PolicyVote = "for"
+	PolicyVote_Against  PolicyVote = "against"
+	PolicyVote_Veto     PolicyVote = "veto"
+	PolicyVote_Delegate PolicyVote = "delegate"
+)
+
+// The proposal type that makes a rule match every proposal
+const anyProposalType ProposalPayloadType = "any"
+
+// A rule in a voting policy
+type VotingPolicyRule struct {
+	Name string `yaml:"name" json:"name"`
+
+	// The proposal type the rule applies to, or "any"
+	Type ProposalPayloadType `yaml:"type" json:"type"`
+
+	// For settings proposals, only match changes to this contract and / or setting path
+	ContractName string `yaml:"contract,omitempty" json:"contract,omitempty"`
+	Setting      string `yaml:"setting,omitempty" json:"setting,omitempty"`
+
+	// For treasury spends, only match spends of more than this much RPL
+	AmountAbove float64 `yaml:"amountAbove,omitempty" json:"amountAbove,omitempty"`
+
+	Vote PolicyVote `yaml:"vote" json:"vote"`
+}
+
+// A declarative policy for voting on Protocol DAO proposals; the first rule that matches a proposal decides the vote
+type VotingPolicy struct {
+	// The address whose vote is copied by rules that vote "delegate"
+	Delegate string `yaml:"delegate,omitempty" json:"delegate,omitempty"`
+
+	// The vote for proposals that don't match any rule
+	Default PolicyVote `yaml:"default,omitempty" json:"default,omitempty"`
+
+	Rules []VotingPolicyRule `yaml:"rules" json:"rules"`
+}
+
+// How a voting policy decided to vote on a proposal
+type VotingPolicyDecision struct {
+	Vote   types.VoteDirection `json:"vote"`
+	Reason string              `json:"reason"`
+}
+
+// Load the voting policy from a file; returns nil if the file doesn't exist
+func LoadVotingPolicy(path string) (*VotingPolicy, error) {
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading voting policy from %s: %w", path, err)
+	}
+	policy := &VotingPolicy{}
+	if err := yaml.UnmarshalStrict(bytes, policy); err != nil {
+		return nil, fmt.Errorf("error parsing voting policy from %s: %w", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("voting policy in %s is invalid: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Check the policy for mistakes
+func (p *VotingPolicy) Validate() error {
+	if p.Delegate != "" && !common.IsHexAddress(p.Delegate) {
+		return fmt.Errorf("delegate '%s' is not a valid address", p.Delegate)
+	}
+	if err := p.validateVote(p.Default); err != nil {
+		return fmt.Errorf("default vote is invalid: %w", err)
+	}
+	for i, rule := range p.Rules {
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		switch rule.Type {
+		case anyProposalType, ProposalPayloadType_Settings, ProposalPayloadType_RewardsPercentages, ProposalPayloadType_TreasurySpend, ProposalPayloadType_SecurityCouncil, ProposalPayloadType_Unknown:
+		default:
+			return fmt.Errorf("rule '%s' has an invalid type '%s'", name, rule.Type)
+		}
+		if (rule.ContractName != "" || rule.Setting != "") && rule.Type != ProposalPayloadType_Settings {
+			return fmt.Errorf("rule '%s' filters on a setting but its type isn't '%s'", name, ProposalPayloadType_Settings)
+		}
+		if rule.AmountAbove != 0 && rule.Type != ProposalPayloadType_TreasurySpend {
+			return fmt.Errorf("rule '%s' filters on an amount but its type isn't '%s'", name, ProposalPayloadType_TreasurySpend)
+		}
+		if rule.AmountAbove < 0 {
+			return fmt.Errorf("rule '%s' has a negative amount", name)
+		}
+		if rule.Vote == "" {
+			return fmt.Errorf("rule '%s' doesn't have a vote", name)
+		}
+		if err := p.validateVote(rule.Vote); err != nil {
+			return fmt.Errorf("rule '%s' is invalid: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Check that a vote is valid for the policy
+func (p *VotingPolicy) validateVote(vote PolicyVote) error {
+	switch vote {
+	case "", PolicyVote_None, PolicyVote_Abstain, PolicyVote_For, PolicyVote_Against, PolicyVote_Veto:
+		return nil
+	case PolicyVote_Delegate:
+		if p.Delegate == "" {
+			return fmt.Errorf("votes of '%s' require the policy to have a delegate", PolicyVote_Delegate)
+		}
+		return nil
+	default:
+		return fmt.Errorf("'%s' is not a valid vote", vote)
+	}
+}
+
+// Get the policy's delegate, if it has one
+func (p *VotingPolicy) GetDelegate() (common.Address, bool) {
+	if p.Delegate == "" {
+		return common.Address{}, false
+	}
+	return common.HexToAddress(p.Delegate), true
+}
+
+// Decide how to vote on a proposal; payload is nil if it couldn't be decoded, and delegateVote is the vote the policy's delegate has cast
+func (p *VotingPolicy) Evaluate(payload *ProposalPayload, delegateVote types.VoteDirection) VotingPolicyDecision {
+	if payload == nil {
+		payload = &ProposalPayload{Type: ProposalPayloadType_Unknown}
+	}
+
+	// Find the first matching rule
+	vote := p.Default
+	reason := "no rule matched, using the default vote"
+	for i, rule := range p.Rules {
+		if rule.matches(payload) {
+			name := rule.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", i+1)
+			}
+			vote = rule.Vote
+			reason = fmt.Sprintf("matched rule '%s'", name)
+			break
+		}
+	}
+
+	decision := VotingPolicyDecision{
+		Vote:   types.VoteDirection_NoVote,
+		Reason: reason,
+	}
+	switch vote {
+	case PolicyVote_Abstain:
+		decision.Vote = types.VoteDirection_Abstain
+	case PolicyVote_For:
+		decision.Vote = types.VoteDirection_For
+	case PolicyVote_Against:
+		decision.Vote = types.VoteDirection_Against
+	case PolicyVote_Veto:
+		decision.Vote = types.VoteDirection_AgainstWithVeto
+	case PolicyVote_Delegate:
+		if delegateVote == types.VoteDirection_NoVote {
+			decision.Reason += "; waiting for the delegate to vote"
+		} else {
+			decision.Vote = delegateVote
+			decision.Reason += "; following the delegate's vote"
+		}
+	default:
+		decision.Reason += "; the policy doesn't vote on it"
+	}
+	return decision
+}
+
+// Check if a rule applies to a proposal
+func (r *VotingPolicyRule) matches(payload *ProposalPayload) bool {
+	if r.Type != anyProposalType && r.Type != payload.Type {
+		return false
+	}
+
+	switch r.Type {
+	case ProposalPayloadType_Settings:
+		if r.ContractName == "" && r.Setting == "" {
+			return true
+		}
+		for _, setting := range payload.Settings {
+			if (r.ContractName == "" || r.ContractName == setting.ContractName) &&
+				(r.Setting == "" || r.Setting == setting.Path) {
+				return true
+			}
+		}
+		return false
+
+	case ProposalPayloadType_TreasurySpend:
+		if r.AmountAbove == 0 {
+			return true
+		}
+		if payload.Amount == nil {
+			return false
+		}
+		return payload.Amount.Cmp(eth.EthToWei(r.AmountAbove)) > 0
+	}
+
+	return true
+}
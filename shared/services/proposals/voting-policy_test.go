@@ -0,0 +1,95 @@
+package proposals
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"gopkg.in/yaml.v2"
+)
+
+const testPolicy = `
+delegate: "0x1111111111111111111111111111111111111111"
+default: delegate
+rules:
+  - name: no deposit pool changes
+    type: settings
+    contract: rocketDAOProtocolSettingsDeposit
+    vote: against
+  - name: big spends
+    type: treasury-spend
+    amountAbove: 10000
+    vote: abstain
+  - name: security council
+    type: security-council
+    vote: none
+`
+
+func TestVotingPolicyEvaluate(t *testing.T) {
+	policy := &VotingPolicy{}
+	if err := yaml.UnmarshalStrict([]byte(testPolicy), policy); err != nil {
+		t.Fatal(err)
+	}
+	if err := policy.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		payload      *ProposalPayload
+		delegateVote types.VoteDirection
+		expected     types.VoteDirection
+	}{{
+		name: "matching setting",
+		payload: &ProposalPayload{Type: ProposalPayloadType_Settings, Settings: []ProposalSettingChange{
+			{ContractName: "rocketDAOProtocolSettingsNode", Path: "node.registration.enabled"},
+			{ContractName: "rocketDAOProtocolSettingsDeposit", Path: "deposit.enabled"},
+		}},
+		expected: types.VoteDirection_Against,
+	}, {
+		name:         "other setting follows the delegate",
+		payload:      &ProposalPayload{Type: ProposalPayloadType_Settings, Settings: []ProposalSettingChange{{ContractName: "rocketDAOProtocolSettingsNode"}}},
+		delegateVote: types.VoteDirection_For,
+		expected:     types.VoteDirection_For,
+	}, {
+		name:     "large spend",
+		payload:  &ProposalPayload{Type: ProposalPayloadType_TreasurySpend, Amount: eth.EthToWei(10001)},
+		expected: types.VoteDirection_Abstain,
+	}, {
+		name:         "small spend follows the delegate",
+		payload:      &ProposalPayload{Type: ProposalPayloadType_TreasurySpend, Amount: eth.EthToWei(10000)},
+		delegateVote: types.VoteDirection_AgainstWithVeto,
+		expected:     types.VoteDirection_AgainstWithVeto,
+	}, {
+		name:         "explicit no vote",
+		payload:      &ProposalPayload{Type: ProposalPayloadType_SecurityCouncil},
+		delegateVote: types.VoteDirection_For,
+		expected:     types.VoteDirection_NoVote,
+	}, {
+		name:     "waiting for the delegate",
+		payload:  nil,
+		expected: types.VoteDirection_NoVote,
+	}}
+	for _, test := range tests {
+		decision := policy.Evaluate(test.payload, test.delegateVote)
+		if decision.Vote != test.expected {
+			t.Errorf("%s: expected vote %s, got %s (%s)", test.name, types.VoteDirections[test.expected], types.VoteDirections[decision.Vote], decision.Reason)
+		}
+	}
+}
+
+func TestVotingPolicyValidate(t *testing.T) {
+	invalid := []VotingPolicy{
+		{Default: PolicyVote_Delegate},
+		{Default: "maybe"},
+		{Rules: []VotingPolicyRule{{Type: "settings-ish", Vote: PolicyVote_For}}},
+		{Rules: []VotingPolicyRule{{Type: ProposalPayloadType_TreasurySpend, Setting: "deposit.enabled", Vote: PolicyVote_For}}},
+		{Rules: []VotingPolicyRule{{Type: ProposalPayloadType_Settings, AmountAbove: 5, Vote: PolicyVote_For}}},
+		{Rules: []VotingPolicyRule{{Type: anyProposalType}}},
+	}
+	for i, policy := range invalid {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("expected policy %d to be invalid", i)
+		}
+	}
+}
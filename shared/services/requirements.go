@@ -46,6 +46,13 @@ func RequireNodePassword(c *cli.Context) error {
 }
 
 func RequireNodeWallet(c *cli.Context) error {
+	watchOnly, err := getNodeWatchOnly(c)
+	if err != nil {
+		return err
+	}
+	if watchOnly {
+		return nil
+	}
 	if err := RequireNodePassword(c); err != nil {
 		return err
 	}
@@ -59,6 +66,22 @@ func RequireNodeWallet(c *cli.Context) error {
 	return nil
 }
 
+// Require a node wallet that can sign with the node key. Watch-only nodes have no key, but they can still export
+// unsigned transactions for offline signing.
+func RequireNodeSigner(c *cli.Context) error {
+	watchOnly, err := getNodeWatchOnly(c)
+	if err != nil {
+		return err
+	}
+	if watchOnly {
+		if c.GlobalBool("offline-export") {
+			return nil
+		}
+		return errors.New("The node is in watch-only mode, so it can't sign transactions or messages. Run this command with --offline-export to export an unsigned transaction for offline signing instead.")
+	}
+	return RequireNodeWallet(c)
+}
+
 func RequireEthClientSynced(c *cli.Context) error {
 	ethClientSynced, err := waitEthClientSynced(c, false, EthClientSyncTimeout)
 	if err != nil {
@@ -167,6 +190,13 @@ func WaitNodePassword(c *cli.Context, verbose bool) error {
 }
 
 func WaitNodeWallet(c *cli.Context, verbose bool) error {
+	watchOnly, err := getNodeWatchOnly(c)
+	if err != nil {
+		return err
+	}
+	if watchOnly {
+		return nil
+	}
 	if err := WaitNodePassword(c, verbose); err != nil {
 		return err
 	}
@@ -249,6 +279,15 @@ func getNodePasswordSet(c *cli.Context) (bool, error) {
 	return pm.IsPasswordSet(), nil
 }
 
+// Check if the node is only being watched, without a wallet
+func getNodeWatchOnly(c *cli.Context) (bool, error) {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return false, err
+	}
+	return cfg.Smartnode.IsWatchOnly(), nil
+}
+
 // Check if the node wallet is initialized
 func getNodeWalletInitialized(c *cli.Context) (bool, error) {
 	w, err := GetWallet(c)
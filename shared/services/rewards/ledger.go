@@ -0,0 +1,579 @@
+package rewards
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// The kind of entry in a node's rewards ledger
+type LedgerEventType string
+
+const (
+	// RPL and Smoothing Pool ETH assigned to the node at the end of a rewards interval
+	LedgerEventType_IntervalRewards LedgerEventType = "interval-rewards"
+
+	// RPL and Smoothing Pool ETH claimed from the Merkle distributor
+	LedgerEventType_RewardsClaim LedgerEventType = "rewards-claim"
+
+	// The node's share of a minipool balance distribution
+	LedgerEventType_MinipoolDistribution LedgerEventType = "minipool-distribution"
+
+	// The node's share of a minipool's final balance after it exits; the bond it gets back isn't counted as rewards
+	LedgerEventType_MinipoolExit LedgerEventType = "minipool-exit"
+
+	// The node's share of a fee distributor distribution
+	LedgerEventType_FeeDistribution LedgerEventType = "fee-distribution"
+)
+
+// A single entry in a node's rewards ledger
+type LedgerEvent struct {
+	Type        LedgerEventType `json:"type"`
+	Time        time.Time       `json:"time"`
+	BlockNumber uint64          `json:"blockNumber"`
+	TxHash      common.Hash     `json:"txHash"`
+	Source      common.Address  `json:"source"`
+	Intervals   []uint64        `json:"intervals,omitempty"`
+	EthAmount   *QuotedBigInt   `json:"ethAmount"`
+	RplAmount   *QuotedBigInt   `json:"rplAmount"`
+	BondAmount  *QuotedBigInt   `json:"bondAmount,omitempty"`
+}
+
+// Events that aren't part of the contract ABIs stored in RocketStorage
+const ledgerEventsAbiString = `[
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"minipool","type":"address"},{"indexed":true,"internalType":"address","name":"node","type":"address"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"MinipoolCreated","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"executed","type":"address"},{"indexed":false,"internalType":"uint256","name":"nodeAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"userAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"totalBalance","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"EtherWithdrawalProcessed","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"_nodeAddress","type":"address"},{"indexed":false,"internalType":"uint256","name":"_userAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"_nodeAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"_time","type":"uint256"}],"name":"FeesDistributed","type":"event"},
+	{"inputs":[],"name":"getNodeAddress","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}
+]`
+
+// The number of minipool addresses to filter on in a single log query
+const ledgerMinipoolBatchSize = 100
+
+// Minipools treat a distribution of at least this much ETH as the full withdrawal of an exited validator
+var minipoolExitBalance = eth.EthToWei(8)
+
+// The on-chain history a ledger is built from
+type ledgerSource interface {
+	getIntervalEvents(registrationTime time.Time) ([]LedgerEvent, []uint64, error)
+	getClaimEvents() ([]LedgerEvent, error)
+	getMinipoolDistributionEvents() ([]LedgerEvent, error)
+	getFeeDistributionEvents() ([]LedgerEvent, error)
+}
+
+// Builds the rewards ledger of a node from the rewards tree files and its on-chain history
+type LedgerBuilder struct {
+	rp               *rocketpool.RocketPool
+	cfg              *config.RocketPoolConfig
+	nodeAddress      common.Address
+	eventLogInterval *big.Int
+	abi              abi.ABI
+	startBlock       *big.Int
+	blockTimes       map[uint64]time.Time
+}
+
+// Create a new ledger builder for a node
+func NewLedgerBuilder(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, nodeAddress common.Address) (*LedgerBuilder, error) {
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return nil, fmt.Errorf("error getting event log interval: %w", err)
+	}
+	ledgerAbi, err := abi.JSON(strings.NewReader(ledgerEventsAbiString))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ledger event ABI: %w", err)
+	}
+	return &LedgerBuilder{
+		rp:               rp,
+		cfg:              cfg,
+		nodeAddress:      nodeAddress,
+		eventLogInterval: big.NewInt(int64(eventLogInterval)),
+		abi:              ledgerAbi,
+		blockTimes:       map[uint64]time.Time{},
+	}, nil
+}
+
+// Build the ledger, sorted by time. Intervals the node has rewards in but whose tree files are missing or invalid
+// are returned separately so they can be downloaded; they aren't included in the ledger.
+func (b *LedgerBuilder) Build() ([]LedgerEvent, []uint64, error) {
+
+	// Only search the chain from the node's registration onwards
+	registrationTime, err := node.GetNodeRegistrationTime(b.rp, b.nodeAddress, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting node registration time: %w", err)
+	}
+	startHeader, err := GetELBlockHeaderForTime(registrationTime, b.rp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting the block the node registered in: %w", err)
+	}
+	b.startBlock = startHeader.Number
+
+	return buildLedger(b, registrationTime)
+
+}
+
+// Collect every entry of a ledger from its source, sorted by time
+func buildLedger(source ledgerSource, registrationTime time.Time) ([]LedgerEvent, []uint64, error) {
+	events := []LedgerEvent{}
+	intervalEvents, missingIntervals, err := source.getIntervalEvents(registrationTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, intervalEvents...)
+
+	claimEvents, err := source.getClaimEvents()
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, claimEvents...)
+
+	minipoolEvents, err := source.getMinipoolDistributionEvents()
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, minipoolEvents...)
+
+	feeEvents, err := source.getFeeDistributionEvents()
+	if err != nil {
+		return nil, nil, err
+	}
+	events = append(events, feeEvents...)
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].BlockNumber != events[j].BlockNumber {
+			return events[i].BlockNumber < events[j].BlockNumber
+		}
+		return events[i].Time.Before(events[j].Time)
+	})
+	return events, missingIntervals, nil
+
+}
+
+// Get the rewards assigned to the node in each interval since it registered
+func (b *LedgerBuilder) getIntervalEvents(registrationTime time.Time) ([]LedgerEvent, []uint64, error) {
+	currentIndex, err := rewards.GetRewardIndex(b.rp, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting current rewards interval: %w", err)
+	}
+
+	events := []LedgerEvent{}
+	missingIntervals := []uint64{}
+	for interval := uint64(0); interval < currentIndex.Uint64(); interval++ {
+		info, err := GetIntervalInfo(b.rp, b.cfg, b.nodeAddress, interval, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if info.EndTime.Before(registrationTime) {
+			continue
+		}
+		if !info.TreeFileExists || !info.MerkleRootValid {
+			missingIntervals = append(missingIntervals, interval)
+			continue
+		}
+		if !info.NodeExists {
+			continue
+		}
+
+		// Get the snapshot transaction
+		snapshotLog, err := b.getRewardSnapshotLog(interval)
+		if err != nil {
+			return nil, nil, err
+		}
+		blockTime, err := b.getBlockTime(snapshotLog.BlockNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rpl := big.NewInt(0).Add(&info.CollateralRplAmount.Int, &info.ODaoRplAmount.Int)
+		events = append(events, LedgerEvent{
+			Type:        LedgerEventType_IntervalRewards,
+			Time:        blockTime,
+			BlockNumber: snapshotLog.BlockNumber,
+			TxHash:      snapshotLog.TxHash,
+			Source:      snapshotLog.Address,
+			Intervals:   []uint64{interval},
+			EthAmount:   &QuotedBigInt{Int: *big.NewInt(0).Set(&info.SmoothingPoolEthAmount.Int)},
+			RplAmount:   &QuotedBigInt{Int: *rpl},
+		})
+	}
+	return events, missingIntervals, nil
+}
+
+// Get the log of the transaction that submitted an interval's rewards snapshot
+func (b *LedgerBuilder) getRewardSnapshotLog(interval uint64) (types.Log, error) {
+	rocketRewardsPool, err := b.rp.GetContract("rocketRewardsPool", nil)
+	if err != nil {
+		return types.Log{}, err
+	}
+
+	// Get the block the snapshot was submitted in
+	indexBig := big.NewInt(0).SetUint64(interval)
+	blockWrapper := new(*big.Int)
+	if err := rocketRewardsPool.Call(nil, blockWrapper, "getClaimIntervalExecutionBlock", indexBig); err != nil {
+		return types.Log{}, fmt.Errorf("error getting the snapshot block for interval %d: %w", interval, err)
+	}
+	block := *blockWrapper
+
+	// Get the snapshot event
+	addresses := append([]common.Address{*rocketRewardsPool.Address}, b.cfg.Smartnode.GetPreviousRewardsPoolAddresses()...)
+	indexBytes := [32]byte{}
+	indexBig.FillBytes(indexBytes[:])
+	topicFilter := [][]common.Hash{{rocketRewardsPool.ABI.Events["RewardSnapshot"].ID}, {indexBytes}}
+	logs, err := eth.GetLogs(b.rp, addresses, topicFilter, big.NewInt(1), block, block, nil)
+	if err != nil {
+		return types.Log{}, fmt.Errorf("error getting the snapshot event for interval %d: %w", interval, err)
+	}
+	if len(logs) == 0 {
+		return types.Log{}, fmt.Errorf("snapshot event for interval %d not found in block %s", interval, block.String())
+	}
+	return logs[0], nil
+}
+
+// Get the node's claims from the Merkle distributor
+func (b *LedgerBuilder) getClaimEvents() ([]LedgerEvent, error) {
+	distributor, err := b.rp.GetContract("rocketMerkleDistributorMainnet", nil)
+	if err != nil {
+		return nil, err
+	}
+	claimEvent, exists := distributor.ABI.Events["RewardsClaimed"]
+	if !exists {
+		return nil, fmt.Errorf("the Merkle distributor ABI doesn't have a RewardsClaimed event")
+	}
+
+	topicFilter := [][]common.Hash{{claimEvent.ID}, {common.BytesToHash(b.nodeAddress.Bytes())}}
+	logs, err := eth.GetLogs(b.rp, []common.Address{*distributor.Address}, topicFilter, b.eventLogInterval, b.startBlock, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rewards claim events: %w", err)
+	}
+
+	events := []LedgerEvent{}
+	for _, log := range logs {
+		blockTime, err := b.getBlockTime(log.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		event, err := decodeRewardsClaim(distributor.ABI, log, blockTime)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Decode a RewardsClaimed event from the Merkle distributor
+func decodeRewardsClaim(distributorAbi *abi.ABI, log types.Log, blockTime time.Time) (LedgerEvent, error) {
+	var claim struct {
+		RewardIndex []*big.Int
+		AmountRPL   []*big.Int
+		AmountETH   []*big.Int
+	}
+	if err := distributorAbi.UnpackIntoInterface(&claim, "RewardsClaimed", log.Data); err != nil {
+		return LedgerEvent{}, fmt.Errorf("error decoding rewards claim event in tx %s: %w", log.TxHash.Hex(), err)
+	}
+
+	event := LedgerEvent{
+		Type:        LedgerEventType_RewardsClaim,
+		Time:        blockTime,
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		Source:      log.Address,
+		Intervals:   make([]uint64, len(claim.RewardIndex)),
+		EthAmount:   NewQuotedBigInt(0),
+		RplAmount:   NewQuotedBigInt(0),
+	}
+	for i, index := range claim.RewardIndex {
+		event.Intervals[i] = index.Uint64()
+	}
+	for _, amount := range claim.AmountETH {
+		event.EthAmount.Add(&event.EthAmount.Int, amount)
+	}
+	for _, amount := range claim.AmountRPL {
+		event.RplAmount.Add(&event.RplAmount.Int, amount)
+	}
+	return event, nil
+}
+
+// Get the node's share of every balance distribution of its minipools, including the ones that have been closed
+func (b *LedgerBuilder) getMinipoolDistributionEvents() ([]LedgerEvent, error) {
+	minipoolAddresses, err := b.getMinipoolAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawalEvent := b.abi.Events["EtherWithdrawalProcessed"]
+	topicFilter := [][]common.Hash{{withdrawalEvent.ID}}
+	events := []LedgerEvent{}
+	for start := 0; start < len(minipoolAddresses); start += ledgerMinipoolBatchSize {
+		end := start + ledgerMinipoolBatchSize
+		if end > len(minipoolAddresses) {
+			end = len(minipoolAddresses)
+		}
+		logs, err := eth.GetLogs(b.rp, minipoolAddresses[start:end], topicFilter, b.eventLogInterval, b.startBlock, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting minipool distribution events: %w", err)
+		}
+
+		for _, log := range logs {
+			event, err := decodeMinipoolWithdrawal(&b.abi, log, b.getMinipoolBond)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// Decode a minipool's EtherWithdrawalProcessed event. The node's share of an exited minipool's balance includes
+// the bond it gets back, so that's split off from the rewards.
+func decodeMinipoolWithdrawal(ledgerAbi *abi.ABI, log types.Log, getBond func(common.Address) (*big.Int, error)) (LedgerEvent, error) {
+	var withdrawal struct {
+		NodeAmount   *big.Int
+		UserAmount   *big.Int
+		TotalBalance *big.Int
+		Time         *big.Int
+	}
+	if err := ledgerAbi.UnpackIntoInterface(&withdrawal, "EtherWithdrawalProcessed", log.Data); err != nil {
+		return LedgerEvent{}, fmt.Errorf("error decoding minipool distribution event in tx %s: %w", log.TxHash.Hex(), err)
+	}
+	event := LedgerEvent{
+		Type:        LedgerEventType_MinipoolDistribution,
+		Time:        time.Unix(withdrawal.Time.Int64(), 0),
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		Source:      log.Address,
+		EthAmount:   &QuotedBigInt{Int: *withdrawal.NodeAmount},
+		RplAmount:   NewQuotedBigInt(0),
+	}
+	if withdrawal.TotalBalance.Cmp(minipoolExitBalance) < 0 {
+		return event, nil
+	}
+
+	// Anything short of the bond is a loss of principal (e.g. from penalties or slashing) rather than negative rewards
+	bond, err := getBond(log.Address)
+	if err != nil {
+		return LedgerEvent{}, err
+	}
+	returnedBond := big.NewInt(0).Set(bond)
+	if withdrawal.NodeAmount.Cmp(bond) < 0 {
+		returnedBond.Set(withdrawal.NodeAmount)
+	}
+	event.Type = LedgerEventType_MinipoolExit
+	event.EthAmount = &QuotedBigInt{Int: *big.NewInt(0).Sub(withdrawal.NodeAmount, returnedBond)}
+	event.BondAmount = &QuotedBigInt{Int: *returnedBond}
+	return event, nil
+}
+
+// Get the bond the node has in a minipool
+func (b *LedgerBuilder) getMinipoolBond(address common.Address) (*big.Int, error) {
+	mp, err := minipool.NewMinipool(b.rp, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating binding for minipool %s: %w", address.Hex(), err)
+	}
+	bond, err := mp.GetNodeDepositBalance(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting bond of minipool %s: %w", address.Hex(), err)
+	}
+	return bond, nil
+}
+
+// Get every minipool the node has created. Closed minipools are removed from the node's minipool list,
+// so they're found from their creation events and checked against the minipool manager's records.
+func (b *LedgerBuilder) getMinipoolAddresses() ([]common.Address, error) {
+	currentAddresses, err := minipool.GetNodeMinipoolAddresses(b.rp, b.nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting node minipool addresses: %w", err)
+	}
+	included := map[common.Address]bool{}
+	addresses := []common.Address{}
+	for _, address := range currentAddresses {
+		included[address] = true
+		addresses = append(addresses, address)
+	}
+
+	// The minipool manager has been upgraded several times, so search for creation events from any contract
+	topicFilter := [][]common.Hash{{b.abi.Events["MinipoolCreated"].ID}, nil, {common.BytesToHash(b.nodeAddress.Bytes())}}
+	logs, err := eth.GetLogs(b.rp, nil, topicFilter, b.eventLogInterval, b.startBlock, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting minipool creation events: %w", err)
+	}
+	candidates := []common.Address{}
+	for _, log := range logs {
+		if len(log.Topics) < 3 {
+			continue
+		}
+		address := common.BytesToAddress(log.Topics[1].Bytes())
+		if !included[address] {
+			included[address] = true
+			candidates = append(candidates, address)
+		}
+	}
+
+	// Only keep the ones that really are (or were) Rocket Pool minipools belonging to this node
+	valid := make([]bool, len(candidates))
+	var wg errgroup.Group
+	wg.SetLimit(16)
+	for i, address := range candidates {
+		i := i
+		address := address
+		wg.Go(func() error {
+			var err error
+			valid[i], err = b.isNodeMinipool(address)
+			return err
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	for i, address := range candidates {
+		if valid[i] {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses, nil
+}
+
+// Check if an address is a current or destroyed minipool that belongs to the node
+func (b *LedgerBuilder) isNodeMinipool(address common.Address) (bool, error) {
+	exists, err := b.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("minipool.exists"), address.Bytes()))
+	if err != nil {
+		return false, fmt.Errorf("error checking if minipool %s exists: %w", address.Hex(), err)
+	}
+	if !exists {
+		destroyed, err := b.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte("minipool.destroyed"), address.Bytes()))
+		if err != nil {
+			return false, fmt.Errorf("error checking if minipool %s was destroyed: %w", address.Hex(), err)
+		}
+		if !destroyed {
+			return false, nil
+		}
+	}
+
+	// Minipools that self-destructed before Atlas have no code left to ask, and never distributed anything
+	contract := bind.NewBoundContract(address, b.abi, b.rp.Client, b.rp.Client, b.rp.Client)
+	results := []interface{}{}
+	if err := contract.Call(nil, &results, "getNodeAddress"); err != nil || len(results) == 0 {
+		return false, nil
+	}
+	owner, ok := results[0].(common.Address)
+	return ok && owner == b.nodeAddress, nil
+}
+
+// Get the node's share of every distribution from its fee distributor
+func (b *LedgerBuilder) getFeeDistributionEvents() ([]LedgerEvent, error) {
+	distributorAddress, err := node.GetDistributorAddress(b.rp, b.nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fee distributor address: %w", err)
+	}
+
+	topicFilter := [][]common.Hash{{b.abi.Events["FeesDistributed"].ID}}
+	logs, err := eth.GetLogs(b.rp, []common.Address{distributorAddress}, topicFilter, b.eventLogInterval, b.startBlock, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting fee distribution events: %w", err)
+	}
+
+	events := []LedgerEvent{}
+	for _, log := range logs {
+		event, err := decodeFeeDistribution(&b.abi, log)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Decode a FeesDistributed event from the node's fee distributor
+func decodeFeeDistribution(ledgerAbi *abi.ABI, log types.Log) (LedgerEvent, error) {
+	var distribution struct {
+		NodeAddress common.Address
+		UserAmount  *big.Int
+		NodeAmount  *big.Int
+		Time        *big.Int
+	}
+	if err := ledgerAbi.UnpackIntoInterface(&distribution, "FeesDistributed", log.Data); err != nil {
+		return LedgerEvent{}, fmt.Errorf("error decoding fee distribution event in tx %s: %w", log.TxHash.Hex(), err)
+	}
+	return LedgerEvent{
+		Type:        LedgerEventType_FeeDistribution,
+		Time:        time.Unix(distribution.Time.Int64(), 0),
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		Source:      log.Address,
+		EthAmount:   &QuotedBigInt{Int: *distribution.NodeAmount},
+		RplAmount:   NewQuotedBigInt(0),
+	}, nil
+}
+
+// Get the timestamp of a block
+func (b *LedgerBuilder) getBlockTime(blockNumber uint64) (time.Time, error) {
+	if blockTime, exists := b.blockTimes[blockNumber]; exists {
+		return blockTime, nil
+	}
+	header, err := b.rp.Client.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(blockNumber))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error getting header for block %d: %w", blockNumber, err)
+	}
+	blockTime := time.Unix(int64(header.Time), 0)
+	b.blockTimes[blockNumber] = blockTime
+	return blockTime, nil
+}
+
+// Write a ledger as CSV, with amounts as exact decimal ETH / RPL values
+func WriteLedgerCsv(writer io.Writer, events []LedgerEvent) error {
+	csvWriter := csv.NewWriter(writer)
+	err := csvWriter.Write([]string{"time", "unix_time", "type", "intervals", "eth", "rpl", "bond_eth", "source", "block", "tx_hash"})
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		intervals := make([]string, len(event.Intervals))
+		for i, interval := range event.Intervals {
+			intervals[i] = fmt.Sprint(interval)
+		}
+		err := csvWriter.Write([]string{
+			event.Time.UTC().Format(time.RFC3339),
+			fmt.Sprint(event.Time.Unix()),
+			string(event.Type),
+			strings.Join(intervals, ";"),
+			formatLedgerAmount(event.EthAmount),
+			formatLedgerAmount(event.RplAmount),
+			formatLedgerAmount(event.BondAmount),
+			event.Source.Hex(),
+			fmt.Sprint(event.BlockNumber),
+			event.TxHash.Hex(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// Format a wei amount as an exact decimal value with 18 places
+func formatLedgerAmount(amount *QuotedBigInt) string {
+	if amount == nil {
+		return "0.000000000000000000"
+	}
+	return new(big.Rat).SetFrac(&amount.Int, big.NewInt(1e18)).FloatString(18)
+}
@@ -0,0 +1,222 @@
+package rewards
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+const testDistributorAbi = `[
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"claimer","type":"address"},{"indexed":false,"internalType":"uint256[]","name":"rewardIndex","type":"uint256[]"},{"indexed":false,"internalType":"uint256[]","name":"amountRPL","type":"uint256[]"},{"indexed":false,"internalType":"uint256[]","name":"amountETH","type":"uint256[]"}],"name":"RewardsClaimed","type":"event"}
+]`
+
+// A ledger source with fixed entries
+type testLedgerSource struct {
+	intervalEvents   []LedgerEvent
+	missingIntervals []uint64
+	claimEvents      []LedgerEvent
+	minipoolEvents   []LedgerEvent
+	feeEvents        []LedgerEvent
+	err              error
+}
+
+func (s *testLedgerSource) getIntervalEvents(registrationTime time.Time) ([]LedgerEvent, []uint64, error) {
+	return s.intervalEvents, s.missingIntervals, nil
+}
+func (s *testLedgerSource) getClaimEvents() ([]LedgerEvent, error) {
+	return s.claimEvents, nil
+}
+func (s *testLedgerSource) getMinipoolDistributionEvents() ([]LedgerEvent, error) {
+	return s.minipoolEvents, s.err
+}
+func (s *testLedgerSource) getFeeDistributionEvents() ([]LedgerEvent, error) {
+	return s.feeEvents, nil
+}
+
+func TestBuildLedger(t *testing.T) {
+	source := &testLedgerSource{
+		intervalEvents:   []LedgerEvent{{Type: LedgerEventType_IntervalRewards, BlockNumber: 300}},
+		missingIntervals: []uint64{4},
+		claimEvents:      []LedgerEvent{{Type: LedgerEventType_RewardsClaim, BlockNumber: 400}},
+		minipoolEvents: []LedgerEvent{
+			{Type: LedgerEventType_MinipoolExit, BlockNumber: 200, Time: time.Unix(20, 0)},
+			{Type: LedgerEventType_MinipoolDistribution, BlockNumber: 200, Time: time.Unix(10, 0)},
+		},
+		feeEvents: []LedgerEvent{{Type: LedgerEventType_FeeDistribution, BlockNumber: 100}},
+	}
+
+	// Every source is included, sorted by block and then by time
+	events, missingIntervals, err := buildLedger(source, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []LedgerEventType{
+		LedgerEventType_FeeDistribution,
+		LedgerEventType_MinipoolDistribution,
+		LedgerEventType_MinipoolExit,
+		LedgerEventType_IntervalRewards,
+		LedgerEventType_RewardsClaim,
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %d", len(expected), len(events))
+	}
+	for i, eventType := range expected {
+		if events[i].Type != eventType {
+			t.Errorf("event %d: expected %s, got %s", i, eventType, events[i].Type)
+		}
+	}
+	if len(missingIntervals) != 1 || missingIntervals[0] != 4 {
+		t.Errorf("unexpected missing intervals: %v", missingIntervals)
+	}
+
+	// A failing source fails the whole ledger instead of leaving entries out
+	source.err = errors.New("log query failed")
+	if _, _, err := buildLedger(source, time.Unix(0, 0)); err == nil {
+		t.Error("expected a source error to fail the ledger")
+	}
+}
+
+func TestDecodeMinipoolWithdrawal(t *testing.T) {
+	ledgerAbi, err := abi.JSON(strings.NewReader(ledgerEventsAbiString))
+	if err != nil {
+		t.Fatal(err)
+	}
+	minipoolAddress := common.HexToAddress("0x0a")
+	getBond := func(address common.Address) (*big.Int, error) {
+		if address != minipoolAddress {
+			t.Errorf("bond requested for unexpected minipool %s", address.Hex())
+		}
+		return eth.EthToWei(8), nil
+	}
+	makeLog := func(nodeAmount float64, userAmount float64) types.Log {
+		data, err := ledgerAbi.Events["EtherWithdrawalProcessed"].Inputs.NonIndexed().Pack(
+			eth.EthToWei(nodeAmount), eth.EthToWei(userAmount), eth.EthToWei(nodeAmount+userAmount), big.NewInt(1700000000))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return types.Log{Address: minipoolAddress, BlockNumber: 100, Data: data}
+	}
+
+	tests := []struct {
+		name       string
+		log        types.Log
+		eventType  LedgerEventType
+		ethAmount  *big.Int
+		bondAmount *big.Int
+	}{
+		{"skimmed rewards", makeLog(0.02, 0.03), LedgerEventType_MinipoolDistribution, eth.EthToWei(0.02), nil},
+		{"exit with rewards", makeLog(8.5, 24.5), LedgerEventType_MinipoolExit, eth.EthToWei(0.5), eth.EthToWei(8)},
+		{"exit with a penalty", makeLog(7.5, 24), LedgerEventType_MinipoolExit, big.NewInt(0), eth.EthToWei(7.5)},
+	}
+	for _, test := range tests {
+		event, err := decodeMinipoolWithdrawal(&ledgerAbi, test.log, getBond)
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if event.Type != test.eventType {
+			t.Errorf("%s: expected type %s, got %s", test.name, test.eventType, event.Type)
+		}
+		if event.EthAmount.Cmp(test.ethAmount) != 0 {
+			t.Errorf("%s: expected %s ETH of rewards, got %s", test.name, test.ethAmount, event.EthAmount)
+		}
+		if test.bondAmount == nil {
+			if event.BondAmount != nil {
+				t.Errorf("%s: expected no bond, got %s", test.name, event.BondAmount)
+			}
+		} else if event.BondAmount == nil || event.BondAmount.Cmp(test.bondAmount) != 0 {
+			t.Errorf("%s: expected a bond of %s, got %v", test.name, test.bondAmount, event.BondAmount)
+		}
+		if event.Time.Unix() != 1700000000 || event.Source != minipoolAddress {
+			t.Errorf("%s: unexpected event details: %+v", test.name, event)
+		}
+	}
+}
+
+func TestDecodeRewardsClaim(t *testing.T) {
+	distributorAbi, err := abi.JSON(strings.NewReader(testDistributorAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := distributorAbi.Events["RewardsClaimed"].Inputs.NonIndexed().Pack(
+		[]*big.Int{big.NewInt(12), big.NewInt(13)},
+		[]*big.Int{eth.EthToWei(10), eth.EthToWei(15)},
+		[]*big.Int{eth.EthToWei(0.1), eth.EthToWei(0.2)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Claims of several intervals are added together
+	event, err := decodeRewardsClaim(&distributorAbi, types.Log{BlockNumber: 100, Data: data}, time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != LedgerEventType_RewardsClaim || len(event.Intervals) != 2 || event.Intervals[0] != 12 || event.Intervals[1] != 13 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if event.RplAmount.Cmp(eth.EthToWei(25)) != 0 || event.EthAmount.Cmp(eth.EthToWei(0.3)) != 0 {
+		t.Errorf("unexpected amounts: %s RPL, %s ETH", event.RplAmount, event.EthAmount)
+	}
+}
+
+func TestDecodeFeeDistribution(t *testing.T) {
+	ledgerAbi, err := abi.JSON(strings.NewReader(ledgerEventsAbiString))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ledgerAbi.Events["FeesDistributed"].Inputs.NonIndexed().Pack(
+		common.HexToAddress("0x01"), eth.EthToWei(0.6), eth.EthToWei(0.4), big.NewInt(1700000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := decodeFeeDistribution(&ledgerAbi, types.Log{BlockNumber: 100, Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != LedgerEventType_FeeDistribution || event.EthAmount.Cmp(eth.EthToWei(0.4)) != 0 || event.Time.Unix() != 1700000000 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	// Events that don't match the ABI are an error rather than a zero entry
+	if _, err := decodeFeeDistribution(&ledgerAbi, types.Log{Data: data[:32]}); err == nil {
+		t.Error("expected a truncated event to fail to decode")
+	}
+}
+
+func TestWriteLedgerCsv(t *testing.T) {
+	eth, _ := big.NewInt(0).SetString("1234567890123456789", 10)
+	events := []LedgerEvent{
+		{
+			Type:        LedgerEventType_RewardsClaim,
+			Time:        time.Unix(1700000000, 0),
+			BlockNumber: 18500000,
+			TxHash:      common.HexToHash("0x01"),
+			Source:      common.HexToAddress("0x02"),
+			Intervals:   []uint64{12, 13},
+			EthAmount:   &QuotedBigInt{Int: *eth},
+			RplAmount:   NewQuotedBigInt(5),
+		},
+	}
+
+	var buffer bytes.Buffer
+	if err := WriteLedgerCsv(&buffer, events); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected a header and 1 row, got %d lines", len(lines))
+	}
+	expected := "2023-11-14T22:13:20Z,1700000000,rewards-claim,12;13,1.234567890123456789,0.000000000000000005,0.000000000000000000," +
+		"0x0000000000000000000000000000000000000002,18500000,0x0000000000000000000000000000000000000000000000000000000000000001"
+	if lines[1] != expected {
+		t.Errorf("unexpected row:\n%s\nexpected:\n%s", lines[1], expected)
+	}
+}
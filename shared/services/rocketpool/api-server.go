@@ -0,0 +1,165 @@
+package rocketpool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Config
+const (
+	apiServerHost          string        = "http://rocketpool-api"
+	apiServerRoutePrefix   string        = "/api/v1/"
+	apiServerCommandsRoute string        = apiServerRoutePrefix + "commands"
+	apiServerProbeTimeout  time.Duration = 2 * time.Second
+)
+
+// Get an HTTP client for the API server if it's running, or nil if the API should be called with docker exec / the daemon binary instead
+func (c *Client) getApiServerClient() *http.Client {
+	if c.apiServerChecked {
+		return c.apiServerClient
+	}
+	c.apiServerChecked = true
+
+	// The API server is only reachable on the local machine
+	if c.client != nil || c.profile != nil {
+		return nil
+	}
+
+	// Get the socket path
+	cfg, isNew, err := c.LoadConfig()
+	if err != nil || isNew {
+		return nil
+	}
+	socketPath, err := homedir.Expand(os.ExpandEnv(cfg.Smartnode.GetApiSocketPath(false)))
+	if err != nil {
+		return nil
+	}
+	if _, err := os.Stat(socketPath); err != nil {
+		return nil
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	// Make sure the server is actually listening on it, and get the commands it can run
+	probeCtx, cancel := context.WithTimeout(context.Background(), apiServerProbeTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(probeCtx, http.MethodGet, apiServerHost+apiServerCommandsRoute, nil)
+	if err != nil {
+		return nil
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		if c.debugPrint {
+			fmt.Printf("API server not available (%s), falling back to running the API directly.\n", err.Error())
+		}
+		return nil
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil
+	}
+	var commands api.APIServerCommandsResponse
+	err = json.NewDecoder(response.Body).Decode(&commands)
+	if err != nil || commands.Status != "success" {
+		return nil
+	}
+	c.apiServerCommands = map[string]bool{}
+	for _, command := range commands.Commands {
+		c.apiServerCommands[command] = true
+	}
+
+	c.apiServerClient = httpClient
+	return httpClient
+}
+
+// Call the Rocket Pool API through the API server
+func (c *Client) callAPIServer(httpClient *http.Client, args string, otherArgs ...string) ([]byte, error) {
+	// The leading words that name one of the server's commands are the command path (e.g. node status, or wait), the rest are its arguments
+	fields := strings.Fields(args)
+	pathLength := 0
+	for i := 1; i <= len(fields); i++ {
+		if c.apiServerCommands[strings.Join(fields[:i], " ")] {
+			pathLength = i
+			break
+		}
+	}
+	if pathLength == 0 {
+		return nil, fmt.Errorf("the API server doesn't have a command for [%s]", args)
+	}
+	request := api.APIServerRequest{
+		Args:            append(fields[pathLength:], otherArgs...),
+		MaxFee:          c.maxFee,
+		MaxPrioFee:      c.maxPrioFee,
+		GasLimit:        c.gasLimit,
+		IgnoreSyncCheck: c.ignoreSyncCheck,
+		ForceFallbacks:  c.forceFallbacks,
+		OfflineExport:   c.IsOfflineExport() || c.IsDeferred(),
+	}
+	if c.customNonce != nil {
+		request.Nonce = c.customNonce.String()
+	}
+	url := apiServerHost + apiServerRoutePrefix + strings.Join(fields[:pathLength], "/")
+
+	// Reset the gas settings after the call
+	defer func() {
+		c.maxFee = c.originalMaxFee
+		c.maxPrioFee = c.originalMaxPrioFee
+		c.gasLimit = c.originalGasLimit
+	}()
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing API server request: %w", err)
+	}
+	if c.debugPrint {
+		fmt.Println("To API server:")
+		fmt.Println(url)
+		fmt.Println(string(body))
+	}
+
+	response, err := httpClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error calling API server: %w", err)
+	}
+	defer response.Body.Close()
+	output, err := io.ReadAll(response.Body)
+
+	if c.debugPrint {
+		if output != nil {
+			fmt.Println("API Out:")
+			fmt.Println(string(output))
+		}
+		if err != nil {
+			fmt.Println("API Err:")
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Save any transactions that were exported instead of sent
+	if err == nil {
+		if exportErr := c.exportTransactions(output); exportErr != nil {
+			return nil, exportErr
+		}
+	}
+
+	return output, err
+}
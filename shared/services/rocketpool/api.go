@@ -10,6 +10,12 @@ import (
 
 // Wait for a transaction
 func (c *Client) WaitForTransaction(txHash common.Hash) (api.APIResponse, error) {
+	if c.offlineExported {
+		return api.APIResponse{}, ErrTransactionExported
+	}
+	if c.deferredID != 0 {
+		return api.APIResponse{}, ErrTransactionDeferred
+	}
 	responseBytes, err := c.callAPI(fmt.Sprintf("wait %s", txHash.String()))
 	if err != nil {
 		return api.APIResponse{}, fmt.Errorf("Error waiting for tx: %w", err)
@@ -81,6 +81,17 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	apiServerClient    *http.Client
+	apiServerChecked   bool
+	apiServerCommands  map[string]bool
+	structuredOutput   bool
+	offlineExportPath  string
+	offlineExported    bool
+	deferGasBelow      float64
+	deferNotAfter      time.Time
+	deferDescription   string
+	deferredID         uint64
+	profile            *NodeProfile
 }
 
 func getClientStatusString(clientStatus api.ClientStatus) string {
@@ -124,18 +135,18 @@ func checkClientStatus(rp *Client) (bool, error) {
 
 		// Fallback EC and CC are good
 		if ecMgrStatus.FallbackClientStatus.IsSynced && bcMgrStatus.FallbackClientStatus.IsSynced {
-			fmt.Printf("%sNOTE: primary clients are not ready, using fallback clients...\n\tPrimary EC status: %s\n\tPrimary CC status: %s%s\n\n", colorYellow, primaryEcStatus, primaryBcStatus, colorReset)
+			fmt.Fprintf(rp.statusWriter(), "%sNOTE: primary clients are not ready, using fallback clients...\n\tPrimary EC status: %s\n\tPrimary CC status: %s%s\n\n", colorYellow, primaryEcStatus, primaryBcStatus, colorReset)
 			rp.SetClientStatusFlags(true, true)
 			return true, nil
 		}
 
 		// Both pairs aren't ready
-		fmt.Printf("Error: neither primary nor fallback client pairs are ready.\n\tPrimary EC status: %s\n\tFallback EC status: %s\n\tPrimary CC status: %s\n\tFallback CC status: %s\n", primaryEcStatus, fallbackEcStatus, primaryBcStatus, fallbackBcStatus)
+		fmt.Fprintf(rp.statusWriter(), "Error: neither primary nor fallback client pairs are ready.\n\tPrimary EC status: %s\n\tFallback EC status: %s\n\tPrimary CC status: %s\n\tFallback CC status: %s\n", primaryEcStatus, fallbackEcStatus, primaryBcStatus, fallbackBcStatus)
 		return false, nil
 	}
 
 	// Primary isn't ready and fallback isn't enabled
-	fmt.Printf("Error: primary client pair isn't ready and fallback clients aren't enabled.\n\tPrimary EC status: %s\n\tPrimary CC status: %s\n", primaryEcStatus, primaryBcStatus)
+	fmt.Fprintf(rp.statusWriter(), "Error: primary client pair isn't ready and fallback clients aren't enabled.\n\tPrimary EC status: %s\n\tPrimary CC status: %s\n", primaryEcStatus, primaryBcStatus)
 	return false, nil
 }
 
@@ -157,15 +168,35 @@ func NewClientFromCtx(c *cli.Context) *Client {
 		debugPrint:         c.GlobalBool("debug"),
 		forceFallbacks:     false,
 		ignoreSyncCheck:    false,
+		structuredOutput:   api.OutputFormat(strings.ToLower(c.GlobalString("output"))).IsStructured(),
+		offlineExportPath:  c.GlobalString("offline-export"),
+		deferGasBelow:      c.GlobalFloat64("when-gas-below"),
+		deferDescription:   strings.TrimSpace(fmt.Sprintf("rocketpool %s %s", c.Command.FullName(), strings.Join(c.Args(), " "))),
 	}
 
 	if nonce, ok := c.App.Metadata["nonce"]; ok {
 		client.customNonce = nonce.(*big.Int)
 	}
+	if notAfter, ok := c.App.Metadata["not-after"]; ok {
+		client.deferNotAfter = notAfter.(time.Time)
+	}
+	if profile, ok := c.App.Metadata[nodeProfileMetadataKey].(NodeProfile); ok {
+		client.profile = &profile
+		client.configPath = profile.getConfigPath()
+		client.daemonPath = profile.DaemonPath
+	}
 
 	return client
 }
 
+// Get the writer for client status messages; commands with structured output keep stdout clean for their response
+func (c *Client) statusWriter() io.Writer {
+	if c.structuredOutput {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
 // Check the status of a newly created client and return it
 // Only use this function from commands that may work without the clients being synced-
 // most users should use WithReady instead
@@ -215,6 +246,9 @@ func (c *Client) ConfigPath() string {
 // Returns the RocketPoolConfig and whether or not it was newly generated
 func (c *Client) LoadConfig() (*config.RocketPoolConfig, bool, error) {
 	settingsFilePath := filepath.Join(c.configPath, SettingsFile)
+	if c.profile != nil {
+		return c.loadRemoteConfig(settingsFilePath)
+	}
 	expandedPath, err := homedir.Expand(settingsFilePath)
 	if err != nil {
 		return nil, false, fmt.Errorf("error expanding settings file path: %w", err)
@@ -1247,8 +1281,26 @@ func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir strin
 
 }
 
+// Load the config from a remote node profile's host
+func (c *Client) loadRemoteConfig(settingsFilePath string) (*config.RocketPoolConfig, bool, error) {
+	configBytes, err := c.readOutput(fmt.Sprintf("cat %s", shellescape.Quote(getRemotePath(settingsFilePath))))
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading the settings file on node '%s': %w", c.profile.Name, err)
+	}
+	cfg, err := config.LoadFromBytes(configBytes, c.configPath)
+	if err != nil {
+		return nil, false, fmt.Errorf("error loading the settings file on node '%s': %w", c.profile.Name, err)
+	}
+	return cfg, false, nil
+}
+
 // Call the Rocket Pool API
 func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
+	// Use the API server if it's running
+	if httpClient := c.getApiServerClient(); httpClient != nil {
+		return c.callAPIServer(httpClient, args, otherArgs...)
+	}
+
 	// Sanitize and parse the args
 	ignoreSyncCheckFlag, forceFallbackECFlag, args := c.getApiCallArgs(args, otherArgs...)
 
@@ -1259,15 +1311,16 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getOfflineExportFlag(), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getOfflineExportFlag(),
 			args)
 	}
 
@@ -1292,13 +1345,13 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s %s api %s", envArgs, shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getOfflineExportFlag(), args)
 	} else {
 		envArgs := ""
 		for key, value := range envVars {
 			envArgs += fmt.Sprintf("%s=%s ", key, shellescape.Quote(value))
 		}
-		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s %s --settings %s %s %s %s %s %s api %s",
 			envArgs,
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
@@ -1306,6 +1359,7 @@ func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, othe
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getOfflineExportFlag(),
 			args)
 	}
 
@@ -1364,6 +1418,13 @@ func (c *Client) runApiCall(cmd string) ([]byte, error) {
 	c.maxPrioFee = c.originalMaxPrioFee
 	c.gasLimit = c.originalGasLimit
 
+	// Save any transactions that were exported instead of sent
+	if err == nil {
+		if exportErr := c.exportTransactions(output); exportErr != nil {
+			return nil, exportErr
+		}
+	}
+
 	return output, err
 }
 
@@ -16,6 +16,13 @@ type command struct {
 
 // Create a command to be run by the Rocket Pool client
 func (c *Client) newCommand(cmdText string) (*command, error) {
+	if c.client == nil && c.profile != nil {
+		client, err := c.profile.dial()
+		if err != nil {
+			return nil, err
+		}
+		c.client = client
+	}
 	if c.client == nil {
 		return &command{
 			cmd:     exec.Command("sh", "-c", cmdText),
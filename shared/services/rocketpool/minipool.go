@@ -3,6 +3,7 @@ package rocketpool
 import (
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
@@ -456,6 +457,46 @@ func (c *Client) DistributeBalance(address common.Address) (api.DistributeBalanc
 	return response, nil
 }
 
+// Check which minipools can have their ETH balances distributed in a single transaction
+func (c *Client) CanBatchDistributeBalance(addresses []common.Address) (api.CanBatchDistributeBalanceResponse, error) {
+	addressStrings := []string{}
+	for _, address := range addresses {
+		addressStrings = append(addressStrings, address.Hex())
+	}
+	responseBytes, err := c.callAPI("minipool can-batch-distribute-balance", strings.Join(addressStrings, ","))
+	if err != nil {
+		return api.CanBatchDistributeBalanceResponse{}, fmt.Errorf("Could not get can batch distribute balance status: %w", err)
+	}
+	var response api.CanBatchDistributeBalanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanBatchDistributeBalanceResponse{}, fmt.Errorf("Could not decode can batch distribute balance response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanBatchDistributeBalanceResponse{}, fmt.Errorf("Could not get can batch distribute balance status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Distribute the ETH balances of several minipools in a single transaction
+func (c *Client) BatchDistributeBalance(addresses []common.Address) (api.BatchDistributeBalanceResponse, error) {
+	addressStrings := []string{}
+	for _, address := range addresses {
+		addressStrings = append(addressStrings, address.Hex())
+	}
+	responseBytes, err := c.callAPI("minipool batch-distribute-balance", strings.Join(addressStrings, ","))
+	if err != nil {
+		return api.BatchDistributeBalanceResponse{}, fmt.Errorf("Could not batch distribute balances: %w", err)
+	}
+	var response api.BatchDistributeBalanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BatchDistributeBalanceResponse{}, fmt.Errorf("Could not decode batch distribute balance response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BatchDistributeBalanceResponse{}, fmt.Errorf("Could not batch distribute balances: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Import a validator private key for a vacant minipool
 func (c *Client) ImportKey(address common.Address, mnemonic string) (api.ChangeWithdrawalCredentialsResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool import-key %s", address.Hex()), mnemonic)
@@ -520,6 +561,22 @@ func (c *Client) GetMinipoolRescueDissolvedDetailsForNode() (api.GetMinipoolResc
 	return response, nil
 }
 
+// Check whether a dissolved minipool can be rescued with a deposit of the given amount
+func (c *Client) CanRescueDissolvedMinipool(address common.Address, amount *big.Int) (api.CanRescueDissolvedMinipoolResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-rescue-dissolved %s %s", address.Hex(), amount.String()))
+	if err != nil {
+		return api.CanRescueDissolvedMinipoolResponse{}, fmt.Errorf("Could not get can rescue dissolved minipool status: %w", err)
+	}
+	var response api.CanRescueDissolvedMinipoolResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanRescueDissolvedMinipoolResponse{}, fmt.Errorf("Could not decode can rescue dissolved minipool response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanRescueDissolvedMinipoolResponse{}, fmt.Errorf("Could not get can rescue dissolved minipool status: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Rescue a dissolved minipool by depositing ETH for it to the Beacon deposit contract
 func (c *Client) RescueDissolvedMinipool(address common.Address, amount *big.Int) (api.RescueDissolvedMinipoolResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool rescue-dissolved %s %s", address.Hex(), amount.String()))
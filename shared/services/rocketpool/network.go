@@ -176,3 +176,19 @@ func (c *Client) GetLatestDelegate() (api.GetLatestDelegateResponse, error) {
 	}
 	return response, nil
 }
+
+// Get max fee suggestions from the execution client's fee history
+func (c *Client) GetGasFeeSuggestion() (api.GasFeeSuggestionResponse, error) {
+	responseBytes, err := c.callAPI("network fee-suggestion")
+	if err != nil {
+		return api.GasFeeSuggestionResponse{}, fmt.Errorf("Could not get gas fee suggestion: %w", err)
+	}
+	var response api.GasFeeSuggestionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GasFeeSuggestionResponse{}, fmt.Errorf("Could not decode gas fee suggestion response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GasFeeSuggestionResponse{}, fmt.Errorf("Could not get gas fee suggestion: %s", response.Error)
+	}
+	return response, nil
+}
@@ -728,6 +728,22 @@ func (c *Client) NodeRewards() (api.NodeRewardsResponse, error) {
 	return response, nil
 }
 
+// Get the node's history of rewards, claims and distributions
+func (c *Client) NodeRewardsLedger() (api.NodeRewardsLedgerResponse, error) {
+	responseBytes, err := c.callAPI("node rewards-ledger")
+	if err != nil {
+		return api.NodeRewardsLedgerResponse{}, fmt.Errorf("Could not get node rewards ledger: %w", err)
+	}
+	var response api.NodeRewardsLedgerResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeRewardsLedgerResponse{}, fmt.Errorf("Could not decode node rewards ledger response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeRewardsLedgerResponse{}, fmt.Errorf("Could not get node rewards ledger: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get the deposit contract info for Rocket Pool and the Beacon Client
 func (c *Client) DepositContractInfo() (api.DepositContractInfoResponse, error) {
 	responseBytes, err := c.callAPI("node deposit-contract-info")
@@ -1128,6 +1144,54 @@ func (c *Client) CheckCollateral() (api.CheckCollateralResponse, error) {
 	return response, nil
 }
 
+// Get the node's collateral projection against a target ratio (percent of borrowed ETH; 0 for the configured target)
+func (c *Client) GetCollateralProjection(targetRatio float64) (api.NodeCollateralProjectionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node get-collateral-projection %f", targetRatio))
+	if err != nil {
+		return api.NodeCollateralProjectionResponse{}, fmt.Errorf("Could not get collateral projection: %w", err)
+	}
+	var response api.NodeCollateralProjectionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeCollateralProjectionResponse{}, fmt.Errorf("Could not decode collateral projection response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeCollateralProjectionResponse{}, fmt.Errorf("Could not get collateral projection: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the network state snapshots saved by the node daemon
+func (c *Client) NodeSnapshots() (api.NodeSnapshotsResponse, error) {
+	responseBytes, err := c.callAPI("node snapshots")
+	if err != nil {
+		return api.NodeSnapshotsResponse{}, fmt.Errorf("Could not get state snapshots: %w", err)
+	}
+	var response api.NodeSnapshotsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSnapshotsResponse{}, fmt.Errorf("Could not decode state snapshots response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSnapshotsResponse{}, fmt.Errorf("Could not get state snapshots: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the node's status from the latest state snapshot at or before a slot, or a block if it's nonzero
+func (c *Client) NodeSnapshotStatus(slot uint64, block uint64) (api.NodeSnapshotStatusResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node snapshot-status %d %d", slot, block))
+	if err != nil {
+		return api.NodeSnapshotStatusResponse{}, fmt.Errorf("Could not get node snapshot status: %w", err)
+	}
+	var response api.NodeSnapshotStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSnapshotStatusResponse{}, fmt.Errorf("Could not decode node snapshot status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSnapshotStatusResponse{}, fmt.Errorf("Could not get node snapshot status: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get the ETH balance of the node address
 func (c *Client) GetEthBalance() (api.NodeEthBalanceResponse, error) {
 	responseBytes, err := c.callAPI("node get-eth-balance")
@@ -1175,3 +1239,83 @@ func (c *Client) SendMessage(address common.Address, message []byte) (api.NodeSe
 	}
 	return response, nil
 }
+
+// Broadcast a transaction that was signed offline
+func (c *Client) BroadcastTransaction(signedTx string) (api.BroadcastTransactionResponse, error) {
+	responseBytes, err := c.callAPI("node broadcast-tx", signedTx)
+	if err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %w", err)
+	}
+	var response api.BroadcastTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not decode broadcast transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the transactions the daemons have sent that haven't been included yet
+func (c *Client) GetPendingTransactions() (api.PendingTransactionsResponse, error) {
+	responseBytes, err := c.callAPI("node pending-tx")
+	if err != nil {
+		return api.PendingTransactionsResponse{}, fmt.Errorf("Could not get pending transactions: %w", err)
+	}
+	var response api.PendingTransactionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PendingTransactionsResponse{}, fmt.Errorf("Could not decode pending transactions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PendingTransactionsResponse{}, fmt.Errorf("Could not get pending transactions: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Cancel a pending transaction
+func (c *Client) CancelPendingTransaction(nonce uint64) (api.CancelPendingTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node cancel-pending-tx %d", nonce))
+	if err != nil {
+		return api.CancelPendingTransactionResponse{}, fmt.Errorf("Could not cancel pending transaction: %w", err)
+	}
+	var response api.CancelPendingTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CancelPendingTransactionResponse{}, fmt.Errorf("Could not decode cancel pending transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CancelPendingTransactionResponse{}, fmt.Errorf("Could not cancel pending transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the transactions queued for the node daemon to send later
+func (c *Client) GetDeferredTransactions() (api.DeferredTransactionsResponse, error) {
+	responseBytes, err := c.callAPI("node deferred-txs")
+	if err != nil {
+		return api.DeferredTransactionsResponse{}, fmt.Errorf("Could not get deferred transactions: %w", err)
+	}
+	var response api.DeferredTransactionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.DeferredTransactionsResponse{}, fmt.Errorf("Could not decode deferred transactions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.DeferredTransactionsResponse{}, fmt.Errorf("Could not get deferred transactions: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Remove a transaction from the deferred queue
+func (c *Client) CancelDeferredTransaction(id uint64) (api.CancelDeferredTransactionResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node cancel-deferred-tx %d", id))
+	if err != nil {
+		return api.CancelDeferredTransactionResponse{}, fmt.Errorf("Could not cancel deferred transaction: %w", err)
+	}
+	var response api.CancelDeferredTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CancelDeferredTransactionResponse{}, fmt.Errorf("Could not decode cancel deferred transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CancelDeferredTransactionResponse{}, fmt.Errorf("Could not cancel deferred transaction: %s", response.Error)
+	}
+	return response, nil
+}
@@ -0,0 +1,125 @@
+package rocketpool
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Config
+const offlineExportFileMode os.FileMode = 0644
+
+// Returned when waiting for a transaction that was exported for offline signing instead of being sent
+var ErrTransactionExported = errors.New("The transaction was exported for offline signing and has not been sent yet.")
+
+// Returned when waiting for a transaction that was queued for the node daemon instead of being sent
+var ErrTransactionDeferred = errors.New("The transaction was queued for the node daemon and has not been sent yet.")
+
+// Check if the client is exporting transactions for offline signing instead of sending them
+func (c *Client) IsOfflineExport() bool {
+	return c.offlineExportPath != ""
+}
+
+// Get the file that transactions are exported to for offline signing
+func (c *Client) GetOfflineExportPath() string {
+	return c.offlineExportPath
+}
+
+// Check if the client is queueing transactions for the node daemon to send later instead of sending them
+func (c *Client) IsDeferred() bool {
+	return c.deferGasBelow > 0 || !c.deferNotAfter.IsZero()
+}
+
+// Get the conditions deferred transactions wait for
+func (c *Client) GetDeferConditions() (float64, time.Time) {
+	return c.deferGasBelow, c.deferNotAfter
+}
+
+// Get the ID of the transaction that was queued for the node daemon
+func (c *Client) GetDeferredTransactionID() uint64 {
+	return c.deferredID
+}
+
+// Get the flag that tells the API to export transactions instead of sending them
+func (c *Client) getOfflineExportFlag() string {
+	if c.offlineExportPath == "" && !c.IsDeferred() {
+		return ""
+	}
+	return "--offline-export"
+}
+
+// Handle the unsigned transaction attached to an API response, if transactions are being exported or deferred
+func (c *Client) exportTransactions(responseBytes []byte) error {
+	if !c.IsOfflineExport() && !c.IsDeferred() {
+		return nil
+	}
+
+	// Get the exported transactions; responses that can't be decoded are reported by the caller
+	var response struct {
+		api.APIResponse
+		api.OfflineTransactionsResponse
+	}
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return nil
+	}
+	if response.Error != "" || len(response.OfflineTransactions) == 0 {
+		return nil
+	}
+
+	// Later transactions depend on the earlier ones being included, so only the first can be exported
+	if c.IsDeferred() {
+		if c.deferredID != 0 || len(response.OfflineTransactions) > 1 {
+			return fmt.Errorf("This command sends more than one transaction, but only one can be deferred at a time. Wait for deferred transaction %d to be sent, and then run the command again.", c.deferredID)
+		}
+		return c.deferTransaction(response.OfflineTransactions[0])
+	}
+	if c.offlineExported || len(response.OfflineTransactions) > 1 {
+		return fmt.Errorf("This command sends more than one transaction, but only one can be exported at a time. Sign and broadcast the transaction in %s, wait for it to be included in a block, and then run the command again.", c.offlineExportPath)
+	}
+	return c.exportOfflineTransaction(response.OfflineTransactions[0])
+
+}
+
+// Write an unsigned transaction to the export file
+func (c *Client) exportOfflineTransaction(tx api.OfflineTransaction) error {
+	bytes, err := json.MarshalIndent(tx, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Could not encode the unsigned transaction: %w", err)
+	}
+	if err := os.WriteFile(c.offlineExportPath, bytes, offlineExportFileMode); err != nil {
+		return fmt.Errorf("Could not write the unsigned transaction to %s: %w", c.offlineExportPath, err)
+	}
+	c.offlineExported = true
+	return nil
+}
+
+// Queue an unsigned transaction for the node daemon to send once its conditions are met
+func (c *Client) deferTransaction(tx api.OfflineTransaction) error {
+	bytes, err := json.Marshal(tx)
+	if err != nil {
+		return fmt.Errorf("Could not encode the deferred transaction: %w", err)
+	}
+	var notAfter int64
+	if !c.deferNotAfter.IsZero() {
+		notAfter = c.deferNotAfter.Unix()
+	}
+	responseBytes, err := c.callAPI("node defer-tx", string(bytes), strconv.FormatFloat(c.deferGasBelow, 'f', -1, 64), strconv.FormatInt(notAfter, 10), c.deferDescription)
+	if err != nil {
+		return fmt.Errorf("Could not defer transaction: %w", err)
+	}
+	var response api.DeferTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return fmt.Errorf("Could not decode defer transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return fmt.Errorf("Could not defer transaction: %s", response.Error)
+	}
+	c.deferredID = response.ID
+	return nil
+}
@@ -43,6 +43,38 @@ func (c *Client) PDAOProposals() (api.PDAOProposalsResponse, error) {
 	return response, nil
 }
 
+// Evaluate the node's voting policy against the protocol DAO proposals that haven't finished voting
+func (c *Client) PDAOVotingPolicy() (api.PDAOVotingPolicyResponse, error) {
+	responseBytes, err := c.callAPI("pdao voting-policy")
+	if err != nil {
+		return api.PDAOVotingPolicyResponse{}, fmt.Errorf("Could not evaluate protocol DAO voting policy: %w", err)
+	}
+	var response api.PDAOVotingPolicyResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOVotingPolicyResponse{}, fmt.Errorf("Could not decode protocol DAO voting policy response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOVotingPolicyResponse{}, fmt.Errorf("Could not evaluate protocol DAO voting policy: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Audit the roots submitted for a protocol DAO proposal against the local voting trees
+func (c *Client) PDAOAuditProposal(proposalID uint64) (api.PDAOAuditProposalResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("pdao audit-proposal %d", proposalID))
+	if err != nil {
+		return api.PDAOAuditProposalResponse{}, fmt.Errorf("Could not audit protocol DAO proposal: %w", err)
+	}
+	var response api.PDAOAuditProposalResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOAuditProposalResponse{}, fmt.Errorf("Could not decode protocol DAO proposal audit response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOAuditProposalResponse{}, fmt.Errorf("Could not audit protocol DAO proposal: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get protocol DAO proposal details
 func (c *Client) PDAOProposalDetails(proposalID uint64) (api.PDAOProposalResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("pdao proposal-details %d", proposalID))
@@ -702,3 +734,40 @@ func (c *Client) GetVotingPower() (api.GetPDAOVotePowerResponse, error) {
 	}
 	return response, nil
 }
+
+// Simulate the node's voting power at a block with hypothetical changes to its stake, minipools and delegations
+func (c *Client) PDAOSimulateVotingPower(blockNumber uint32, additionalRpl *big.Int, newMinipools uint64, newMinipoolBond *big.Int, bondReductions uint64, newDelegators []common.Address) (api.PDAOSimulateVotingPowerResponse, error) {
+	delegatorStrings := make([]string, len(newDelegators))
+	for i, address := range newDelegators {
+		delegatorStrings[i] = address.Hex()
+	}
+
+	responseBytes, err := c.callAPI(fmt.Sprintf("pdao simulate-voting-power %d %s %d %s %d", blockNumber, additionalRpl.String(), newMinipools, newMinipoolBond.String(), bondReductions), strings.Join(delegatorStrings, ","))
+	if err != nil {
+		return api.PDAOSimulateVotingPowerResponse{}, fmt.Errorf("Could not simulate voting power: %w", err)
+	}
+	var response api.PDAOSimulateVotingPowerResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOSimulateVotingPowerResponse{}, fmt.Errorf("Could not decode simulate voting power response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOSimulateVotingPowerResponse{}, fmt.Errorf("Could not simulate voting power: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the nodes that delegate to this node and the node it delegates to at a block
+func (c *Client) PDAODelegationGraph(blockNumber uint32) (api.PDAODelegationGraphResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("pdao delegation-graph %d", blockNumber))
+	if err != nil {
+		return api.PDAODelegationGraphResponse{}, fmt.Errorf("Could not get delegation graph: %w", err)
+	}
+	var response api.PDAODelegationGraphResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAODelegationGraphResponse{}, fmt.Errorf("Could not decode delegation graph response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAODelegationGraphResponse{}, fmt.Errorf("Could not get delegation graph: %s", response.Error)
+	}
+	return response, nil
+}
@@ -0,0 +1,195 @@
+package rocketpool
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"gopkg.in/yaml.v2"
+)
+
+// Config
+const (
+	NodeProfilesFile         string = "node-profiles.yml"
+	AllNodeProfiles          string = "all"
+	defaultRemoteConfigPath  string = "~/.rocketpool"
+	defaultSshPort           string = "22"
+	defaultKnownHostsPath    string = "~/.ssh/known_hosts"
+	nodeProfilesFileMode            = 0600
+	nodeProfileMetadataKey   string = "node-profile"
+	nodeProfilesMetadataKey  string = "node-profiles"
+	sshAuthSocketEnvVariable string = "SSH_AUTH_SOCK"
+)
+
+// A named remote node the CLI can run commands against over SSH
+type NodeProfile struct {
+	Name       string `yaml:"name" json:"name"`
+	Host       string `yaml:"host" json:"host"`
+	KeyPath    string `yaml:"keyPath,omitempty" json:"keyPath,omitempty"`
+	ConfigPath string `yaml:"configPath,omitempty" json:"configPath,omitempty"`
+	DaemonPath string `yaml:"daemonPath,omitempty" json:"daemonPath,omitempty"`
+}
+
+// Load the node profiles saved in the CLI's config directory
+func LoadNodeProfiles(configPath string) ([]NodeProfile, error) {
+	path, err := homedir.Expand(filepath.Join(configPath, NodeProfilesFile))
+	if err != nil {
+		return nil, fmt.Errorf("error expanding node profiles path: %w", err)
+	}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []NodeProfile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading node profiles from %s: %w", path, err)
+	}
+	profiles := []NodeProfile{}
+	if err := yaml.Unmarshal(bytes, &profiles); err != nil {
+		return nil, fmt.Errorf("error parsing node profiles from %s: %w", path, err)
+	}
+	return profiles, nil
+}
+
+// Save the node profiles to the CLI's config directory
+func SaveNodeProfiles(configPath string, profiles []NodeProfile) error {
+	path, err := homedir.Expand(filepath.Join(configPath, NodeProfilesFile))
+	if err != nil {
+		return fmt.Errorf("error expanding node profiles path: %w", err)
+	}
+	bytes, err := yaml.Marshal(profiles)
+	if err != nil {
+		return fmt.Errorf("error serializing node profiles: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, nodeProfilesFileMode); err != nil {
+		return fmt.Errorf("error saving node profiles to %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get the profiles matching a `--node` selector: a profile name, a comma-separated list of names, or "all"
+func SelectNodeProfiles(profiles []NodeProfile, selector string) ([]NodeProfile, error) {
+	if len(profiles) == 0 {
+		return nil, fmt.Errorf("no node profiles have been added yet; add one with `rocketpool profile add`")
+	}
+	if selector == AllNodeProfiles {
+		return profiles, nil
+	}
+
+	selected := []NodeProfile{}
+	for _, name := range strings.Split(selector, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, profile := range profiles {
+			if profile.Name == name {
+				selected = append(selected, profile)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("there is no node profile named '%s'", name)
+		}
+	}
+	return selected, nil
+}
+
+// Set the profiles a command should run against; the CLI runs each command once per profile
+func SetSelectedNodeProfiles(metadata map[string]interface{}, profiles []NodeProfile) {
+	metadata[nodeProfilesMetadataKey] = profiles
+}
+
+// Get the profiles a command should run against, or nil if it runs against the local node
+func GetSelectedNodeProfiles(metadata map[string]interface{}) []NodeProfile {
+	profiles, _ := metadata[nodeProfilesMetadataKey].([]NodeProfile)
+	return profiles
+}
+
+// Set the profile that clients created for the current command run should connect to
+func SetActiveNodeProfile(metadata map[string]interface{}, profile *NodeProfile) {
+	if profile == nil {
+		delete(metadata, nodeProfileMetadataKey)
+		return
+	}
+	metadata[nodeProfileMetadataKey] = *profile
+}
+
+// Get the remote config path for the profile
+func (p *NodeProfile) getConfigPath() string {
+	if p.ConfigPath == "" {
+		return defaultRemoteConfigPath
+	}
+	return p.ConfigPath
+}
+
+// Connect to the profile's host over SSH, using its key file or the running SSH agent
+func (p *NodeProfile) dial() (*ssh.Client, error) {
+
+	// Split the user from the host
+	user, address, found := strings.Cut(p.Host, "@")
+	if !found {
+		return nil, fmt.Errorf("host '%s' for node profile '%s' must be in the format user@host[:port]", p.Host, p.Name)
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultSshPort)
+	}
+
+	// Get the auth methods
+	authMethods := []ssh.AuthMethod{}
+	if p.KeyPath != "" {
+		keyPath, err := homedir.Expand(p.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error expanding key path for node profile '%s': %w", p.Name, err)
+		}
+		keyBytes, err := os.ReadFile(keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading key for node profile '%s': %w", p.Name, err)
+		}
+		signer, err := ssh.ParsePrivateKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing key for node profile '%s' (passphrase-protected keys must be loaded into ssh-agent instead): %w", p.Name, err)
+		}
+		authMethods = append(authMethods, ssh.PublicKeys(signer))
+	}
+	if socket := os.Getenv(sshAuthSocketEnvVariable); socket != "" {
+		conn, err := net.Dial("unix", socket)
+		if err == nil {
+			authMethods = append(authMethods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+	if len(authMethods) == 0 {
+		return nil, fmt.Errorf("node profile '%s' has no key file and no SSH agent is running", p.Name)
+	}
+
+	// Only connect to known hosts
+	knownHostsPath, err := homedir.Expand(defaultKnownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding known hosts path: %w", err)
+	}
+	hostKeyCallback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading known hosts from %s (connect to the node with ssh once to add it): %w", knownHostsPath, err)
+	}
+
+	// Connect
+	client, err := ssh.Dial("tcp", address, &ssh.ClientConfig{
+		User:            user,
+		Auth:            authMethods,
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to node profile '%s' (%s): %w", p.Name, p.Host, err)
+	}
+	return client, nil
+
+}
+
+// Get a path on the remote host relative to the login directory, since the shell won't expand a quoted ~
+func getRemotePath(path string) string {
+	return strings.TrimPrefix(path, "~/")
+}
@@ -23,6 +23,10 @@ import (
 	nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	w3skeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/web3signer"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/signer"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -36,9 +40,8 @@ var (
 	cfg                *config.RocketPoolConfig
 	passwordManager    *passwords.PasswordManager
 	nodeWallet         *wallet.Wallet
-	ecManager          *ExecutionClientManager
-	bcManager          *BeaconClientManager
-	rocketPool         *rocketpool.RocketPool
+	ecManagers         = map[clientFlags]*ExecutionClientManager{}
+	bcManagers         = map[clientFlags]*BeaconClientManager{}
 	snapshotDelegation *contracts.SnapshotDelegation
 	beaconClient       beacon.Client
 	docker             *client.Client
@@ -46,9 +49,8 @@ var (
 	initCfg                sync.Once
 	initPasswordManager    sync.Once
 	initNodeWallet         sync.Once
-	initECManager          sync.Once
-	initBCManager          sync.Once
-	initRocketPool         sync.Once
+	clientLock             sync.Mutex
+	rocketPoolLock         sync.Mutex
 	initOneInchOracle      sync.Once
 	initSnapshotDelegation sync.Once
 	initBeaconClient       sync.Once
@@ -77,7 +79,22 @@ func GetWallet(c *cli.Context) (*wallet.Wallet, error) {
 		return nil, err
 	}
 	pm := getPasswordManager(cfg)
-	return getWallet(c, cfg, pm)
+	w, err := getWallet(c, cfg, pm)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commands run side by side (e.g. by the API server) take turns with the wallet, and each one uses its own gas flags
+	if lock, ok := c.App.Metadata[walletLockKey].(*WalletLock); ok {
+		lock.acquire.Do(func() {
+			lock.mutex.Lock()
+			lock.locked = true
+			maxFee, maxPriorityFee := getGasSettings(c, cfg)
+			w.SetGasSettings(maxFee, maxPriorityFee, 0)
+			setOfflineExport(c, w)
+		})
+	}
+	return w, nil
 }
 
 func GetEthClient(c *cli.Context) (*ExecutionClientManager, error) {
@@ -97,18 +114,7 @@ func GetRocketPool(c *cli.Context) (*rocketpool.RocketPool, error) {
 	if err != nil {
 		return nil, err
 	}
-	var ec rocketpool.ExecutionClient
-	if c.GlobalBool("use-protected-api") {
-		url := cfg.Smartnode.GetFlashbotsProtectUrl()
-		ec, err = ethclient.Dial(url)
-	} else {
-		ec, err = getEthClient(c, cfg)
-	}
-	if err != nil {
-		return nil, err
-	}
-
-	return getRocketPool(cfg, ec)
+	return getRocketPool(c, cfg)
 }
 
 func GetSnapshotDelegation(c *cli.Context) (*contracts.SnapshotDelegation, error) {
@@ -139,6 +145,38 @@ func GetDocker(c *cli.Context) (*client.Client, error) {
 	return docker, err
 }
 
+// A lock on the node wallet for a command that runs alongside others in the same process, such as the API server's.
+// The command takes it the first time it gets the wallet and holds it until it finishes, so commands that use the
+// wallet run one at a time while the ones that don't can run whenever they like.
+type WalletLock struct {
+	mutex   *sync.Mutex
+	acquire sync.Once
+	locked  bool
+}
+
+// The metadata key of a command's wallet lock
+const walletLockKey string = "walletLock"
+
+// Give a command's app a lock on the node wallet that it shares with other commands using the same mutex
+func SetWalletLock(app *cli.App, mutex *sync.Mutex) *WalletLock {
+	lock := &WalletLock{
+		mutex: mutex,
+	}
+	if app.Metadata == nil {
+		app.Metadata = map[string]interface{}{}
+	}
+	app.Metadata[walletLockKey] = lock
+	return lock
+}
+
+// Release the wallet if the command took it
+func (l *WalletLock) Release() {
+	if l.locked {
+		l.locked = false
+		l.mutex.Unlock()
+	}
+}
+
 //
 // Service instance getters
 //
@@ -165,28 +203,40 @@ func getPasswordManager(cfg *config.RocketPoolConfig) *passwords.PasswordManager
 func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.PasswordManager) (*wallet.Wallet, error) {
 	var err error
 	initNodeWallet.Do(func() {
-		var maxFee *big.Int
-		maxFeeFloat := c.GlobalFloat64("maxFee")
-		if maxFeeFloat == 0 {
-			maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
-		}
-		if maxFeeFloat != 0 {
-			maxFee = eth.GweiToWei(maxFeeFloat)
-		}
+		maxFee, maxPriorityFee := getGasSettings(c, cfg)
+		chainId := cfg.Smartnode.GetChainID()
 
-		var maxPriorityFee *big.Int
-		maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
-		if maxPriorityFeeFloat == 0 {
-			maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
+		nodeWallet, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm)
+		if err != nil {
+			return
 		}
-		if maxPriorityFeeFloat != 0 {
-			maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
+		setOfflineExport(c, nodeWallet)
+
+		// Watch the node address without any keys if requested
+		if cfg.Smartnode.IsWatchOnly() {
+			nodeWallet.SetWatchOnlyAddress(cfg.Smartnode.GetWatchOnlyAddress())
+			return
 		}
 
-		chainId := cfg.Smartnode.GetChainID()
+		// Back the node account with an external signer if requested
+		if cfg.Smartnode.IsNodeSignerExternal() {
+			signerUrl := cfg.Smartnode.NodeSignerUrl.Value.(string)
+			signerAddress := common.HexToAddress(cfg.Smartnode.NodeSignerAddress.Value.(string))
+			switch cfg.Smartnode.NodeSigner.Value.(cfgtypes.NodeSigner) {
+			case cfgtypes.NodeSigner_Clef:
+				nodeWallet.SetNodeSigner(signer.NewClefSigner(signerUrl, signerAddress))
+			case cfgtypes.NodeSigner_Eip1193:
+				nodeWallet.SetNodeSigner(signer.NewEip1193Signer(signerUrl, signerAddress))
+			default:
+				err = fmt.Errorf("unknown node signer [%v]", cfg.Smartnode.NodeSigner.Value)
+				return
+			}
+		}
 
-		nodeWallet, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm)
-		if err != nil {
+		// Keep validator keys on the remote signer instead of on disk if requested
+		if cfg.Smartnode.IsRemoteSignerEnabled() {
+			web3signerKeystore := w3skeystore.NewKeystore(cfg.Smartnode.Web3SignerUrl.Value.(string), os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()))
+			nodeWallet.AddKeystore("web3signer", web3signerKeystore)
 			return
 		}
 
@@ -205,30 +255,108 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 	return nodeWallet, err
 }
 
+// Get the max fee and priority fee from the global flags, falling back to the config
+func getGasSettings(c *cli.Context, cfg *config.RocketPoolConfig) (*big.Int, *big.Int) {
+	var maxFee *big.Int
+	maxFeeFloat := c.GlobalFloat64("maxFee")
+	if maxFeeFloat == 0 {
+		maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
+	}
+	if maxFeeFloat != 0 {
+		maxFee = eth.GweiToWei(maxFeeFloat)
+	}
+
+	var maxPriorityFee *big.Int
+	maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
+	if maxPriorityFeeFloat == 0 {
+		maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
+	}
+	if maxPriorityFeeFloat != 0 {
+		maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
+	}
+
+	return maxFee, maxPriorityFee
+}
+
+// Have the wallet export the node account's transactions with the API response instead of sending them if requested
+func setOfflineExport(c *cli.Context, w *wallet.Wallet) {
+	if c.GlobalBool("offline-export") {
+		w.SetOfflineExport(apiutils.GetResponsePrinter(c).AddOfflineTransaction)
+	} else {
+		w.SetOfflineExport(nil)
+	}
+}
+
+// The global flags that change how the client managers pick a client
+type clientFlags struct {
+	ignoreSyncCheck bool
+	forceFallbacks  bool
+}
+
+// Get the client flags of a command; commands with different flags get their own client managers, so one command
+// forcing the fallbacks doesn't send the others to them too
+func getClientFlags(c *cli.Context) clientFlags {
+	return clientFlags{
+		// Set by the CLI when it already checked the sync status or knows the primary EC / CC is offline
+		ignoreSyncCheck: c.GlobalBool("ignore-sync-check"),
+		forceFallbacks:  c.GlobalBool("force-fallbacks"),
+	}
+}
+
 func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClientManager, error) {
-	var err error
-	initECManager.Do(func() {
-		// Create a new client manager
-		ecManager, err = NewExecutionClientManager(cfg)
-		if err == nil {
-			// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
-			if c.GlobalBool("ignore-sync-check") {
-				ecManager.ignoreSyncCheck = true
-			}
-			if c.GlobalBool("force-fallbacks") {
-				ecManager.primaryReady = false
-			}
-		}
-	})
-	return ecManager, err
+	clientLock.Lock()
+	defer clientLock.Unlock()
+
+	flags := getClientFlags(c)
+	if ecManager, exists := ecManagers[flags]; exists {
+		return ecManager, nil
+	}
+
+	// Create a new client manager
+	ecManager, err := NewExecutionClientManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+	ecManager.ignoreSyncCheck = flags.ignoreSyncCheck
+	if flags.forceFallbacks {
+		ecManager.primaryReady = false
+	}
+	ecManagers[flags] = ecManager
+	return ecManager, nil
 }
 
-func getRocketPool(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*rocketpool.RocketPool, error) {
+// The metadata key of a command's Rocket Pool manager
+const rocketPoolKey string = "rocketPool"
+
+func getRocketPool(c *cli.Context, cfg *config.RocketPoolConfig) (*rocketpool.RocketPool, error) {
+	rocketPoolLock.Lock()
+	defer rocketPoolLock.Unlock()
+
+	// Each command gets its own manager, so the gas estimates it records aren't mixed up with those of other commands
+	if rocketPool, ok := c.App.Metadata[rocketPoolKey].(*rocketpool.RocketPool); ok {
+		return rocketPool, nil
+	}
+
+	var ec rocketpool.ExecutionClient
 	var err error
-	initRocketPool.Do(func() {
-		rocketPool, err = rocketpool.NewRocketPool(client, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
-	})
-	return rocketPool, err
+	if c.GlobalBool("use-protected-api") {
+		url := cfg.Smartnode.GetFlashbotsProtectUrl()
+		ec, err = ethclient.Dial(url)
+	} else {
+		ec, err = getEthClient(c, cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rocketPool, err := rocketpool.NewRocketPool(&estimateRecorder{ExecutionClient: ec}, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+	if err != nil {
+		return nil, err
+	}
+	if c.App.Metadata == nil {
+		c.App.Metadata = map[string]interface{}{}
+	}
+	c.App.Metadata[rocketPoolKey] = rocketPool
+	return rocketPool, nil
 }
 
 func getSnapshotDelegation(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*contracts.SnapshotDelegation, error) {
@@ -243,19 +371,23 @@ func getSnapshotDelegation(cfg *config.RocketPoolConfig, client rocketpool.Execu
 }
 
 func getBeaconClient(c *cli.Context, cfg *config.RocketPoolConfig) (*BeaconClientManager, error) {
-	var err error
-	initBCManager.Do(func() {
-		// Create a new client manager
-		bcManager, err = NewBeaconClientManager(cfg)
-		if err == nil {
-			// Check if the manager should ignore sync checks and/or default to using the fallback (used by the API container when driven by the CLI)
-			if c.GlobalBool("ignore-sync-check") {
-				bcManager.ignoreSyncCheck = true
-			}
-			if c.GlobalBool("force-fallbacks") {
-				bcManager.primaryReady = false
-			}
-		}
-	})
-	return bcManager, err
+	clientLock.Lock()
+	defer clientLock.Unlock()
+
+	flags := getClientFlags(c)
+	if bcManager, exists := bcManagers[flags]; exists {
+		return bcManager, nil
+	}
+
+	// Create a new client manager
+	bcManager, err := NewBeaconClientManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+	bcManager.ignoreSyncCheck = flags.ignoreSyncCheck
+	if flags.forceFallbacks {
+		bcManager.primaryReady = false
+	}
+	bcManagers[flags] = bcManager
+	return bcManager, nil
 }
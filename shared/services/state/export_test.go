@@ -0,0 +1,28 @@
+package state
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/goccy/go-json"
+)
+
+// Do a full refresh of the updater's state
+func (u *IncrementalStateUpdater) RefreshState(slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	return u.refreshState(slotNumber, calculateTotalEffectiveStake)
+}
+
+// Update the updater's state incrementally, without falling back to a full refresh
+func (u *IncrementalStateUpdater) UpdateState(slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	return u.updateState(slotNumber, calculateTotalEffectiveStake)
+}
+
+// Serialize a state with its validators in a consistent order
+func SerializeState(state *NetworkState) ([]byte, error) {
+	snapshot := state.toSnapshot()
+	sort.Slice(snapshot.ValidatorDetails, func(i, j int) bool {
+		return bytes.Compare(snapshot.ValidatorDetails[i].Pubkey[:], snapshot.ValidatorDetails[j].Pubkey[:]) < 0
+	})
+	return json.Marshal(snapshot)
+}
@@ -0,0 +1,557 @@
+package state
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+const (
+	incrementalFullRefreshInterval  time.Duration = 6 * time.Hour
+	incrementalMaxBlockRange        uint64        = 7200
+	incrementalReorgBuffer          uint64        = 64
+	incrementalMinipoolLogBatchSize int           = 1000
+	incrementalNodeLogBatchSize     int           = 1000
+)
+
+// The ERC-20 Transfer event, which is the only token event that can change a node's details
+var transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// The Rocket Pool contracts that are watched for events, grouped by how their events are filtered
+type eventContracts struct {
+	// Every event from these is checked
+	network []common.Address
+
+	// Only transfers to or from the nodes are checked for these
+	tokens []common.Address
+
+	// Every event from these is checked, and the ones that don't name a minipool change all of them
+	penalties []common.Address
+}
+
+// Get all of the watched contract addresses
+func (c *eventContracts) all() []common.Address {
+	addresses := make([]common.Address, 0, len(c.network)+len(c.tokens)+len(c.penalties))
+	addresses = append(addresses, c.network...)
+	addresses = append(addresses, c.tokens...)
+	return append(addresses, c.penalties...)
+}
+
+// Keeps a network state up to date by only refreshing the nodes and minipools that changed since the last update.
+// Changes are found from the events emitted by the Rocket Pool contracts and minipools, and from the ETH balances of
+// nodes, minipools and fee distributors since those can change without an event. A full refresh is done periodically,
+// and whenever the state can't be updated safely (e.g. after a contract upgrade or a long gap between updates).
+type IncrementalStateUpdater struct {
+	m                 *NetworkStateManager
+	nodeAddresses     []common.Address
+	state             *NetworkState
+	contractAddresses *eventContracts
+	lastFullRefresh   time.Time
+}
+
+// Create a new incremental updater for the whole network, or only for the provided nodes if they aren't nil
+func NewIncrementalStateUpdater(m *NetworkStateManager, nodeAddresses []common.Address) *IncrementalStateUpdater {
+	return &IncrementalStateUpdater{
+		m:             m,
+		nodeAddresses: nodeAddresses,
+	}
+}
+
+// Get the state of the network at the provided Beacon slot, along with the total effective RPL stake for the network if requested.
+// The total effective stake is only available when the updater is limited to specific nodes.
+func (u *IncrementalStateUpdater) Update(slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	if u.state != nil && slotNumber >= u.state.BeaconSlotNumber && time.Since(u.lastFullRefresh) < incrementalFullRefreshInterval {
+		state, totalEffectiveStake, err := u.updateState(slotNumber, calculateTotalEffectiveStake)
+		if err == nil {
+			u.state = state
+			return state, totalEffectiveStake, nil
+		}
+		u.logLine("Couldn't update the network state incrementally, doing a full refresh instead: %s", err.Error())
+	}
+	return u.refreshState(slotNumber, calculateTotalEffectiveStake)
+}
+
+// Rebuild the whole state from scratch
+func (u *IncrementalStateUpdater) refreshState(slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	m := u.m
+	var state *NetworkState
+	var totalEffectiveStake *big.Int
+	var err error
+	if u.nodeAddresses == nil {
+		state, err = CreateNetworkState(m.cfg, m.rp, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig)
+	} else {
+		state, totalEffectiveStake, err = CreateNetworkStateForNodes(m.cfg, m.rp, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig, u.nodeAddresses, calculateTotalEffectiveStake)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Record the contract addresses so upgrades can be detected
+	contracts, err := u.getContracts(state.ElBlockNumber, state.IsHoustonDeployed)
+	if err != nil {
+		return nil, nil, err
+	}
+	u.contractAddresses, err = u.getEventContracts(contracts, state.ElBlockNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	u.state = state
+	u.lastFullRefresh = time.Now()
+	return state, totalEffectiveStake, nil
+}
+
+// Create a new state from the last one, only refreshing what changed since then
+func (u *IncrementalStateUpdater) updateState(slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	m := u.m
+	previous := u.state
+
+	// Get the execution block for the given slot
+	beaconBlock, exists, err := m.bc.GetBeaconBlock(fmt.Sprintf("%d", slotNumber))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting Beacon block for slot %d: %w", slotNumber, err)
+	}
+	if !exists {
+		return nil, nil, fmt.Errorf("slot %d did not have a Beacon block", slotNumber)
+	}
+	elBlockNumber := beaconBlock.ExecutionBlockNumber
+	if elBlockNumber < previous.ElBlockNumber || elBlockNumber-previous.ElBlockNumber > incrementalMaxBlockRange {
+		return nil, nil, fmt.Errorf("block %d is too far from the last update at block %d", elBlockNumber, previous.ElBlockNumber)
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(elBlockNumber),
+	}
+
+	// Make sure the contracts haven't changed
+	isHoustonDeployed, err := IsHoustonDeployed(m.rp, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error checking if Houston is deployed: %w", err)
+	}
+	if isHoustonDeployed != previous.IsHoustonDeployed {
+		return nil, nil, fmt.Errorf("Houston was deployed since the last update")
+	}
+	contracts, err := u.getContracts(elBlockNumber, isHoustonDeployed)
+	if err != nil {
+		return nil, nil, err
+	}
+	contractAddresses, err := u.getEventContracts(contracts, elBlockNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !slices.Equal(contractAddresses.all(), u.contractAddresses.all()) {
+		return nil, nil, fmt.Errorf("the Rocket Pool contracts were upgraded since the last update")
+	}
+
+	// Create the state wrapper
+	state := &NetworkState{
+		BeaconSlotNumber:  slotNumber,
+		ElBlockNumber:     elBlockNumber,
+		BeaconConfig:      m.BeaconConfig,
+		log:               m.log,
+		IsHoustonDeployed: isHoustonDeployed,
+	}
+	state.logLine("Updating network state for EL block %d, Beacon slot %d from block %d", elBlockNumber, slotNumber, previous.ElBlockNumber)
+	start := time.Now()
+
+	// Network details
+	state.NetworkDetails, err = rpstate.NewNetworkDetails(m.rp, contracts, isHoustonDeployed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting network details: %w", err)
+	}
+
+	// Get the nodes to include, adding any that registered since the last update
+	nodeAddresses := make([]common.Address, len(previous.NodeDetails))
+	for i, details := range previous.NodeDetails {
+		nodeAddresses[i] = details.NodeAddress
+	}
+	changedNodes := map[common.Address]bool{}
+	if u.nodeAddresses == nil {
+		nodeCount, err := node.GetNodeCount(m.rp, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting node count: %w", err)
+		}
+		if nodeCount < uint64(len(nodeAddresses)) {
+			return nil, nil, fmt.Errorf("the node count dropped from %d to %d since the last update", len(nodeAddresses), nodeCount)
+		}
+		for i := uint64(len(nodeAddresses)); i < nodeCount; i++ {
+			address, err := node.GetNodeAt(m.rp, i, opts)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error getting address of node %d: %w", i, err)
+			}
+			nodeAddresses = append(nodeAddresses, address)
+			changedNodes[address] = true
+		}
+	}
+
+	// Find the nodes that changed since the last update
+	err = u.addNodesFromEvents(previous, contractAddresses, elBlockNumber, changedNodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = u.addNodesFromBalances(previous, contracts, opts, changedNodes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Node details are derived from the RPL price and collateral limits, so every node needs to be refreshed if those changed
+	refreshAllNodes := state.NetworkDetails.RplPrice.Cmp(previous.NetworkDetails.RplPrice) != 0 ||
+		state.NetworkDetails.MinCollateralFraction.Cmp(previous.NetworkDetails.MinCollateralFraction) != 0 ||
+		state.NetworkDetails.MaxCollateralFraction.Cmp(previous.NetworkDetails.MaxCollateralFraction) != 0
+	state.logLine("1/4 - Found %d changed node(s) (%s so far)", len(changedNodes), time.Since(start))
+
+	// Node details
+	if refreshAllNodes && u.nodeAddresses == nil {
+		state.NodeDetails, err = rpstate.GetAllNativeNodeDetails(m.rp, contracts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting all node details: %w", err)
+		}
+	} else {
+		state.NodeDetails = make([]rpstate.NativeNodeDetails, len(nodeAddresses))
+		for i, nodeAddress := range nodeAddresses {
+			if !refreshAllNodes && !changedNodes[nodeAddress] {
+				state.NodeDetails[i] = *previous.NodeDetailsByAddress[nodeAddress]
+				continue
+			}
+			state.NodeDetails[i], err = rpstate.GetNativeNodeDetails(m.rp, contracts, nodeAddress)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error getting details for node %s: %w", nodeAddress.Hex(), err)
+			}
+		}
+	}
+
+	// Minipool details
+	state.MinipoolDetails = []rpstate.NativeMinipoolDetails{}
+	refreshedMinipools := map[common.Address]bool{}
+	for _, nodeAddress := range nodeAddresses {
+		if !changedNodes[nodeAddress] {
+			for _, mpd := range previous.MinipoolDetailsByNode[nodeAddress] {
+				state.MinipoolDetails = append(state.MinipoolDetails, *mpd)
+			}
+			continue
+		}
+		minipoolDetails, err := rpstate.GetNodeNativeMinipoolDetails(m.rp, contracts, nodeAddress)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting minipool details for node %s: %w", nodeAddress.Hex(), err)
+		}
+		for _, mpd := range minipoolDetails {
+			refreshedMinipools[mpd.MinipoolAddress] = true
+		}
+		state.MinipoolDetails = append(state.MinipoolDetails, minipoolDetails...)
+	}
+	state.logLine("2/4 - Retrieved details for changed nodes and minipools (%s so far)", time.Since(start))
+
+	// Create the lookups and calculate avg node fees and distributor shares for the refreshed nodes
+	state.createLookups()
+	for _, details := range state.NodeDetails {
+		if refreshAllNodes || changedNodes[details.NodeAddress] {
+			rpstate.CalculateAverageFeeAndDistributorShares(m.rp, contracts, details, state.MinipoolDetailsByNode[details.NodeAddress])
+		}
+	}
+
+	// Oracle DAO member details and Protocol DAO proposals are cheap enough to get every time
+	if u.nodeAddresses == nil {
+		state.OracleDaoMemberDetails, err = rpstate.GetAllOracleDaoMemberDetails(m.rp, contracts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting Oracle DAO details: %w", err)
+		}
+	} else if isHoustonDeployed {
+		state.ProtocolDaoProposalDetails, err = rpstate.GetAllProtocolDaoProposalDetails(m.rp, contracts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting Protocol DAO proposal details: %w", err)
+		}
+	}
+
+	// Get the validator statuses, which only change once per epoch
+	state.ValidatorDetails, err = u.getValidatorDetails(previous, state, slotNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	state.logLine("3/4 - Retrieved validator details (%s so far)", time.Since(start))
+
+	// Get the complete node and user shares of the minipools that were refreshed or had their Beacon balance change
+	mpds := []*rpstate.NativeMinipoolDetails{}
+	beaconBalances := []*big.Int{}
+	for i, mpd := range state.MinipoolDetails {
+		validator := state.ValidatorDetails[mpd.Pubkey]
+		previousValidator := previous.ValidatorDetails[mpd.Pubkey]
+		if !refreshedMinipools[mpd.MinipoolAddress] && validator.Exists == previousValidator.Exists && validator.Balance == previousValidator.Balance {
+			continue
+		}
+
+		// The copied shares still point to the previous state's values, so clear them before they're recalculated
+		details := &state.MinipoolDetails[i]
+		details.NodeShareOfBeaconBalance = nil
+		details.UserShareOfBeaconBalance = nil
+		details.NodeShareOfBalanceIncludingBeacon = nil
+		details.UserShareOfBalanceIncludingBeacon = nil
+		mpds = append(mpds, details)
+		if !validator.Exists {
+			beaconBalances = append(beaconBalances, big.NewInt(0))
+		} else {
+			beaconBalances = append(beaconBalances, eth.GweiToWei(float64(validator.Balance)))
+		}
+	}
+	err = rpstate.CalculateCompleteMinipoolShares(m.rp, contracts, mpds, beaconBalances)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Get the total network effective RPL stake
+	var totalEffectiveStake *big.Int
+	if calculateTotalEffectiveStake && u.nodeAddresses != nil {
+		totalEffectiveStake, err = rpstate.GetTotalEffectiveRplStake(m.rp, contracts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error calculating total effective RPL stake for the network: %w", err)
+		}
+	}
+	state.logLine("4/4 - Updated %d of %d nodes and %d of %d minipools (total time: %s)", len(changedNodes), len(state.NodeDetails), len(refreshedMinipools), len(state.MinipoolDetails), time.Since(start))
+
+	return state, totalEffectiveStake, nil
+}
+
+// Flag the nodes that were involved in Rocket Pool events since the last update, either directly or through one of their minipools
+func (u *IncrementalStateUpdater) addNodesFromEvents(previous *NetworkState, contractAddresses *eventContracts, elBlockNumber uint64, changedNodes map[common.Address]bool) error {
+	eventLogInterval, err := u.m.cfg.GetEventLogInterval()
+	if err != nil {
+		return fmt.Errorf("error getting event log interval: %w", err)
+	}
+	intervalSize := big.NewInt(int64(eventLogInterval))
+
+	// Rescan a few blocks before the last update in case they were reorged
+	fromBlock := uint64(0)
+	if previous.ElBlockNumber > incrementalReorgBuffer {
+		fromBlock = previous.ElBlockNumber - incrementalReorgBuffer
+	}
+	startBlock := big.NewInt(0).SetUint64(fromBlock)
+	endBlock := big.NewInt(0).SetUint64(elBlockNumber)
+
+	// Get the logs from the network contracts, including the penalty contracts
+	networkAddresses := append(append([]common.Address{}, contractAddresses.network...), contractAddresses.penalties...)
+	logs, err := eth.GetLogs(u.m.rp, networkAddresses, nil, intervalSize, startBlock, endBlock, nil)
+	if err != nil {
+		return fmt.Errorf("error getting Rocket Pool contract events: %w", err)
+	}
+
+	// Get the token transfers to and from the nodes; the tokens are traded constantly, so their other events aren't worth pulling in
+	nodeTopics := make([]common.Hash, len(previous.NodeDetails))
+	for i, details := range previous.NodeDetails {
+		nodeTopics[i] = common.BytesToHash(details.NodeAddress.Bytes())
+	}
+	for i := 0; i < len(nodeTopics); i += incrementalNodeLogBatchSize {
+		max := i + incrementalNodeLogBatchSize
+		if max > len(nodeTopics) {
+			max = len(nodeTopics)
+		}
+		for _, topicFilter := range [][][]common.Hash{
+			{{transferEventTopic}, nodeTopics[i:max]},
+			{{transferEventTopic}, nil, nodeTopics[i:max]},
+		} {
+			tokenLogs, err := eth.GetLogs(u.m.rp, contractAddresses.tokens, topicFilter, intervalSize, startBlock, endBlock, nil)
+			if err != nil {
+				return fmt.Errorf("error getting token transfer events: %w", err)
+			}
+			logs = append(logs, tokenLogs...)
+		}
+	}
+
+	// Get the logs from the minipools
+	minipoolAddresses := make([]common.Address, len(previous.MinipoolDetails))
+	for i, mpd := range previous.MinipoolDetails {
+		minipoolAddresses[i] = mpd.MinipoolAddress
+	}
+	for i := 0; i < len(minipoolAddresses); i += incrementalMinipoolLogBatchSize {
+		max := i + incrementalMinipoolLogBatchSize
+		if max > len(minipoolAddresses) {
+			max = len(minipoolAddresses)
+		}
+		minipoolLogs, err := eth.GetLogs(u.m.rp, minipoolAddresses[i:max], nil, intervalSize, startBlock, endBlock, nil)
+		if err != nil {
+			return fmt.Errorf("error getting minipool events: %w", err)
+		}
+		logs = append(logs, minipoolLogs...)
+	}
+
+	return addNodesFromLogs(previous, logs, contractAddresses.penalties, changedNodes)
+}
+
+// Flag the emitting minipool and any known node or minipool in the indexed topics of the logs.
+// Penalty events name the minipool in their data instead, and the ones that don't name any (such as a change to the
+// maximum penalty rate) affect every minipool, so they return an error to force a full refresh.
+func addNodesFromLogs(previous *NetworkState, logs []ethtypes.Log, penaltyAddresses []common.Address, changedNodes map[common.Address]bool) error {
+	flag := func(address common.Address) {
+		if _, exists := previous.NodeDetailsByAddress[address]; exists {
+			changedNodes[address] = true
+		} else if mpd, exists := previous.MinipoolDetailsByAddress[address]; exists {
+			changedNodes[mpd.NodeAddress] = true
+		}
+	}
+
+	for _, eventLog := range logs {
+		if mpd, exists := previous.MinipoolDetailsByAddress[eventLog.Address]; exists {
+			changedNodes[mpd.NodeAddress] = true
+		}
+		if len(eventLog.Topics) > 1 {
+			for _, topic := range eventLog.Topics[1:] {
+				flag(common.BytesToAddress(topic.Bytes()))
+			}
+		}
+
+		// Check the data of penalty events for the minipool
+		if !slices.Contains(penaltyAddresses, eventLog.Address) {
+			continue
+		}
+		if len(eventLog.Topics) < 2 {
+			return fmt.Errorf("the minipool penalty settings changed in block %d", eventLog.BlockNumber)
+		}
+		for i := 0; i+common.HashLength <= len(eventLog.Data); i += common.HashLength {
+			flag(common.BytesToAddress(eventLog.Data[i : i+common.HashLength]))
+		}
+	}
+	return nil
+}
+
+// Flag the nodes whose ETH balance, or the balance of their fee distributor or one of their minipools, changed since the last update
+func (u *IncrementalStateUpdater) addNodesFromBalances(previous *NetworkState, contracts *rpstate.NetworkContracts, opts *bind.CallOpts, changedNodes map[common.Address]bool) error {
+	addresses := make([]common.Address, 0, 2*len(previous.NodeDetails)+len(previous.MinipoolDetails))
+	owners := make([]common.Address, 0, cap(addresses))
+	previousBalances := make([]*big.Int, 0, cap(addresses))
+	for _, details := range previous.NodeDetails {
+		addresses = append(addresses, details.NodeAddress, details.FeeDistributorAddress)
+		owners = append(owners, details.NodeAddress, details.NodeAddress)
+		previousBalances = append(previousBalances, details.BalanceETH, details.DistributorBalance)
+	}
+	for _, mpd := range previous.MinipoolDetails {
+		addresses = append(addresses, mpd.MinipoolAddress)
+		owners = append(owners, mpd.NodeAddress)
+		previousBalances = append(previousBalances, mpd.Balance)
+	}
+
+	balances, err := contracts.BalanceBatcher.GetEthBalances(addresses, opts)
+	if err != nil {
+		return fmt.Errorf("error getting ETH balances: %w", err)
+	}
+	for i, balance := range balances {
+		if previousBalances[i] == nil || balance.Cmp(previousBalances[i]) != 0 {
+			changedNodes[owners[i]] = true
+		}
+	}
+	return nil
+}
+
+// Get the validator statuses for the state's minipools, reusing the previous ones if the epoch hasn't changed
+func (u *IncrementalStateUpdater) getValidatorDetails(previous *NetworkState, state *NetworkState, slotNumber uint64) (map[types.ValidatorPubkey]beacon.ValidatorStatus, error) {
+	emptyPubkey := types.ValidatorPubkey{}
+	sameEpoch := slotNumber/u.m.BeaconConfig.SlotsPerEpoch == previous.BeaconSlotNumber/u.m.BeaconConfig.SlotsPerEpoch
+	validatorDetails := map[types.ValidatorPubkey]beacon.ValidatorStatus{}
+	pubkeys := make([]types.ValidatorPubkey, 0, len(state.MinipoolDetails))
+	for _, mpd := range state.MinipoolDetails {
+		if mpd.Pubkey == emptyPubkey {
+			continue
+		}
+		if validator, exists := previous.ValidatorDetails[mpd.Pubkey]; exists && sameEpoch {
+			validatorDetails[mpd.Pubkey] = validator
+			continue
+		}
+		pubkeys = append(pubkeys, mpd.Pubkey)
+	}
+	if len(pubkeys) == 0 {
+		return validatorDetails, nil
+	}
+
+	statusMap, err := u.m.bc.GetValidatorStatuses(pubkeys, &beacon.ValidatorStatusOptions{
+		Slot: &slotNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for pubkey, validator := range statusMap {
+		validatorDetails[pubkey] = validator
+	}
+	return validatorDetails, nil
+}
+
+// Get the network contracts at the provided block
+func (u *IncrementalStateUpdater) getContracts(elBlockNumber uint64, isHoustonDeployed bool) (*rpstate.NetworkContracts, error) {
+	multicallerAddress := common.HexToAddress(u.m.cfg.Smartnode.GetMulticallAddress())
+	balanceBatcherAddress := common.HexToAddress(u.m.cfg.Smartnode.GetBalanceBatcherAddress())
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(elBlockNumber),
+	}
+	contracts, err := rpstate.NewNetworkContracts(u.m.rp, multicallerAddress, balanceBatcherAddress, isHoustonDeployed, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network contracts: %w", err)
+	}
+	return contracts, nil
+}
+
+// Logs a line if the logger is specified
+func (u *IncrementalStateUpdater) logLine(format string, v ...interface{}) {
+	if u.m.log != nil {
+		u.m.log.Printlnf(format, v...)
+	}
+}
+
+// Get the addresses of the contracts to watch for events
+func (u *IncrementalStateUpdater) getEventContracts(contracts *rpstate.NetworkContracts, elBlockNumber uint64) (*eventContracts, error) {
+	addresses := &eventContracts{}
+	for _, contract := range []*rocketpool.Contract{
+		contracts.RocketDAONodeTrusted,
+		contracts.RocketDAONodeTrustedSettingsMinipool,
+		contracts.RocketDAOProtocolSettingsMinipool,
+		contracts.RocketDAOProtocolSettingsNetwork,
+		contracts.RocketDAOProtocolSettingsNode,
+		contracts.RocketDepositPool,
+		contracts.RocketMinipoolManager,
+		contracts.RocketMinipoolQueue,
+		contracts.RocketNetworkBalances,
+		contracts.RocketNetworkFees,
+		contracts.RocketNetworkPrices,
+		contracts.RocketNodeDeposit,
+		contracts.RocketNodeDistributorFactory,
+		contracts.RocketNodeManager,
+		contracts.RocketNodeStaking,
+		contracts.RocketRewardsPool,
+		contracts.RocketSmoothingPool,
+		contracts.RocketStorage,
+		contracts.RocketMinipoolBondReducer,
+		contracts.RocketDAOProtocolProposal,
+		contracts.RocketDAOProtocolVerifier,
+	} {
+		if contract != nil {
+			addresses.network = append(addresses.network, *contract.Address)
+		}
+	}
+	for _, contract := range []*rocketpool.Contract{
+		contracts.RocketTokenRETH,
+		contracts.RocketTokenRPL,
+		contracts.RocketTokenRPLFixedSupply,
+	} {
+		if contract != nil {
+			addresses.tokens = append(addresses.tokens, *contract.Address)
+		}
+	}
+
+	// The penalty contracts aren't part of the network contracts, but they set the penalty rates in the minipool details
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(elBlockNumber),
+	}
+	for _, name := range []string{"rocketMinipoolPenalty", "rocketNetworkPenalties"} {
+		address, err := u.m.rp.GetAddress(name, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s address: %w", name, err)
+		}
+		addresses.penalties = append(addresses.penalties, *address)
+	}
+	return addresses, nil
+}
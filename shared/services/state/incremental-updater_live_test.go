@@ -0,0 +1,109 @@
+package state_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Environment variables for comparing incremental updates against a live network
+const (
+	testNetworkEnvVar = "SMARTNODE_TEST_NETWORK"
+	testEcUrlEnvVar   = "SMARTNODE_TEST_EC_URL"
+	testBnUrlEnvVar   = "SMARTNODE_TEST_BN_URL"
+	testNodesEnvVar   = "SMARTNODE_TEST_NODES"
+)
+
+// The number of slots between the full refresh and the incremental update in the live test
+const testUpdateSlots uint64 = 64
+
+// Checks that an incremental update produces the same state as a full refresh of the same slot.
+// This needs a live network, so it only runs if the Execution and Beacon clients are provided in the environment.
+func TestIncrementalUpdateMatchesFullState(t *testing.T) {
+	ecUrl := os.Getenv(testEcUrlEnvVar)
+	bnUrl := os.Getenv(testBnUrlEnvVar)
+	if ecUrl == "" || bnUrl == "" {
+		t.Skipf("set %s and %s to compare incremental updates against a live network", testEcUrlEnvVar, testBnUrlEnvVar)
+	}
+
+	// Set up the clients
+	cfg := config.NewRocketPoolConfig("", true)
+	if network := os.Getenv(testNetworkEnvVar); network != "" {
+		cfg.ChangeNetwork(cfgtypes.Network(network))
+	}
+	ec, err := ethclient.Dial(ecUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rp, err := rocketpool.NewRocketPool(ec, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bc := client.NewStandardHttpClient(bnUrl)
+	m, err := state.NewNetworkStateManager(rp, cfg, ec, bc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Limit the state to the provided nodes, or use the whole network if there aren't any
+	var nodeAddresses []common.Address
+	if nodes := os.Getenv(testNodesEnvVar); nodes != "" {
+		for _, address := range strings.Split(nodes, ",") {
+			nodeAddresses = append(nodeAddresses, common.HexToAddress(strings.TrimSpace(address)))
+		}
+	}
+
+	// Do a full refresh of an earlier slot, then update it incrementally to the finalized slot
+	target, err := m.GetLatestFinalizedBeaconBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, err := m.GetLatestProposedBeaconBlock(target.Slot - testUpdateSlots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updater := state.NewIncrementalStateUpdater(m, nodeAddresses)
+	if _, _, err := updater.RefreshState(start.Slot, false); err != nil {
+		t.Fatal(err)
+	}
+	updated, _, err := updater.UpdateState(target.Slot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Get the same slot from scratch
+	var expected *state.NetworkState
+	if nodeAddresses == nil {
+		expected, err = state.CreateNetworkState(cfg, rp, ec, bc, nil, target.Slot, m.BeaconConfig)
+	} else {
+		expected, _, err = state.CreateNetworkStateForNodes(cfg, rp, ec, bc, nil, target.Slot, m.BeaconConfig, nodeAddresses, false)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Compare their serialized forms
+	updatedBytes := serializeTestState(t, updated)
+	expectedBytes := serializeTestState(t, expected)
+	if !bytes.Equal(updatedBytes, expectedBytes) {
+		t.Errorf("the incrementally updated state for slot %d doesn't match a full refresh of it", target.Slot)
+	}
+}
+
+// Serialize a state with its validators in a consistent order
+func serializeTestState(t *testing.T, networkState *state.NetworkState) []byte {
+	serialized, err := state.SerializeState(networkState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return serialized
+}
@@ -0,0 +1,72 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+)
+
+func TestAddNodesFromLogs(t *testing.T) {
+	nodeA := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	nodeB := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	nodeC := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	minipoolA := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	minipoolB := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	minipoolC := common.HexToAddress("0xcccccccccccccccccccccccccccccccccccccccc")
+	network := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	penalties := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	oracle := common.HexToAddress("0x6666666666666666666666666666666666666666")
+	eventTopic := common.HexToHash("0x01")
+
+	previous := &NetworkState{
+		NodeDetails: []rpstate.NativeNodeDetails{
+			{NodeAddress: nodeA},
+			{NodeAddress: nodeB},
+			{NodeAddress: nodeC},
+		},
+		MinipoolDetails: []rpstate.NativeMinipoolDetails{
+			{MinipoolAddress: minipoolA, NodeAddress: nodeA},
+			{MinipoolAddress: minipoolB, NodeAddress: nodeB},
+			{MinipoolAddress: minipoolC, NodeAddress: nodeC},
+		},
+	}
+	previous.createLookups()
+
+	// Nodes are flagged from the emitting minipool, from indexed topics, and from the data of penalty events
+	penaltyData := append(common.BytesToHash(minipoolC.Bytes()).Bytes(), common.BigToHash(big.NewInt(1e18)).Bytes()...)
+	logs := []ethtypes.Log{
+		{Address: minipoolA, Topics: []common.Hash{eventTopic}},
+		{Address: network, Topics: []common.Hash{eventTopic, common.BytesToHash(nodeB.Bytes())}},
+		{Address: penalties, Topics: []common.Hash{eventTopic, common.BytesToHash(oracle.Bytes())}, Data: penaltyData},
+	}
+	changedNodes := map[common.Address]bool{}
+	if err := addNodesFromLogs(previous, logs, []common.Address{penalties}, changedNodes); err != nil {
+		t.Fatal(err)
+	}
+	if len(changedNodes) != 3 || !changedNodes[nodeA] || !changedNodes[nodeB] || !changedNodes[nodeC] {
+		t.Errorf("expected all 3 nodes to be flagged, got %v", changedNodes)
+	}
+
+	// Only penalty events have their data checked
+	logs = []ethtypes.Log{
+		{Address: network, Topics: []common.Hash{eventTopic, common.BytesToHash(oracle.Bytes())}, Data: penaltyData},
+	}
+	changedNodes = map[common.Address]bool{}
+	if err := addNodesFromLogs(previous, logs, []common.Address{penalties}, changedNodes); err != nil {
+		t.Fatal(err)
+	}
+	if len(changedNodes) != 0 {
+		t.Errorf("expected no nodes to be flagged, got %v", changedNodes)
+	}
+
+	// A penalty event that doesn't name a minipool changes all of them, which needs a full refresh
+	logs = []ethtypes.Log{
+		{Address: penalties, Topics: []common.Hash{eventTopic}, Data: common.BigToHash(big.NewInt(1e18)).Bytes()},
+	}
+	if err := addNodesFromLogs(previous, logs, []common.Address{penalties}, map[common.Address]bool{}); err == nil {
+		t.Error("expected a penalty settings change to force a full refresh")
+	}
+}
@@ -61,13 +61,13 @@ func (m *NetworkStateManager) GetHeadState() (*NetworkState, error) {
 	return m.getState(targetSlot)
 }
 
-// Get the state of the network for a single node using the latest Execution layer block, along with the total effective RPL stake for the network
-func (m *NetworkStateManager) GetHeadStateForNode(nodeAddress common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+// Get the state of the network for a set of nodes using the latest Execution layer block, along with the total effective RPL stake for the network
+func (m *NetworkStateManager) GetHeadStateForNodes(nodeAddresses []common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
 	targetSlot, err := m.GetHeadSlot()
 	if err != nil {
 		return nil, nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
 	}
-	return m.getStateForNode(nodeAddress, targetSlot, calculateTotalEffectiveStake)
+	return m.getStateForNodes(nodeAddresses, targetSlot, calculateTotalEffectiveStake)
 }
 
 // Get the state of the network at the provided Beacon slot
@@ -138,9 +138,9 @@ func (m *NetworkStateManager) getState(slotNumber uint64) (*NetworkState, error)
 	return state, nil
 }
 
-// Get the state of the network for a specific node only at the provided Beacon slot
-func (m *NetworkStateManager) getStateForNode(nodeAddress common.Address, slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
-	state, totalEffectiveStake, err := CreateNetworkStateForNode(m.cfg, m.rp, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig, nodeAddress, calculateTotalEffectiveStake)
+// Get the state of the network for specific nodes only at the provided Beacon slot
+func (m *NetworkStateManager) getStateForNodes(nodeAddresses []common.Address, slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	state, totalEffectiveStake, err := CreateNetworkStateForNodes(m.cfg, m.rp, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig, nodeAddresses, calculateTotalEffectiveStake)
 	if err != nil {
 		return nil, nil, err
 	}
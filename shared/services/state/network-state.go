@@ -198,9 +198,9 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 	return state, nil
 }
 
-// Creates a snapshot of the Rocket Pool network, but only for a single node
+// Creates a snapshot of the Rocket Pool network, but only for the provided nodes
 // Also gets the total effective RPL stake of the network for convenience since this is required by several node routines
-func CreateNetworkStateForNode(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config, nodeAddress common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+func CreateNetworkStateForNodes(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config, nodeAddresses []common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
 	steps := 5
 	if calculateTotalEffectiveStake {
 		steps++
@@ -260,17 +260,23 @@ func CreateNetworkStateForNode(cfg *config.RocketPoolConfig, rp *rocketpool.Rock
 	state.logLine("1/%d - Retrieved network details (%s so far)", steps, time.Since(start))
 
 	// Node details
-	nodeDetails, err := rpstate.GetNativeNodeDetails(rp, contracts, nodeAddress)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error getting node details: %w", err)
+	state.NodeDetails = make([]rpstate.NativeNodeDetails, len(nodeAddresses))
+	for i, nodeAddress := range nodeAddresses {
+		state.NodeDetails[i], err = rpstate.GetNativeNodeDetails(rp, contracts, nodeAddress)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting details for node %s: %w", nodeAddress.Hex(), err)
+		}
 	}
-	state.NodeDetails = []rpstate.NativeNodeDetails{nodeDetails}
 	state.logLine("2/%d - Retrieved node details (%s so far)", steps, time.Since(start))
 
 	// Minipool details
-	state.MinipoolDetails, err = rpstate.GetNodeNativeMinipoolDetails(rp, contracts, nodeAddress)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error getting all minipool details: %w", err)
+	state.MinipoolDetails = []rpstate.NativeMinipoolDetails{}
+	for _, nodeAddress := range nodeAddresses {
+		minipoolDetails, err := rpstate.GetNodeNativeMinipoolDetails(rp, contracts, nodeAddress)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting minipool details for node %s: %w", nodeAddress.Hex(), err)
+		}
+		state.MinipoolDetails = append(state.MinipoolDetails, minipoolDetails...)
 	}
 	state.logLine("3/%d - Retrieved minipool details (%s so far)", steps, time.Since(start))
 
@@ -494,6 +500,33 @@ func (s *NetworkState) GetEligibleBorrowedEth(node *rpstate.NativeNodeDetails) *
 	return eligibleBorrowedEth
 }
 
+// Get the ETH a node has borrowed and bonded across its unfinalized minipools, treating pending bond reductions as complete
+func (s *NetworkState) GetPendingBorrowedAndBondedEth(nodeAddress common.Address) (*big.Int, *big.Int) {
+	reductionWindowEnd := s.NetworkDetails.BondReductionWindowStart + s.NetworkDetails.BondReductionWindowLength
+	genesisTime := time.Unix(int64(s.BeaconConfig.GenesisTime), 0)
+	blockTime := genesisTime.Add(time.Duration(s.BeaconSlotNumber*s.BeaconConfig.SecondsPerSlot) * time.Second)
+
+	pendingBorrowedEth := big.NewInt(0)
+	pendingBondedEth := big.NewInt(0)
+	for _, mpd := range s.MinipoolDetailsByNode[nodeAddress] {
+		if mpd.Finalised {
+			continue
+		}
+
+		bonded := mpd.NodeDepositBalance
+		reduceBondTime := time.Unix(mpd.ReduceBondTime.Int64(), 0)
+		if mpd.ReduceBondTime.Sign() != 0 &&
+			!mpd.ReduceBondCancelled &&
+			blockTime.Sub(reduceBondTime) <= reductionWindowEnd {
+			bonded = mpd.ReduceBondValue
+		}
+		borrowed := big.NewInt(0).Sub(eth.EthToWei(32), bonded)
+		pendingBorrowedEth.Add(pendingBorrowedEth, borrowed)
+		pendingBondedEth.Add(pendingBondedEth, bonded)
+	}
+	return pendingBorrowedEth, pendingBondedEth
+}
+
 // Calculate the true effective stakes of all nodes in the state, using the validator status
 // on Beacon as a reference for minipool eligibility instead of the EL-based minipool status
 func (s *NetworkState) CalculateTrueEffectiveStakes(scaleByParticipation bool, allowRplForUnstartedValidators bool) (map[common.Address]*big.Int, *big.Int, error) {
@@ -601,6 +634,20 @@ func (s *NetworkState) CalculateTrueEffectiveStakes(scaleByParticipation bool, a
 
 }
 
+// Create the node and minipool lookups from the details
+func (s *NetworkState) createLookups() {
+	s.NodeDetailsByAddress = map[common.Address]*rpstate.NativeNodeDetails{}
+	s.MinipoolDetailsByAddress = map[common.Address]*rpstate.NativeMinipoolDetails{}
+	s.MinipoolDetailsByNode = map[common.Address][]*rpstate.NativeMinipoolDetails{}
+	for i, details := range s.NodeDetails {
+		s.NodeDetailsByAddress[details.NodeAddress] = &s.NodeDetails[i]
+	}
+	for i, details := range s.MinipoolDetails {
+		s.MinipoolDetailsByAddress[details.MinipoolAddress] = &s.MinipoolDetails[i]
+		s.MinipoolDetailsByNode[details.NodeAddress] = append(s.MinipoolDetailsByNode[details.NodeAddress], &s.MinipoolDetails[i])
+	}
+}
+
 // Logs a line if the logger is specified
 func (s *NetworkState) logLine(format string, v ...interface{}) {
 	if s.log != nil {
@@ -0,0 +1,307 @@
+package state
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rocket-pool/rocketpool-go/dao/protocol"
+	"github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// Config
+const (
+	snapshotFilenameFormat  string = "%d-%d.json.zst"
+	snapshotFilenamePattern string = "^(?P<slot>\\d+)\\-(?P<block>\\d+)\\.json\\.zst$"
+	snapshotFileMode               = 0644
+	snapshotDirMode                = 0755
+)
+
+// Info about a snapshot saved in the store
+type SnapshotInfo struct {
+	Slot     uint64 `json:"slot"`
+	Block    uint64 `json:"block"`
+	Filename string `json:"filename"`
+}
+
+// An on-disk store of compressed network state snapshots, keyed by Beacon slot
+type SnapshotStore struct {
+	path          string
+	interval      uint64
+	maxAge        time.Duration
+	maxCount      uint64
+	lastSavedSlot uint64
+	compressor    *zstd.Encoder
+	decompressor  *zstd.Decoder
+	filenameRegex *regexp.Regexp
+	lock          *sync.Mutex
+}
+
+// The serialized form of a network state; the lookup maps are rebuilt when it's loaded
+type networkStateSnapshot struct {
+	IsHoustonDeployed          bool                                  `json:"isHoustonDeployed"`
+	ElBlockNumber              uint64                                `json:"elBlockNumber"`
+	BeaconSlotNumber           uint64                                `json:"beaconSlotNumber"`
+	BeaconConfig               beacon.Eth2Config                     `json:"beaconConfig"`
+	NetworkDetails             *rpstate.NetworkDetails               `json:"networkDetails"`
+	NodeDetails                []rpstate.NativeNodeDetails           `json:"nodeDetails"`
+	MinipoolDetails            []rpstate.NativeMinipoolDetails       `json:"minipoolDetails"`
+	ValidatorDetails           []beacon.ValidatorStatus              `json:"validatorDetails"`
+	OracleDaoMemberDetails     []rpstate.OracleDaoMemberDetails      `json:"oracleDaoMemberDetails"`
+	ProtocolDaoProposalDetails []protocol.ProtocolDaoProposalDetails `json:"protocolDaoProposalDetails"`
+}
+
+// Create a new snapshot store.
+// The interval is the minimum number of slots between saved snapshots; a max age or max count of 0 keeps snapshots forever.
+func NewSnapshotStore(path string, interval uint64, maxAge time.Duration, maxCount uint64) (*SnapshotStore, error) {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd compressor for snapshot store: %w", err)
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating zstd decompressor for snapshot store: %w", err)
+	}
+
+	return &SnapshotStore{
+		path:          path,
+		interval:      interval,
+		maxAge:        maxAge,
+		maxCount:      maxCount,
+		compressor:    encoder,
+		decompressor:  decoder,
+		filenameRegex: regexp.MustCompile(snapshotFilenamePattern),
+		lock:          &sync.Mutex{},
+	}, nil
+}
+
+// Save a snapshot of the state if enough slots have passed since the last one, then apply the retention policies.
+// Returns true if a snapshot was saved.
+func (s *SnapshotStore) Save(state *NetworkState) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Pick up where the store left off after a restart
+	if s.lastSavedSlot == 0 {
+		snapshots, err := s.list()
+		if err != nil {
+			return false, err
+		}
+		if len(snapshots) > 0 {
+			s.lastSavedSlot = snapshots[len(snapshots)-1].Slot
+		}
+	}
+	if s.lastSavedSlot != 0 && state.BeaconSlotNumber < s.lastSavedSlot+s.interval {
+		return false, nil
+	}
+
+	// Serialize and compress the state
+	bytes, err := json.Marshal(state.toSnapshot())
+	if err != nil {
+		return false, fmt.Errorf("error serializing state for slot %d: %w", state.BeaconSlotNumber, err)
+	}
+	compressedBytes := s.compressor.EncodeAll(bytes, make([]byte, 0, len(bytes)))
+
+	// Write it to a temp file first so readers never see a partial snapshot
+	if err := os.MkdirAll(s.path, snapshotDirMode); err != nil {
+		return false, fmt.Errorf("error creating snapshot folder %s: %w", s.path, err)
+	}
+	filename := filepath.Join(s.path, fmt.Sprintf(snapshotFilenameFormat, state.BeaconSlotNumber, state.ElBlockNumber))
+	tempFilename := filename + ".tmp"
+	if err := os.WriteFile(tempFilename, compressedBytes, snapshotFileMode); err != nil {
+		return false, fmt.Errorf("error writing snapshot file %s: %w", tempFilename, err)
+	}
+	if err := os.Rename(tempFilename, filename); err != nil {
+		return false, fmt.Errorf("error moving snapshot file %s into place: %w", filename, err)
+	}
+	s.lastSavedSlot = state.BeaconSlotNumber
+
+	// Drop anything the retention policies no longer cover
+	if err := s.prune(state.BeaconSlotNumber, state.BeaconConfig.SecondsPerSlot); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// Get the snapshots in the store, ordered by slot
+func (s *SnapshotStore) List() ([]SnapshotInfo, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.list()
+}
+
+// Get the latest snapshot taken at or before the given slot
+func (s *SnapshotStore) GetStateAtSlot(slot uint64) (*NetworkState, error) {
+	return s.getLatestState(func(snapshot SnapshotInfo) bool {
+		return snapshot.Slot <= slot
+	}, fmt.Sprintf("slot %d", slot))
+}
+
+// Get the latest snapshot taken at or before the given Execution layer block
+func (s *SnapshotStore) GetStateAtBlock(block uint64) (*NetworkState, error) {
+	return s.getLatestState(func(snapshot SnapshotInfo) bool {
+		return snapshot.Block <= block
+	}, fmt.Sprintf("block %d", block))
+}
+
+// Load the latest snapshot that matches the filter
+func (s *SnapshotStore) getLatestState(filter func(SnapshotInfo) bool, description string) (*NetworkState, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	snapshots, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if filter(snapshots[i]) {
+			return s.load(snapshots[i])
+		}
+	}
+	return nil, fmt.Errorf("there is no state snapshot at or before %s", description)
+}
+
+// Get the snapshots in the store, ordered by slot
+func (s *SnapshotStore) list() ([]SnapshotInfo, error) {
+	entries, err := os.ReadDir(s.path)
+	if os.IsNotExist(err) {
+		return []SnapshotInfo{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot folder %s: %w", s.path, err)
+	}
+
+	snapshots := []SnapshotInfo{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := s.filenameRegex.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		slot, err := strconv.ParseUint(matches[s.filenameRegex.SubexpIndex("slot")], 10, 64)
+		if err != nil {
+			continue
+		}
+		block, err := strconv.ParseUint(matches[s.filenameRegex.SubexpIndex("block")], 10, 64)
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, SnapshotInfo{
+			Slot:     slot,
+			Block:    block,
+			Filename: entry.Name(),
+		})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Slot < snapshots[j].Slot
+	})
+	return snapshots, nil
+}
+
+// Load a snapshot from disk
+func (s *SnapshotStore) load(snapshot SnapshotInfo) (*NetworkState, error) {
+	path := filepath.Join(s.path, snapshot.Filename)
+	compressedBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot file %s: %w", path, err)
+	}
+	bytes, err := s.decompressor.DecodeAll(compressedBytes, []byte{})
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing snapshot file %s: %w", path, err)
+	}
+	serialized := networkStateSnapshot{}
+	if err := json.Unmarshal(bytes, &serialized); err != nil {
+		return nil, fmt.Errorf("error deserializing snapshot file %s: %w", path, err)
+	}
+	return serialized.toNetworkState(), nil
+}
+
+// Delete the snapshots that are older than the max age or past the max count
+func (s *SnapshotStore) prune(currentSlot uint64, secondsPerSlot uint64) error {
+	snapshots, err := s.list()
+	if err != nil {
+		return err
+	}
+
+	for i, snapshot := range snapshots {
+		tooMany := s.maxCount > 0 && uint64(len(snapshots)-i) > s.maxCount
+		tooOld := false
+		if s.maxAge > 0 && snapshot.Slot < currentSlot {
+			age := time.Duration((currentSlot-snapshot.Slot)*secondsPerSlot) * time.Second
+			tooOld = age > s.maxAge
+		}
+		if !tooMany && !tooOld {
+			continue
+		}
+		path := filepath.Join(s.path, snapshot.Filename)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error deleting expired snapshot %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// Get the serializable form of the state
+func (s *NetworkState) toSnapshot() *networkStateSnapshot {
+	validators := make([]beacon.ValidatorStatus, 0, len(s.ValidatorDetails))
+	for pubkey, validator := range s.ValidatorDetails {
+		validator.Pubkey = pubkey
+		validators = append(validators, validator)
+	}
+	return &networkStateSnapshot{
+		IsHoustonDeployed:          s.IsHoustonDeployed,
+		ElBlockNumber:              s.ElBlockNumber,
+		BeaconSlotNumber:           s.BeaconSlotNumber,
+		BeaconConfig:               s.BeaconConfig,
+		NetworkDetails:             s.NetworkDetails,
+		NodeDetails:                s.NodeDetails,
+		MinipoolDetails:            s.MinipoolDetails,
+		ValidatorDetails:           validators,
+		OracleDaoMemberDetails:     s.OracleDaoMemberDetails,
+		ProtocolDaoProposalDetails: s.ProtocolDaoProposalDetails,
+	}
+}
+
+// Rebuild a network state and its lookups from its serialized form
+func (s *networkStateSnapshot) toNetworkState() *NetworkState {
+	state := &NetworkState{
+		IsHoustonDeployed:          s.IsHoustonDeployed,
+		ElBlockNumber:              s.ElBlockNumber,
+		BeaconSlotNumber:           s.BeaconSlotNumber,
+		BeaconConfig:               s.BeaconConfig,
+		NetworkDetails:             s.NetworkDetails,
+		NodeDetails:                s.NodeDetails,
+		MinipoolDetails:            s.MinipoolDetails,
+		ValidatorDetails:           map[types.ValidatorPubkey]beacon.ValidatorStatus{},
+		OracleDaoMemberDetails:     s.OracleDaoMemberDetails,
+		ProtocolDaoProposalDetails: s.ProtocolDaoProposalDetails,
+	}
+	state.createLookups()
+	for _, validator := range s.ValidatorDetails {
+		state.ValidatorDetails[validator.Pubkey] = validator
+	}
+	return state
+}
+
+// Create a snapshot store with the Smartnode's configured path and retention policies
+func NewSnapshotStoreFromConfig(cfg *config.RocketPoolConfig) (*SnapshotStore, error) {
+	retentionDays := cfg.Smartnode.StateSnapshotRetentionDays.Value.(uint64)
+	return NewSnapshotStore(
+		cfg.Smartnode.GetStateSnapshotPath(),
+		cfg.Smartnode.StateSnapshotInterval.Value.(uint64),
+		time.Duration(retentionDays)*24*time.Hour,
+		cfg.Smartnode.StateSnapshotMaxCount.Value.(uint64),
+	)
+}
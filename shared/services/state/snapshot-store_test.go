@@ -0,0 +1,108 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func newTestState(slot uint64, rplStake int64) *NetworkState {
+	nodeAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	pubkey := types.ValidatorPubkey{0x01}
+	return &NetworkState{
+		ElBlockNumber:    slot + 1000,
+		BeaconSlotNumber: slot,
+		BeaconConfig:     beacon.Eth2Config{SecondsPerSlot: 12},
+		NetworkDetails:   &rpstate.NetworkDetails{RplPrice: big.NewInt(1)},
+		NodeDetails: []rpstate.NativeNodeDetails{{
+			NodeAddress: nodeAddress,
+			RplStake:    big.NewInt(rplStake),
+		}},
+		MinipoolDetails: []rpstate.NativeMinipoolDetails{{
+			MinipoolAddress: common.HexToAddress("0x2222222222222222222222222222222222222222"),
+			NodeAddress:     nodeAddress,
+			Pubkey:          pubkey,
+		}},
+		ValidatorDetails: map[types.ValidatorPubkey]beacon.ValidatorStatus{
+			pubkey: {Pubkey: pubkey, Index: "42", Exists: true},
+		},
+	}
+}
+
+func TestSnapshotStore(t *testing.T) {
+	store, err := NewSnapshotStore(t.TempDir(), 10, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Snapshots closer together than the interval are skipped
+	for _, slot := range []uint64{100, 105, 110, 120, 130} {
+		if _, err := store.Save(newTestState(slot, int64(slot))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only the latest 3 of the 4 saved snapshots are kept
+	snapshots, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 3 || snapshots[0].Slot != 110 || snapshots[2].Slot != 130 {
+		t.Fatalf("unexpected snapshots: %v", snapshots)
+	}
+
+	// Lookups return the latest snapshot at or before the target, with its lookups rebuilt
+	state, err := store.GetStateAtSlot(125)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.BeaconSlotNumber != 120 {
+		t.Errorf("expected the snapshot for slot 120, got %d", state.BeaconSlotNumber)
+	}
+	node := state.NodeDetailsByAddress[common.HexToAddress("0x1111111111111111111111111111111111111111")]
+	if node == nil || node.RplStake.Int64() != 120 {
+		t.Error("node lookup wasn't rebuilt from the snapshot")
+	}
+	minipools := state.MinipoolDetailsByNode[node.NodeAddress]
+	if len(minipools) != 1 || state.ValidatorDetails[minipools[0].Pubkey].Index != "42" {
+		t.Error("minipool and validator lookups weren't rebuilt from the snapshot")
+	}
+
+	state, err = store.GetStateAtBlock(1115)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.BeaconSlotNumber != 110 {
+		t.Errorf("expected the snapshot for block 1110, got slot %d", state.BeaconSlotNumber)
+	}
+
+	if _, err := store.GetStateAtSlot(50); err == nil {
+		t.Error("expected an error for a slot before the first snapshot")
+	}
+}
+
+func TestSnapshotStoreMaxAge(t *testing.T) {
+	// 12 second slots, so an hour is 300 slots
+	store, err := NewSnapshotStore(t.TempDir(), 1, time.Hour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, slot := range []uint64{1000, 1200, 1400} {
+		if _, err := store.Save(newTestState(slot, 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snapshots, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 2 || snapshots[0].Slot != 1200 {
+		t.Fatalf("expected the snapshot for slot 1000 to expire, got %v", snapshots)
+	}
+}
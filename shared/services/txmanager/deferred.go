@@ -0,0 +1,135 @@
+package txmanager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+)
+
+// The lifecycle of a deferred transaction
+type DeferredTransactionStatus string
+
+const (
+	DeferredTransactionStatus_Queued    DeferredTransactionStatus = "queued"
+	DeferredTransactionStatus_Sent      DeferredTransactionStatus = "sent"
+	DeferredTransactionStatus_Completed DeferredTransactionStatus = "completed"
+	DeferredTransactionStatus_Failed    DeferredTransactionStatus = "failed"
+)
+
+// A transaction built by the CLI that the node daemon will send once its conditions are met.
+// The daemon sets the nonce and fees when it sends it.
+type DeferredTransaction struct {
+	ID               uint64                    `json:"id"`
+	Description      string                    `json:"description"`
+	From             common.Address            `json:"from"`
+	Tx               *types.Transaction        `json:"tx"`
+	WhenGasBelowGwei float64                   `json:"whenGasBelowGwei"`
+	NotAfter         time.Time                 `json:"notAfter"`
+	QueuedTime       time.Time                 `json:"queuedTime"`
+	Status           DeferredTransactionStatus `json:"status"`
+	SentTime         time.Time                 `json:"sentTime"`
+	TxHash           common.Hash               `json:"txHash"`
+	Nonce            uint64                    `json:"nonce"`
+	Error            string                    `json:"error"`
+}
+
+// Check if a queued transaction should be sent, given the network's current base fee
+func (t *DeferredTransaction) IsReady(baseFeeGwei float64, now time.Time) bool {
+	if t.Status != DeferredTransactionStatus_Queued {
+		return false
+	}
+	if !t.NotAfter.IsZero() && !now.Before(t.NotAfter) {
+		return true
+	}
+	return t.WhenGasBelowGwei > 0 && baseFeeGwei < t.WhenGasBelowGwei
+}
+
+// Load the deferred transactions, returning an empty list if there aren't any yet
+func LoadDeferredTransactions(path string) ([]DeferredTransaction, error) {
+	txs := []DeferredTransaction{}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return txs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading deferred transactions from %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, &txs); err != nil {
+		return nil, fmt.Errorf("error decoding deferred transactions from %s: %w", path, err)
+	}
+	return txs, nil
+}
+
+// Add a transaction to the deferred queue and return its ID
+func AddDeferredTransaction(path string, tx DeferredTransaction) (uint64, error) {
+	txs, err := LoadDeferredTransactions(path)
+	if err != nil {
+		return 0, err
+	}
+	tx.ID = 1
+	for _, existing := range txs {
+		if existing.ID >= tx.ID {
+			tx.ID = existing.ID + 1
+		}
+	}
+	tx.Status = DeferredTransactionStatus_Queued
+	tx.QueuedTime = time.Now()
+	txs = append(txs, tx)
+	return tx.ID, saveDeferredTransactions(path, txs)
+}
+
+// Modify a deferred transaction in place
+func UpdateDeferredTransaction(path string, id uint64, update func(tx *DeferredTransaction)) error {
+	txs, err := LoadDeferredTransactions(path)
+	if err != nil {
+		return err
+	}
+	for i := range txs {
+		if txs[i].ID == id {
+			update(&txs[i])
+			return saveDeferredTransactions(path, txs)
+		}
+	}
+	return fmt.Errorf("deferred transaction %d does not exist", id)
+}
+
+// Remove a deferred transaction
+func RemoveDeferredTransaction(path string, id uint64) error {
+	txs, err := LoadDeferredTransactions(path)
+	if err != nil {
+		return err
+	}
+	for i := range txs {
+		if txs[i].ID == id {
+			txs = append(txs[:i], txs[i+1:]...)
+			return saveDeferredTransactions(path, txs)
+		}
+	}
+	return fmt.Errorf("deferred transaction %d does not exist", id)
+}
+
+// Write the deferred transactions to disk
+func saveDeferredTransactions(path string, txs []DeferredTransaction) error {
+	bytes, err := json.MarshalIndent(txs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding deferred transactions: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), journalDirMode); err != nil {
+		return fmt.Errorf("error creating deferred transaction folder: %w", err)
+	}
+
+	// The CLI and the daemon both write this file, so replace it atomically
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, bytes, journalFileMode); err != nil {
+		return fmt.Errorf("error writing deferred transactions: %w", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		return fmt.Errorf("error saving deferred transactions: %w", err)
+	}
+	return nil
+}
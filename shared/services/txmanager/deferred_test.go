@@ -0,0 +1,37 @@
+package txmanager
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeferredTransactionIsReady(t *testing.T) {
+	now := time.Now()
+
+	// Waits for the gas target
+	tx := DeferredTransaction{Status: DeferredTransactionStatus_Queued, WhenGasBelowGwei: 10}
+	if tx.IsReady(12, now) {
+		t.Error("expected the transaction to wait while gas is above the target")
+	}
+	if !tx.IsReady(9, now) {
+		t.Error("expected the transaction to be ready once gas is below the target")
+	}
+
+	// The deadline overrides the gas target
+	tx.NotAfter = now.Add(-time.Minute)
+	if !tx.IsReady(12, now) {
+		t.Error("expected the transaction to be ready once its deadline passed")
+	}
+
+	// A deadline on its own schedules the transaction
+	tx = DeferredTransaction{Status: DeferredTransactionStatus_Queued, NotAfter: now.Add(time.Hour)}
+	if tx.IsReady(1, now) {
+		t.Error("expected the transaction to wait for its scheduled time")
+	}
+
+	// Transactions that were already sent are never ready again
+	tx.Status = DeferredTransactionStatus_Sent
+	if tx.IsReady(1, now.Add(2*time.Hour)) {
+		t.Error("expected a sent transaction not to be ready")
+	}
+}
@@ -0,0 +1,180 @@
+package txmanager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+)
+
+// Config
+const (
+	journalDirMode  os.FileMode = 0755
+	journalFileMode os.FileMode = 0644
+)
+
+// A transaction the daemon has sent that hasn't been included in a block yet.
+// Every version of it (the original and its fee-bumped replacements) shares the same nonce.
+type JournalEntry struct {
+	From      common.Address     `json:"from"`
+	Nonce     uint64             `json:"nonce"`
+	Hashes    []common.Hash      `json:"hashes"`
+	Tx        *types.Transaction `json:"tx"`
+	FirstSent time.Time          `json:"firstSent"`
+	LastSent  time.Time          `json:"lastSent"`
+}
+
+// The on-disk record of a daemon's pending transactions, so they can still be watched after a restart
+type Journal struct {
+	path    string
+	entries []*JournalEntry
+	lock    sync.Mutex
+}
+
+// Load a journal, creating an empty one if it doesn't exist yet
+func LoadJournal(path string) (*Journal, error) {
+	journal := &Journal{
+		path:    path,
+		entries: []*JournalEntry{},
+	}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return journal, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading transaction journal %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, &journal.entries); err != nil {
+		return nil, fmt.Errorf("error decoding transaction journal %s: %w", path, err)
+	}
+	return journal, nil
+}
+
+// Get a copy of the journal's entries
+func (j *Journal) GetEntries() []JournalEntry {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	entries := make([]JournalEntry, len(j.entries))
+	for i, entry := range j.entries {
+		entries[i] = copyEntry(entry)
+	}
+	return entries
+}
+
+// Get a copy of the entry that has a version with the given hash
+func (j *Journal) getEntryForHash(hash common.Hash) (JournalEntry, bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for _, entry := range j.entries {
+		for _, entryHash := range entry.Hashes {
+			if entryHash == hash {
+				return copyEntry(entry), true
+			}
+		}
+	}
+	return JournalEntry{}, false
+}
+
+// Get a copy of the entry for a sender's nonce
+func (j *Journal) getEntry(from common.Address, nonce uint64) (JournalEntry, bool) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for _, entry := range j.entries {
+		if entry.From == from && entry.Nonce == nonce {
+			return copyEntry(entry), true
+		}
+	}
+	return JournalEntry{}, false
+}
+
+// Add a sent transaction, or a replacement for one that's already in the journal
+func (j *Journal) add(from common.Address, tx *types.Transaction) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	now := time.Now()
+	for _, entry := range j.entries {
+		if entry.From == from && entry.Nonce == tx.Nonce() {
+			for _, hash := range entry.Hashes {
+				if hash == tx.Hash() {
+					return nil
+				}
+			}
+			entry.Hashes = append(entry.Hashes, tx.Hash())
+			entry.Tx = tx
+			entry.LastSent = now
+			return j.save()
+		}
+	}
+	j.entries = append(j.entries, &JournalEntry{
+		From:      from,
+		Nonce:     tx.Nonce(),
+		Hashes:    []common.Hash{tx.Hash()},
+		Tx:        tx,
+		FirstSent: now,
+		LastSent:  now,
+	})
+	return j.save()
+}
+
+// Remove the entry for a nonce once it's been used
+func (j *Journal) remove(from common.Address, nonce uint64) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for i, entry := range j.entries {
+		if entry.From == from && entry.Nonce == nonce {
+			j.entries = append(j.entries[:i], j.entries[i+1:]...)
+			return j.save()
+		}
+	}
+	return nil
+}
+
+// Restart the wait before an entry is bumped again, without replacing it
+func (j *Journal) resetBumpTimer(from common.Address, nonce uint64) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for _, entry := range j.entries {
+		if entry.From == from && entry.Nonce == nonce {
+			entry.LastSent = time.Now()
+		}
+	}
+}
+
+// Write the journal to disk
+func (j *Journal) save() error {
+	bytes, err := json.MarshalIndent(j.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding transaction journal: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(j.path), journalDirMode); err != nil {
+		return fmt.Errorf("error creating transaction journal folder: %w", err)
+	}
+
+	// Write to a temporary file first so a crash can't leave a half-written journal behind
+	tempPath := j.path + ".tmp"
+	if err := os.WriteFile(tempPath, bytes, journalFileMode); err != nil {
+		return fmt.Errorf("error writing transaction journal: %w", err)
+	}
+	if err := os.Rename(tempPath, j.path); err != nil {
+		return fmt.Errorf("error saving transaction journal: %w", err)
+	}
+	return nil
+}
+
+// Copy an entry so callers can read it without holding the lock
+func copyEntry(entry *JournalEntry) JournalEntry {
+	copy := *entry
+	copy.Hashes = append([]common.Hash{}, entry.Hashes...)
+	return copy
+}
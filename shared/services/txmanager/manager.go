@@ -0,0 +1,281 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Config
+const (
+	// How often to check pending transactions for inclusion
+	pollInterval = 12 * time.Second
+
+	// How long a transaction can stay pending before it's replaced with higher fees
+	bumpInterval = 3 * time.Minute
+
+	// Journal names for each daemon
+	NodeJournalName       = "node"
+	WatchtowerJournalName = "watchtower"
+)
+
+// The journals of every daemon that sends transactions
+var JournalNames = []string{NodeJournalName, WatchtowerJournalName}
+
+// Errors
+var ErrTransactionReplaced = errors.New("the transaction's nonce was used by a different transaction")
+
+// Keeps track of a daemon's transactions until they're included, replacing them with higher fees if they get stuck
+type TxManager struct {
+	cfg     *config.RocketPoolConfig
+	w       *wallet.Wallet
+	ec      rocketpool.ExecutionClient
+	journal *Journal
+	log     *log.ColorLogger
+}
+
+// Create a new transaction manager backed by the named daemon's journal
+func NewTxManager(cfg *config.RocketPoolConfig, w *wallet.Wallet, ec rocketpool.ExecutionClient, name string, logger *log.ColorLogger) (*TxManager, error) {
+	journal, err := LoadJournal(cfg.Smartnode.GetTxJournalPath(name))
+	if err != nil {
+		return nil, err
+	}
+	return &TxManager{
+		cfg:     cfg,
+		w:       w,
+		ec:      ec,
+		journal: journal,
+		log:     logger,
+	}, nil
+}
+
+// Add a transaction that was just sent to the journal
+func (m *TxManager) Record(tx *types.Transaction) {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		m.log.Printlnf("WARNING: couldn't get the sender of transaction %s, so it won't be tracked: %s", tx.Hash().Hex(), err.Error())
+		return
+	}
+	if err := m.journal.add(from, tx); err != nil {
+		m.log.Printlnf("WARNING: couldn't add transaction %s to the journal: %s", tx.Hash().Hex(), err.Error())
+	}
+}
+
+// Start watching the transactions left in the journal by a previous run of the daemon
+func (m *TxManager) Resume() {
+	for _, entry := range m.journal.GetEntries() {
+		m.log.Printlnf("Resuming pending transaction %s (nonce %d).", entry.Hashes[len(entry.Hashes)-1].Hex(), entry.Nonce)
+		go func(entry JournalEntry) {
+			receipt, err := m.watch(entry.From, entry.Nonce, m.log)
+			if err != nil {
+				m.log.Printlnf("Transaction with nonce %d didn't complete: %s", entry.Nonce, err.Error())
+				return
+			}
+			m.log.Printlnf("Transaction %s was included in block %d.", receipt.TxHash.Hex(), receipt.BlockNumber.Uint64())
+		}(entry)
+	}
+}
+
+// Wait for a transaction to be included in a block, replacing it with higher fees if it gets stuck.
+// Returns the receipt of whichever version of the transaction was included.
+func (m *TxManager) WaitForTransaction(hash common.Hash, logger *log.ColorLogger) (*types.Receipt, error) {
+	entry, exists := m.journal.getEntryForHash(hash)
+	if !exists {
+		return utils.WaitForTransaction(m.ec, hash)
+	}
+	return m.watch(entry.From, entry.Nonce, logger)
+}
+
+// Watch a journal entry until one of its versions is included or its nonce is used by something else
+func (m *TxManager) watch(from common.Address, nonce uint64, logger *log.ColorLogger) (*types.Receipt, error) {
+	for {
+		entry, exists := m.journal.getEntry(from, nonce)
+		if !exists {
+			return nil, fmt.Errorf("transaction with nonce %d is no longer in the journal", nonce)
+		}
+
+		// Get the nonce before the receipts so a version included in between isn't mistaken for a replacement
+		latestNonce, err := m.ec.NonceAt(context.Background(), from, nil)
+		if err != nil {
+			logger.Printlnf("WARNING: couldn't get the nonce for %s: %s", from.Hex(), err.Error())
+			time.Sleep(pollInterval)
+			continue
+		}
+
+		// Check every version of the transaction, newest first
+		receipt, err := m.getReceipt(entry.Hashes)
+		if err != nil {
+			logger.Printlnf("WARNING: couldn't check transaction %s: %s", entry.Hashes[len(entry.Hashes)-1].Hex(), err.Error())
+			time.Sleep(pollInterval)
+			continue
+		}
+		if receipt != nil {
+			if err := m.journal.remove(from, nonce); err != nil {
+				logger.Printlnf("WARNING: couldn't remove transaction %s from the journal: %s", receipt.TxHash.Hex(), err.Error())
+			}
+			if receipt.Status == types.ReceiptStatusFailed {
+				return receipt, fmt.Errorf("Transaction %s failed with status 0", receipt.TxHash.Hex())
+			}
+			return receipt, nil
+		}
+		if latestNonce > nonce {
+			if err := m.journal.remove(from, nonce); err != nil {
+				logger.Printlnf("WARNING: couldn't remove transaction with nonce %d from the journal: %s", nonce, err.Error())
+			}
+			return nil, ErrTransactionReplaced
+		}
+
+		// Replace it if it's been pending too long
+		if time.Since(entry.LastSent) >= bumpInterval {
+			if err := m.bump(entry, logger); err != nil {
+				logger.Printlnf("WARNING: couldn't replace stuck transaction %s: %s", entry.Hashes[len(entry.Hashes)-1].Hex(), err.Error())
+			}
+		}
+
+		time.Sleep(pollInterval)
+	}
+}
+
+// Get the receipt for whichever of the hashes was included, if any
+func (m *TxManager) getReceipt(hashes []common.Hash) (*types.Receipt, error) {
+	for i := len(hashes) - 1; i >= 0; i-- {
+		receipt, err := m.ec.TransactionReceipt(context.Background(), hashes[i])
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+	}
+	return nil, nil
+}
+
+// Replace a pending transaction with a copy that pays higher fees
+func (m *TxManager) bump(entry JournalEntry, logger *log.ColorLogger) error {
+
+	// Check if bumping is enabled
+	ceilingGwei := m.cfg.Smartnode.TxFeeBumpCeiling.Value.(float64)
+	if ceilingGwei <= 0 {
+		return nil
+	}
+	ceiling := eth.GweiToWei(ceilingGwei)
+
+	// Only EIP-1559 transactions can be bumped
+	tx := entry.Tx
+	if tx == nil || tx.Type() != types.DynamicFeeTxType {
+		return nil
+	}
+
+	// Get the new fees
+	header, err := m.ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("error getting latest block header: %w", err)
+	}
+	newTip, newFeeCap, ok := GetBumpedFees(tx.GasTipCap(), tx.GasFeeCap(), header.BaseFee, ceiling)
+	if !ok {
+		logger.Printlnf("Transaction %s is still pending, but its max fee is already at the %.2f gwei ceiling.", tx.Hash().Hex(), ceilingGwei)
+		m.journal.resetBumpTimer(entry.From, entry.Nonce)
+		return nil
+	}
+
+	// Sign the replacement with whichever key sent the original, since permissionless tasks use the delegated hot key
+	opts, err := m.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	if opts.From != entry.From {
+		opts, err = m.w.GetDelegateTransactor()
+		if err != nil {
+			return err
+		}
+	}
+	if opts.From != entry.From {
+		return fmt.Errorf("the transaction was sent by %s but the wallet signs for %s", entry.From.Hex(), opts.From.Hex())
+	}
+	replacement, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:    tx.ChainId(),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  newTip,
+		GasFeeCap:  newFeeCap,
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}))
+	if err != nil {
+		return fmt.Errorf("error signing replacement transaction: %w", err)
+	}
+
+	// Send it
+	if err := m.ec.SendTransaction(context.Background(), replacement); err != nil {
+		return fmt.Errorf("error sending replacement transaction: %w", err)
+	}
+	if err := m.journal.add(entry.From, replacement); err != nil {
+		logger.Printlnf("WARNING: couldn't add replacement transaction %s to the journal: %s", replacement.Hash().Hex(), err.Error())
+	}
+	logger.Printlnf("Transaction %s was still pending after %s, so it was replaced with %s (max fee %.2f gwei, priority fee %.2f gwei).",
+		tx.Hash().Hex(), time.Since(entry.LastSent).Round(time.Second), replacement.Hash().Hex(), eth.WeiToGwei(newFeeCap), eth.WeiToGwei(newTip))
+	return nil
+
+}
+
+// Get the fees for a replacement transaction.
+// Clients only accept a replacement if both fees go up by at least 10%; it also needs to cover twice the current base fee so it isn't stuck again right away.
+// The max fee won't exceed the ceiling unless the ceiling is nil. Returns false if the ceiling leaves no room for a valid replacement.
+func GetBumpedFees(tip *big.Int, feeCap *big.Int, baseFee *big.Int, ceiling *big.Int) (*big.Int, *big.Int, bool) {
+
+	// Raise both by 12.5%, rounding up
+	newTip := new(big.Int).Mul(tip, big.NewInt(9))
+	newTip.Div(newTip, big.NewInt(8))
+	newTip.Add(newTip, big.NewInt(1))
+	newFeeCap := new(big.Int).Mul(feeCap, big.NewInt(9))
+	newFeeCap.Div(newFeeCap, big.NewInt(8))
+	newFeeCap.Add(newFeeCap, big.NewInt(1))
+
+	// Make sure the max fee covers the base fee rising for a while
+	if baseFee != nil {
+		minFeeCap := new(big.Int).Mul(baseFee, big.NewInt(2))
+		minFeeCap.Add(minFeeCap, newTip)
+		if newFeeCap.Cmp(minFeeCap) < 0 {
+			newFeeCap = minFeeCap
+		}
+	}
+
+	// Apply the ceiling
+	if ceiling != nil && newFeeCap.Cmp(ceiling) > 0 {
+		newFeeCap = new(big.Int).Set(ceiling)
+		if newTip.Cmp(newFeeCap) > 0 {
+			newTip = new(big.Int).Set(newFeeCap)
+		}
+	}
+
+	// Check that the replacement will be accepted
+	if !isBumpedEnough(tip, newTip) || !isBumpedEnough(feeCap, newFeeCap) {
+		return nil, nil, false
+	}
+	return newTip, newFeeCap, true
+
+}
+
+// Check if a fee went up by at least 10%
+func isBumpedEnough(oldFee *big.Int, newFee *big.Int) bool {
+	minFee := new(big.Int).Mul(oldFee, big.NewInt(11))
+	return new(big.Int).Mul(newFee, big.NewInt(10)).Cmp(minFee) >= 0
+}
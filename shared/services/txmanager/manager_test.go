@@ -0,0 +1,39 @@
+package txmanager
+
+import (
+	"math/big"
+	"testing"
+)
+
+func gwei(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(1e9))
+}
+
+func TestBumpedFees(t *testing.T) {
+	// Both fees go up by 12.5%, and the max fee covers twice the base fee
+	tip, feeCap, ok := GetBumpedFees(gwei(2), gwei(20), gwei(30), nil)
+	if !ok {
+		t.Fatal("expected the bump to succeed")
+	}
+	if tip.Cmp(big.NewInt(2250000001)) != 0 {
+		t.Errorf("expected a priority fee of 2250000001 wei, got %s", tip)
+	}
+	expectedFeeCap := new(big.Int).Add(gwei(60), tip)
+	if feeCap.Cmp(expectedFeeCap) != 0 {
+		t.Errorf("expected a max fee of %s wei, got %s", expectedFeeCap, feeCap)
+	}
+
+	// The ceiling caps the max fee
+	_, feeCap, ok = GetBumpedFees(gwei(2), gwei(20), gwei(30), gwei(40))
+	if !ok {
+		t.Fatal("expected the capped bump to succeed")
+	}
+	if feeCap.Cmp(gwei(40)) != 0 {
+		t.Errorf("expected the max fee to be capped at 40 gwei, got %s", feeCap)
+	}
+
+	// A ceiling that leaves less than a 10% increase can't be used
+	if _, _, ok = GetBumpedFees(gwei(2), gwei(20), gwei(30), gwei(21)); ok {
+		t.Error("expected the bump to fail when the ceiling is too close to the current max fee")
+	}
+}
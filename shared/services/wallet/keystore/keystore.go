@@ -4,6 +4,9 @@ import (
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/sethvargo/go-password/password"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
 )
 
 // Generates a random password
@@ -24,3 +27,10 @@ type Keystore interface {
 	LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
 	GetKeystoreDir() string
 }
+
+// Validator keystore that holds keys on a remote signer and never releases them
+type RemoteSigner interface {
+	Keystore
+	SignVoluntaryExit(pubkey types.ValidatorPubkey, exit eth2.VoluntaryExit, signingRoot [32]byte, eth2Config beacon.Eth2Config) (types.ValidatorSignature, error)
+	SignDeposit(pubkey types.ValidatorPubkey, depositData eth2.DepositDataNoSignature, signingRoot [32]byte, eth2Config beacon.Eth2Config) (types.ValidatorSignature, error)
+}
@@ -0,0 +1,302 @@
+package web3signer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	keystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	RequestTimeout  = 60 * time.Second
+	RequestJsonType = "application/json"
+
+	keystoresRoute = "eth/v1/keystores"
+	signRoute      = "api/v1/eth2/sign/%s"
+
+	importStatusImported  = "imported"
+	importStatusDuplicate = "duplicate"
+
+	signTypeDeposit       = "DEPOSIT"
+	signTypeVoluntaryExit = "VOLUNTARY_EXIT"
+)
+
+// Web3Signer keystore
+type Keystore struct {
+	url          string
+	keystorePath string
+	client       *http.Client
+	encryptor    *eth2ks.Encryptor
+}
+
+// Encrypted validator key store
+type validatorKey struct {
+	Crypto  map[string]interface{} `json:"crypto"`
+	Version uint                   `json:"version"`
+	UUID    uuid.UUID              `json:"uuid"`
+	Path    string                 `json:"path"`
+	Pubkey  types.ValidatorPubkey  `json:"pubkey"`
+}
+
+// Keymanager API import request
+type importKeystoresRequest struct {
+	Keystores []string `json:"keystores"`
+	Passwords []string `json:"passwords"`
+}
+
+// Keymanager API import response
+type importKeystoresResponse struct {
+	Data []struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"data"`
+}
+
+// Keymanager API list response
+type listKeystoresResponse struct {
+	Data []struct {
+		ValidatingPubkey string `json:"validating_pubkey"`
+	} `json:"data"`
+}
+
+// Signing API types
+type forkInfo struct {
+	Fork                  fork   `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+type fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+type voluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+type deposit struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	GenesisForkVersion    string `json:"genesis_fork_version"`
+}
+type signRequest struct {
+	Type          string         `json:"type"`
+	ForkInfo      *forkInfo      `json:"fork_info,omitempty"`
+	SigningRoot   string         `json:"signingRoot"`
+	VoluntaryExit *voluntaryExit `json:"voluntary_exit,omitempty"`
+	Deposit       *deposit       `json:"deposit,omitempty"`
+}
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// Create new Web3Signer keystore
+func NewKeystore(url string, keystorePath string) *Keystore {
+	return &Keystore{
+		url:          strings.TrimSuffix(url, "/"),
+		keystorePath: keystorePath,
+		client: &http.Client{
+			Timeout: RequestTimeout,
+		},
+		encryptor: eth2ks.New(eth2ks.WithCipher("scrypt")),
+	}
+}
+
+// Get the keystore directory; keys are never stored locally, so there is nothing to delete
+func (ks *Keystore) GetKeystoreDir() string {
+	return ""
+}
+
+// Import a validator key into the remote signer
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Get validator pubkey
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	// Create a one-time password; the signer stores it alongside the key, so it is never saved here
+	password, err := keystore.GenerateRandomPassword()
+	if err != nil {
+		return fmt.Errorf("Could not generate random password: %w", err)
+	}
+
+	// Encrypt key
+	encryptedKey, err := ks.encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	// Encode key store
+	keyStoreBytes, err := json.Marshal(validatorKey{
+		Crypto:  encryptedKey,
+		Version: ks.encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    derivationPath,
+		Pubkey:  pubkey,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	// Import it
+	var response importKeystoresResponse
+	err = ks.request(http.MethodPost, keystoresRoute, importKeystoresRequest{
+		Keystores: []string{string(keyStoreBytes)},
+		Passwords: []string{password},
+	}, &response)
+	if err != nil {
+		return fmt.Errorf("Could not import validator key %s into Web3Signer: %w", pubkey.Hex(), err)
+	}
+	if len(response.Data) != 1 {
+		return fmt.Errorf("Web3Signer returned %d import results for validator key %s", len(response.Data), pubkey.Hex())
+	}
+	status := response.Data[0]
+	if status.Status != importStatusImported && status.Status != importStatusDuplicate {
+		return fmt.Errorf("Web3Signer could not import validator key %s: %s (%s)", pubkey.Hex(), status.Status, status.Message)
+	}
+
+	// Make sure the signer actually holds the key before Lighthouse's local copy is deleted
+	if err := ks.verifyValidatorKey(pubkey); err != nil {
+		return err
+	}
+
+	// Register the key with Lighthouse, which can only load remote keys from its definitions file
+	if err := ks.addLighthouseDefinition(pubkey); err != nil {
+		return err
+	}
+
+	// Return
+	return nil
+
+}
+
+// Check that a validator key is listed by the remote signer
+func (ks *Keystore) verifyValidatorKey(pubkey types.ValidatorPubkey) error {
+	var response listKeystoresResponse
+	if err := ks.request(http.MethodGet, keystoresRoute, nil, &response); err != nil {
+		return fmt.Errorf("Could not list the validator keys in Web3Signer to verify %s: %w", pubkey.Hex(), err)
+	}
+	pubkeyString := hexutil.AddPrefix(pubkey.Hex())
+	for _, key := range response.Data {
+		if strings.EqualFold(key.ValidatingPubkey, pubkeyString) {
+			return nil
+		}
+	}
+	return fmt.Errorf("Web3Signer reported validator key %s as imported but doesn't list it; its local keystore has been left in place", pubkey.Hex())
+}
+
+// Validator keys can't be exported from the remote signer
+func (ks *Keystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+	return nil, nil
+}
+
+// Sign a voluntary exit message with the remote signer
+func (ks *Keystore) SignVoluntaryExit(pubkey types.ValidatorPubkey, exit eth2.VoluntaryExit, signingRoot [32]byte, eth2Config beacon.Eth2Config) (types.ValidatorSignature, error) {
+
+	// Exits are always signed against the Capella fork (EIP-7044), so use it for both sides of the fork info
+	capellaForkVersion := hexutil.AddPrefix(fmt.Sprintf("%x", eth2Config.CapellaForkVersion))
+	return ks.sign(pubkey, signRequest{
+		Type: signTypeVoluntaryExit,
+		ForkInfo: &forkInfo{
+			Fork: fork{
+				PreviousVersion: capellaForkVersion,
+				CurrentVersion:  capellaForkVersion,
+				Epoch:           "0",
+			},
+			GenesisValidatorsRoot: hexutil.AddPrefix(fmt.Sprintf("%x", eth2Config.GenesisValidatorsRoot)),
+		},
+		SigningRoot: hexutil.AddPrefix(fmt.Sprintf("%x", signingRoot)),
+		VoluntaryExit: &voluntaryExit{
+			Epoch:          strconv.FormatUint(exit.Epoch, 10),
+			ValidatorIndex: strconv.FormatUint(exit.ValidatorIndex, 10),
+		},
+	})
+
+}
+
+// Sign deposit data with the remote signer
+func (ks *Keystore) SignDeposit(pubkey types.ValidatorPubkey, depositData eth2.DepositDataNoSignature, signingRoot [32]byte, eth2Config beacon.Eth2Config) (types.ValidatorSignature, error) {
+	return ks.sign(pubkey, signRequest{
+		Type:        signTypeDeposit,
+		SigningRoot: hexutil.AddPrefix(fmt.Sprintf("%x", signingRoot)),
+		Deposit: &deposit{
+			Pubkey:                hexutil.AddPrefix(fmt.Sprintf("%x", depositData.PublicKey)),
+			WithdrawalCredentials: hexutil.AddPrefix(fmt.Sprintf("%x", depositData.WithdrawalCredentials)),
+			Amount:                strconv.FormatUint(depositData.Amount, 10),
+			GenesisForkVersion:    hexutil.AddPrefix(fmt.Sprintf("%x", eth2Config.GenesisForkVersion)),
+		},
+	})
+}
+
+// Request a signature from the remote signer
+func (ks *Keystore) sign(pubkey types.ValidatorPubkey, request signRequest) (types.ValidatorSignature, error) {
+
+	var response signResponse
+	err := ks.request(http.MethodPost, fmt.Sprintf(signRoute, hexutil.AddPrefix(pubkey.Hex())), request, &response)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("error getting %s signature for validator %s from Web3Signer: %w", request.Type, pubkey.Hex(), err)
+	}
+	signature, err := types.HexToValidatorSignature(hexutil.RemovePrefix(response.Signature))
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("Web3Signer returned an invalid signature for validator %s: %w", pubkey.Hex(), err)
+	}
+	return signature, nil
+
+}
+
+// Send a request to the remote signer and decode the response; a nil request body is sent without one
+func (ks *Keystore) request(method string, route string, request interface{}, response interface{}) error {
+
+	// Encode the request
+	var requestBody io.Reader
+	if request != nil {
+		requestBytes, err := json.Marshal(request)
+		if err != nil {
+			return fmt.Errorf("error encoding request: %w", err)
+		}
+		requestBody = bytes.NewReader(requestBytes)
+	}
+	httpRequest, err := http.NewRequest(method, fmt.Sprintf("%s/%s", ks.url, route), requestBody)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	if request != nil {
+		httpRequest.Header.Set("Content-Type", RequestJsonType)
+	}
+	httpRequest.Header.Set("Accept", RequestJsonType)
+
+	// Send it
+	httpResponse, err := ks.client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	body, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with status %s: %s", httpResponse.Status, strings.TrimSpace(string(body)))
+	}
+
+	// Decode the response
+	if err := json.Unmarshal(body, response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+
+}
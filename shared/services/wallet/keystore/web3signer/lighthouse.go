@@ -0,0 +1,168 @@
+package web3signer
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"gopkg.in/yaml.v2"
+
+	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	LighthouseDefinitionsFileName = "validator_definitions.yml"
+
+	lighthouseVotingPubkeyKey = "voting_public_key"
+	lighthouseTypeKey         = "type"
+	lighthouseWeb3SignerType  = "web3signer"
+)
+
+// A remote validator entry in Lighthouse's definitions file
+type lighthouseDefinition struct {
+	Enabled         bool   `yaml:"enabled"`
+	VotingPublicKey string `yaml:"voting_public_key"`
+	Type            string `yaml:"type"`
+	Url             string `yaml:"url"`
+}
+
+// Add a validator to Lighthouse's definitions file so its VC signs with Web3Signer, leaving the other entries intact.
+// If Lighthouse was using a local keystore for the validator, the definition is replaced and the keystore is securely deleted.
+// The key must already be verified in the remote signer, since no copy of it is kept on this machine.
+func (ks *Keystore) addLighthouseDefinition(pubkey types.ValidatorPubkey) error {
+
+	// Get the definitions file path
+	definitionsPath := filepath.Join(ks.keystorePath, lhkeystore.KeystoreDir, lhkeystore.ValidatorsDir, LighthouseDefinitionsFileName)
+
+	// Load the existing definitions
+	definitions := []yaml.MapSlice{}
+	bytes, err := os.ReadFile(definitionsPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Could not read Lighthouse validator definitions: %w", err)
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(bytes, &definitions); err != nil {
+			return fmt.Errorf("Could not parse Lighthouse validator definitions: %w", err)
+		}
+	}
+
+	// Find the validator's existing definition; skip it if it already uses the remote signer
+	pubkeyString := hexutil.AddPrefix(pubkey.Hex())
+	existingIndex := -1
+	for i, definition := range definitions {
+		if strings.EqualFold(getDefinitionValue(definition, lighthouseVotingPubkeyKey), pubkeyString) {
+			existingIndex = i
+			break
+		}
+	}
+	if existingIndex >= 0 && getDefinitionValue(definitions[existingIndex], lighthouseTypeKey) == lighthouseWeb3SignerType {
+		return nil
+	}
+
+	// Delete the local keystore first, so Lighthouse can never load the key from both places
+	if err := ks.deleteLighthouseKeystore(pubkeyString); err != nil {
+		return err
+	}
+
+	// Add the new definition
+	newDefinition := yaml.MapSlice{}
+	newBytes, err := yaml.Marshal(lighthouseDefinition{
+		Enabled:         true,
+		VotingPublicKey: pubkeyString,
+		Type:            lighthouseWeb3SignerType,
+		Url:             ks.url,
+	})
+	if err != nil {
+		return fmt.Errorf("Could not encode Lighthouse validator definition: %w", err)
+	}
+	if err := yaml.Unmarshal(newBytes, &newDefinition); err != nil {
+		return fmt.Errorf("Could not encode Lighthouse validator definition: %w", err)
+	}
+	if existingIndex >= 0 {
+		definitions[existingIndex] = newDefinition
+	} else {
+		definitions = append(definitions, newDefinition)
+	}
+
+	// Write the definitions file
+	bytes, err = yaml.Marshal(definitions)
+	if err != nil {
+		return fmt.Errorf("Could not encode Lighthouse validator definitions: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(definitionsPath), lhkeystore.DirMode); err != nil {
+		return fmt.Errorf("Could not create Lighthouse validator folder: %w", err)
+	}
+	if err := os.WriteFile(definitionsPath, bytes, lhkeystore.FileMode); err != nil {
+		return fmt.Errorf("Could not write Lighthouse validator definitions: %w", err)
+	}
+
+	return nil
+
+}
+
+// Securely delete Lighthouse's local keystore and password for a validator, if it has them
+func (ks *Keystore) deleteLighthouseKeystore(pubkeyString string) error {
+
+	lighthouseDir := filepath.Join(ks.keystorePath, lhkeystore.KeystoreDir)
+	for _, subdir := range []string{lhkeystore.ValidatorsDir, lhkeystore.SecretsDir} {
+		path := filepath.Join(lighthouseDir, subdir, pubkeyString)
+		_, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Could not check Lighthouse keystore %s: %w", path, err)
+		}
+
+		// Overwrite every file before removing it, so the key can't be recovered from the disk
+		err = filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			return shredFile(filePath)
+		})
+		if err != nil {
+			return fmt.Errorf("Could not securely delete Lighthouse keystore %s: %w", path, err)
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("Could not remove Lighthouse keystore %s: %w", path, err)
+		}
+	}
+	return nil
+
+}
+
+// Overwrite a file with random data and flush it to disk
+func shredFile(path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyN(file, rand.Reader, info.Size()); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+// Get the string value of a key in a Lighthouse validator definition
+func getDefinitionValue(definition yaml.MapSlice, key string) string {
+	for _, item := range definition {
+		if item.Key == key {
+			value, _ := item.Value.(string)
+			return value
+		}
+	}
+	return ""
+}
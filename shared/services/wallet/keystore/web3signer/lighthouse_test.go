@@ -0,0 +1,182 @@
+package web3signer
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	"gopkg.in/yaml.v2"
+
+	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+const testOtherPubkey = "0xb2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2b2"
+
+func TestAddLighthouseDefinition(t *testing.T) {
+	keystorePath := t.TempDir()
+	ks := NewKeystore("http://web3signer:9000/", keystorePath)
+	pubkey := types.BytesToValidatorPubkey(make([]byte, types.ValidatorPubkeyLength))
+	pubkey[0] = 0xa1
+	pubkeyString := hexutil.AddPrefix(pubkey.Hex())
+
+	// Lighthouse already loads the validator from a local keystore, along with another validator
+	lighthouseDir := filepath.Join(keystorePath, lhkeystore.KeystoreDir)
+	keyDir := filepath.Join(lighthouseDir, lhkeystore.ValidatorsDir, pubkeyString)
+	secretPath := filepath.Join(lighthouseDir, lhkeystore.SecretsDir, pubkeyString)
+	definitionsPath := filepath.Join(lighthouseDir, lhkeystore.ValidatorsDir, LighthouseDefinitionsFileName)
+	if err := os.MkdirAll(keyDir, 0770); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(secretPath), 0770); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(keyDir, lhkeystore.KeyFileName), []byte("{}"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(secretPath, []byte("password"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	definitions := []map[string]interface{}{
+		{
+			"enabled":              true,
+			"voting_public_key":    pubkeyString,
+			"type":                 "local_keystore",
+			"voting_keystore_path": filepath.Join(keyDir, lhkeystore.KeyFileName),
+		},
+		{
+			"enabled":              true,
+			"voting_public_key":    testOtherPubkey,
+			"type":                 "local_keystore",
+			"voting_keystore_path": "/other/voting-keystore.json",
+		},
+	}
+	bytes, err := yaml.Marshal(definitions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(definitionsPath, bytes, 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	// Migrate it, twice to make sure it's idempotent
+	for i := 0; i < 2; i++ {
+		if err := ks.addLighthouseDefinition(pubkey); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The validator's definition is replaced and the other one is kept
+	bytes, err = os.ReadFile(definitionsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result []map[string]interface{}
+	if err := yaml.Unmarshal(bytes, &result); err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("expected 2 definitions, got:\n%s", bytes)
+	}
+	if result[0]["voting_public_key"] != pubkeyString || result[0]["type"] != "web3signer" || result[0]["url"] != "http://web3signer:9000" {
+		t.Errorf("validator wasn't switched to the remote signer:\n%s", bytes)
+	}
+	if _, exists := result[0]["voting_keystore_path"]; exists {
+		t.Errorf("validator still has a local keystore path:\n%s", bytes)
+	}
+	if result[1]["voting_public_key"] != testOtherPubkey || result[1]["type"] != "local_keystore" {
+		t.Errorf("other validator was changed:\n%s", bytes)
+	}
+
+	// The local keystore is deleted and no copy is left in Lighthouse's folder
+	if _, err := os.Stat(keyDir); !os.IsNotExist(err) {
+		t.Errorf("local keystore wasn't deleted")
+	}
+	if _, err := os.Stat(secretPath); !os.IsNotExist(err) {
+		t.Errorf("local keystore password wasn't deleted")
+	}
+	entries, err := os.ReadDir(lighthouseDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != lhkeystore.ValidatorsDir && entry.Name() != lhkeystore.SecretsDir {
+			t.Errorf("unexpected entry %s left in Lighthouse's folder", entry.Name())
+		}
+	}
+}
+
+func TestStoreValidatorKeyVerification(t *testing.T) {
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatal(err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkeyString := hexutil.AddPrefix(types.BytesToValidatorPubkey(key.PublicKey().Marshal()).Hex())
+
+	tests := []struct {
+		name       string
+		listed     string
+		expectKept bool
+	}{
+		{
+			name:   "listed",
+			listed: pubkeyString,
+		},
+		{
+			name:       "not listed",
+			listed:     testOtherPubkey,
+			expectKept: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The signer reports every import as successful, but only lists one key
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					fmt.Fprint(w, `{"data":[{"status":"imported","message":""}]}`)
+					return
+				}
+				fmt.Fprintf(w, `{"data":[{"validating_pubkey":"%s"}]}`, test.listed)
+			}))
+			defer server.Close()
+
+			// Lighthouse already has a local keystore for the validator
+			keystorePath := t.TempDir()
+			secretPath := filepath.Join(keystorePath, lhkeystore.KeystoreDir, lhkeystore.SecretsDir, pubkeyString)
+			if err := os.MkdirAll(filepath.Dir(secretPath), 0770); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(secretPath, []byte("password"), 0640); err != nil {
+				t.Fatal(err)
+			}
+
+			ks := NewKeystore(server.URL, keystorePath)
+			err := ks.StoreValidatorKey(key, "m/12381/3600/0/0/0")
+			_, statErr := os.Stat(secretPath)
+			if test.expectKept {
+				if err == nil {
+					t.Error("expected an error for a key the signer doesn't list")
+				}
+				if statErr != nil {
+					t.Errorf("local keystore was deleted before the key was verified: %s", statErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !os.IsNotExist(statErr) {
+					t.Error("local keystore wasn't deleted after the key was verified")
+				}
+			}
+		})
+	}
+}
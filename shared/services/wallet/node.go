@@ -5,21 +5,46 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // Get the node account
 func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 
+	// Use the watched address if the wallet is watch-only
+	if w.watchOnlyAddress != nil {
+		return accounts.Account{
+			Address: *w.watchOnlyAddress,
+		}, nil
+	}
+
+	// Use the external signer's account if there is one
+	if w.nodeSigner != nil {
+		return accounts.Account{
+			Address: w.nodeSigner.GetAddress(),
+		}, nil
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return accounts.Account{}, errors.New("Wallet is not initialized")
+		return accounts.Account{}, w.getUninitializedError()
 	}
 
+	// Get the wallet's own account
+	return w.getLocalAccount()
+
+}
+
+// Get the account of the wallet's own key
+func (w *Wallet) getLocalAccount() (accounts.Account, error) {
+
 	// Get private key
 	privateKey, path, err := w.getNodePrivateKey()
 	if err != nil {
@@ -47,9 +72,63 @@ func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 // Get a transactor for the node account
 func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
+	// Build unsigned transactions for offline signing if requested; this only needs the node address, so it works without the node key
+	if w.offlineExport != nil {
+		account, err := w.GetNodeAccount()
+		if err != nil {
+			return nil, err
+		}
+		return &bind.TransactOpts{
+			From: account.Address,
+			Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				if address != account.Address {
+					return nil, fmt.Errorf("only transactions from the node account can be exported for offline signing, but this one is from %s", address.Hex())
+				}
+				w.offlineExport(address, withChainID(tx, w.chainID))
+				return tx, nil
+			},
+			GasFeeCap: w.maxFee,
+			GasTipCap: w.maxPriorityFee,
+			GasLimit:  w.gasLimit,
+			Context:   context.Background(),
+			NoSend:    true,
+		}, nil
+	}
+
+	// Watch-only nodes can still estimate gas from the node account, but can't sign anything
+	if w.watchOnlyAddress != nil {
+		return &bind.TransactOpts{
+			From: *w.watchOnlyAddress,
+			Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return nil, ErrWatchOnly
+			},
+			GasFeeCap: w.maxFee,
+			GasTipCap: w.maxPriorityFee,
+			GasLimit:  w.gasLimit,
+			Context:   context.Background(),
+		}, nil
+	}
+
+	// Sign with the external signer if there is one
+	if w.nodeSigner != nil {
+		return &bind.TransactOpts{
+			From: w.nodeSigner.GetAddress(),
+			Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				if address != w.nodeSigner.GetAddress() {
+					return nil, bind.ErrNotAuthorized
+				}
+				return w.nodeSigner.SignTx(tx, w.chainID)
+			},
+			GasFeeCap: w.maxFee,
+			GasTipCap: w.maxPriorityFee,
+			GasLimit:  w.gasLimit,
+			Context:   context.Background(),
+		}, nil
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return nil, errors.New("Wallet is not initialized")
+		return nil, w.getUninitializedError()
 	}
 
 	// Get private key
@@ -68,12 +147,51 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
 }
 
+// Get a transactor for permissionless transactions, which anyone can send on the node's behalf.
+// If the node account is held by an external signer, the daemon can't wait for it to approve them, so they're signed with
+// the wallet's own key as a delegated hot key instead; otherwise this is the node account's transactor.
+func (w *Wallet) GetDelegateTransactor() (*bind.TransactOpts, error) {
+
+	// Use the node account unless it's held by an external signer
+	if w.nodeSigner == nil || w.offlineExport != nil || w.watchOnlyAddress != nil {
+		return w.GetNodeAccountTransactor()
+	}
+
+	// Check wallet is initialized
+	if !w.IsInitialized() {
+		return nil, w.getUninitializedError()
+	}
+
+	// Get private key
+	privateKey, _, err := w.getNodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create & return transactor
+	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, w.chainID)
+	if err != nil {
+		return nil, err
+	}
+	transactor.GasFeeCap = w.maxFee
+	transactor.GasTipCap = w.maxPriorityFee
+	transactor.GasLimit = w.gasLimit
+	transactor.Context = context.Background()
+	return transactor, nil
+
+}
+
 // Get the node account private key bytes
 func (w *Wallet) GetNodePrivateKeyBytes() ([]byte, error) {
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return nil, errors.New("Wallet is not initialized")
+		return nil, w.getUninitializedError()
+	}
+
+	// The node account's key never leaves the external signer
+	if w.nodeSigner != nil {
+		return nil, errors.New("The node account is held by an external signer")
 	}
 
 	// Get private key
@@ -147,3 +265,21 @@ func (w *Wallet) getNodeDerivedKey(index uint) (*hdkeychain.ExtendedKey, string,
 	return key, derivationPath, nil
 
 }
+
+// Set the chain ID of an unsigned transaction, which is normally only added when it's signed
+func withChainID(tx *types.Transaction, chainID *big.Int) *types.Transaction {
+	if tx.Type() != types.DynamicFeeTxType {
+		return tx
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      tx.Nonce(),
+		GasTipCap:  tx.GasTipCap(),
+		GasFeeCap:  tx.GasFeeCap(),
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	})
+}
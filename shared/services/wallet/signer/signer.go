@@ -0,0 +1,244 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Config
+const (
+	// Hardware wallets wait for the user to confirm on the device, so give them plenty of time
+	RequestTimeout = 5 * time.Minute
+)
+
+// An account that signs transactions and messages without exposing its private key
+type NodeSigner interface {
+	GetAddress() common.Address
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignMessage(message []byte) ([]byte, error)
+}
+
+// JSON-RPC methods used by a type of external signer
+type rpcMethods struct {
+	name            string
+	isClef          bool
+	signTransaction string
+	signMessage     string
+}
+
+var (
+	clefMethods = rpcMethods{
+		name:            "Clef",
+		isClef:          true,
+		signTransaction: "account_signTransaction",
+		signMessage:     "account_signData",
+	}
+	eip1193Methods = rpcMethods{
+		name:            "EIP-1193 bridge",
+		signTransaction: "eth_signTransaction",
+		signMessage:     "personal_sign",
+	}
+)
+
+// An external signer reached over JSON-RPC
+type RpcSigner struct {
+	url     string
+	address common.Address
+	methods rpcMethods
+}
+
+// Transaction arguments understood by both Clef and EIP-1193 providers
+type transactionArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId,omitempty"`
+}
+
+// Clef's transaction signing result
+type clefSignTransactionResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// Create a signer for a Clef-compatible JSON-RPC API
+func NewClefSigner(url string, address common.Address) *RpcSigner {
+	return &RpcSigner{
+		url:     url,
+		address: address,
+		methods: clefMethods,
+	}
+}
+
+// Create a signer for an EIP-1193 JSON-RPC bridge to a hardware wallet
+func NewEip1193Signer(url string, address common.Address) *RpcSigner {
+	return &RpcSigner{
+		url:     url,
+		address: address,
+		methods: eip1193Methods,
+	}
+}
+
+// Get the address of the account held by the signer
+func (s *RpcSigner) GetAddress() common.Address {
+	return s.address
+}
+
+// Sign a transaction with the external signer
+func (s *RpcSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+
+	// Build the transaction args
+	args := transactionArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+
+	// Request the signature
+	var raw hexutil.Bytes
+	if s.methods.isClef {
+		var result clefSignTransactionResult
+		if err := s.call(&result, s.methods.signTransaction, args); err != nil {
+			return nil, err
+		}
+		raw = result.Raw
+	} else {
+		if err := s.call(&raw, s.methods.signTransaction, args); err != nil {
+			return nil, err
+		}
+	}
+
+	// Decode it and make sure the signer didn't change it
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("error decoding transaction signed by %s: %w", s.methods.name, err)
+	}
+	if signedTx.Hash() != types.NewTx(signedTxData(tx, signedTx)).Hash() {
+		return nil, fmt.Errorf("%s returned a different transaction than the one it was asked to sign", s.methods.name)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("error recovering the sender of the transaction signed by %s: %w", s.methods.name, err)
+	}
+	if sender != s.address {
+		return nil, fmt.Errorf("%s signed the transaction with %s instead of the node account %s", s.methods.name, sender.Hex(), s.address.Hex())
+	}
+	return signedTx, nil
+
+}
+
+// Sign an arbitrary message with the external signer, returning a signature with a 27 / 28 recovery ID
+func (s *RpcSigner) SignMessage(message []byte) ([]byte, error) {
+
+	// Request the signature
+	var signature hexutil.Bytes
+	var err error
+	if s.methods.isClef {
+		err = s.call(&signature, s.methods.signMessage, accounts.MimetypeTextPlain, common.NewMixedcaseAddress(s.address), hexutil.Encode(message))
+	} else {
+		err = s.call(&signature, s.methods.signMessage, hexutil.Encode(message), s.address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("%s returned a signature of %d bytes instead of %d", s.methods.name, len(signature), crypto.SignatureLength)
+	}
+
+	// Normalize the recovery ID
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+
+	// Make sure it was signed by the node account
+	recoverable := make([]byte, crypto.SignatureLength)
+	copy(recoverable, signature)
+	recoverable[crypto.RecoveryIDOffset] -= 27
+	pubkey, err := crypto.SigToPub(accounts.TextHash(message), recoverable)
+	if err != nil {
+		return nil, fmt.Errorf("error recovering the signer of the message signed by %s: %w", s.methods.name, err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubkey); signer != s.address {
+		return nil, fmt.Errorf("%s signed the message with %s instead of the node account %s", s.methods.name, signer.Hex(), s.address.Hex())
+	}
+	return signature, nil
+
+}
+
+// Call a method on the signer's JSON-RPC API
+func (s *RpcSigner) call(result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+
+	client, err := rpc.DialContext(ctx, s.url)
+	if err != nil {
+		return fmt.Errorf("error connecting to %s at %s: %w", s.methods.name, s.url, err)
+	}
+	defer client.Close()
+
+	if err := client.CallContext(ctx, result, method, args...); err != nil {
+		return fmt.Errorf("error calling %s on %s: %w", method, s.methods.name, err)
+	}
+	return nil
+}
+
+// Rebuild the unsigned transaction with the signature of the signed one, so the two can be compared
+func signedTxData(unsigned *types.Transaction, signed *types.Transaction) types.TxData {
+	v, r, s := signed.RawSignatureValues()
+	switch unsigned.Type() {
+	case types.DynamicFeeTxType:
+		return &types.DynamicFeeTx{
+			ChainID:    signed.ChainId(),
+			Nonce:      unsigned.Nonce(),
+			GasTipCap:  unsigned.GasTipCap(),
+			GasFeeCap:  unsigned.GasFeeCap(),
+			Gas:        unsigned.Gas(),
+			To:         unsigned.To(),
+			Value:      unsigned.Value(),
+			Data:       unsigned.Data(),
+			AccessList: signed.AccessList(),
+			V:          v,
+			R:          r,
+			S:          s,
+		}
+	default:
+		return &types.LegacyTx{
+			Nonce:    unsigned.Nonce(),
+			GasPrice: unsigned.GasPrice(),
+			Gas:      unsigned.Gas(),
+			To:       unsigned.To(),
+			Value:    unsigned.Value(),
+			Data:     unsigned.Data(),
+			V:        v,
+			R:        r,
+			S:        s,
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// A mock signer that holds a single key
+type mockSigner struct {
+	key     *ecdsa.PrivateKey
+	chainID *big.Int
+}
+
+// Clef's account namespace
+type mockClefApi struct {
+	m *mockSigner
+}
+
+// EIP-1193's eth namespace
+type mockEthApi struct {
+	m *mockSigner
+}
+
+// EIP-1193's personal namespace
+type mockPersonalApi struct {
+	m *mockSigner
+}
+
+func (m *mockSigner) signTx(args transactionArgs) (hexutil.Bytes, error) {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   (*big.Int)(args.ChainID),
+		Nonce:     uint64(args.Nonce),
+		GasTipCap: (*big.Int)(args.MaxPriorityFeePerGas),
+		GasFeeCap: (*big.Int)(args.MaxFeePerGas),
+		Gas:       uint64(args.Gas),
+		To:        args.To,
+		Value:     (*big.Int)(args.Value),
+		Data:      args.Data,
+	})
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(m.chainID), m.key)
+	if err != nil {
+		return nil, err
+	}
+	return signedTx.MarshalBinary()
+}
+
+func (m *mockSigner) signMessage(data hexutil.Bytes, recoveryOffset byte) (hexutil.Bytes, error) {
+	signature, err := crypto.Sign(accounts.TextHash(data), m.key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += recoveryOffset
+	return signature, nil
+}
+
+func (api *mockClefApi) SignTransaction(args transactionArgs) (*clefSignTransactionResult, error) {
+	raw, err := api.m.signTx(args)
+	if err != nil {
+		return nil, err
+	}
+	return &clefSignTransactionResult{Raw: raw}, nil
+}
+
+func (api *mockClefApi) SignData(contentType string, address common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	// Clef returns signatures with a 27 / 28 recovery ID
+	return api.m.signMessage(data, 27)
+}
+
+func (api *mockEthApi) SignTransaction(args transactionArgs) (hexutil.Bytes, error) {
+	return api.m.signTx(args)
+}
+
+func (api *mockPersonalApi) Sign(data hexutil.Bytes, address common.Address) (hexutil.Bytes, error) {
+	// Some hardware wallet bridges return signatures with a 0 / 1 recovery ID
+	return api.m.signMessage(data, 0)
+}
+
+// Start a mock JSON-RPC signer and return its URL
+func startMockSigner(t *testing.T, m *mockSigner) string {
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", &mockClefApi{m: m}); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RegisterName("eth", &mockEthApi{m: m}); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.RegisterName("personal", &mockPersonalApi{m: m}); err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
+func newMockSigner(t *testing.T) *mockSigner {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mockSigner{
+		key:     key,
+		chainID: big.NewInt(17000),
+	}
+}
+
+func testSigner(t *testing.T, s NodeSigner, chainID *big.Int) {
+	// Sign a transaction
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       100000,
+		To:        &to,
+		Value:     big.NewInt(1e18),
+		Data:      []byte{0xde, 0xad, 0xbe, 0xef},
+	})
+	signedTx, err := s.SignTx(tx, chainID)
+	if err != nil {
+		t.Fatalf("error signing transaction: %s", err.Error())
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != s.GetAddress() {
+		t.Errorf("expected transaction sender %s, got %s", s.GetAddress().Hex(), sender.Hex())
+	}
+	if signedTx.Nonce() != tx.Nonce() || signedTx.Value().Cmp(tx.Value()) != 0 || *signedTx.To() != to {
+		t.Error("signed transaction doesn't match the original")
+	}
+
+	// Sign a message
+	message := []byte("hello rocket pool")
+	signature, err := s.SignMessage(message)
+	if err != nil {
+		t.Fatalf("error signing message: %s", err.Error())
+	}
+	recoveryID := signature[crypto.RecoveryIDOffset]
+	if recoveryID != 27 && recoveryID != 28 {
+		t.Errorf("expected a 27 / 28 recovery ID, got %d", recoveryID)
+	}
+}
+
+func TestClefSigner(t *testing.T) {
+	m := newMockSigner(t)
+	url := startMockSigner(t, m)
+	testSigner(t, NewClefSigner(url, crypto.PubkeyToAddress(m.key.PublicKey)), m.chainID)
+}
+
+func TestEip1193Signer(t *testing.T) {
+	m := newMockSigner(t)
+	url := startMockSigner(t, m)
+	testSigner(t, NewEip1193Signer(url, crypto.PubkeyToAddress(m.key.PublicKey)), m.chainID)
+}
+
+func TestSignerRejectsWrongAccount(t *testing.T) {
+	m := newMockSigner(t)
+	url := startMockSigner(t, m)
+	other := newMockSigner(t)
+	s := NewClefSigner(url, crypto.PubkeyToAddress(other.key.PublicKey))
+
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   m.chainID,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	if _, err := s.SignTx(tx, m.chainID); err == nil {
+		t.Error("expected an error for a transaction signed by the wrong account")
+	}
+	if _, err := s.SignMessage([]byte("hello")); err == nil {
+		t.Error("expected an error for a message signed by the wrong account")
+	}
+}
@@ -2,13 +2,16 @@ package wallet
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/types"
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 	eth2util "github.com/wealdtech/go-eth2-util"
@@ -32,7 +35,7 @@ func (w *Wallet) GetValidatorKeyCount() (uint, error) {
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return 0, errors.New("Wallet is not initialized")
+		return 0, w.getUninitializedError()
 	}
 
 	// Return validator key count
@@ -45,7 +48,7 @@ func (w *Wallet) GetValidatorKeyAt(index uint) (*eth2types.BLSPrivateKey, error)
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return nil, errors.New("Wallet is not initialized")
+		return nil, w.getUninitializedError()
 	}
 
 	// Return validator key
@@ -59,7 +62,7 @@ func (w *Wallet) GetValidatorKeyByPubkey(pubkey rptypes.ValidatorPubkey) (*eth2t
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return nil, errors.New("Wallet is not initialized")
+		return nil, w.getUninitializedError()
 	}
 
 	// Load the key from the wallet's keystores
@@ -72,7 +75,7 @@ func (w *Wallet) CreateValidatorKey() (*eth2types.BLSPrivateKey, error) {
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return nil, errors.New("Wallet is not initialized")
+		return nil, w.getUninitializedError()
 	}
 
 	// Get & increment account index
@@ -136,11 +139,72 @@ func (w *Wallet) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSP
 
 }
 
+// Get the remote signer holding the wallet's validator keys, or nil if they are stored locally
+func (w *Wallet) GetRemoteSigner() keystore.RemoteSigner {
+	for name := range w.keystores {
+		if signer, ok := w.keystores[name].(keystore.RemoteSigner); ok {
+			return signer
+		}
+	}
+	return nil
+}
+
+// Get signed deposit data & root for a validator, using the remote signer if there is one
+func (w *Wallet) GetDepositData(pubkey types.ValidatorPubkey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (eth2.DepositData, common.Hash, error) {
+
+	// Sign locally if the key is available
+	signer := w.GetRemoteSigner()
+	if signer == nil {
+		validatorKey, err := w.GetValidatorKeyByPubkey(pubkey)
+		if err != nil {
+			return eth2.DepositData{}, common.Hash{}, err
+		}
+		return validator.GetDepositData(validatorKey, withdrawalCredentials, eth2Config, depositAmount)
+	}
+
+	// Have the remote signer sign it
+	dd, signingRoot, err := validator.GetDepositDataSigningRoot(pubkey, withdrawalCredentials, eth2Config, depositAmount)
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
+	}
+	signature, err := signer.SignDeposit(pubkey, dd, signingRoot, eth2Config)
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
+	}
+	return validator.GetSignedDepositData(dd, signature)
+
+}
+
+// Get a signed voluntary exit message for a validator, using the remote signer if there is one
+func (w *Wallet) GetSignedExitMessage(pubkey types.ValidatorPubkey, validatorIndex string, epoch uint64, signatureDomain []byte, eth2Config beacon.Eth2Config) (types.ValidatorSignature, error) {
+
+	// Sign locally if the key is available
+	signer := w.GetRemoteSigner()
+	if signer == nil {
+		validatorKey, err := w.GetValidatorKeyByPubkey(pubkey)
+		if err != nil {
+			return types.ValidatorSignature{}, err
+		}
+		return validator.GetSignedExitMessage(validatorKey, validatorIndex, epoch, signatureDomain)
+	}
+
+	// Have the remote signer sign it
+	exitMessage, signingRoot, err := validator.GetExitMessageSigningRoot(validatorIndex, epoch, signatureDomain)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	return signer.SignVoluntaryExit(pubkey, exitMessage, signingRoot, eth2Config)
+
+}
+
 // Deletes all of the keystore directories and persistent VC storage
 func (w *Wallet) DeleteValidatorStores() error {
 
 	for name := range w.keystores {
 		keystorePath := w.keystores[name].GetKeystoreDir()
+		if keystorePath == "" {
+			continue
+		}
 		err := os.RemoveAll(keystorePath)
 		if err != nil {
 			return fmt.Errorf("error deleting validator directory for %s: %w", name, err)
@@ -156,7 +220,7 @@ func (w *Wallet) GetNextValidatorKey() (*eth2types.BLSPrivateKey, error) {
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return nil, errors.New("Wallet is not initialized")
+		return nil, w.getUninitializedError()
 	}
 
 	// Get account index
@@ -178,7 +242,7 @@ func (w *Wallet) GetValidatorKeys(startIndex uint, length uint) ([]ValidatorKey,
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return nil, errors.New("Wallet is not initialized")
+		return nil, w.getUninitializedError()
 	}
 
 	validatorKeys := make([]ValidatorKey, 0, length)
@@ -226,7 +290,7 @@ func (w *Wallet) RecoverValidatorKey(pubkey rptypes.ValidatorPubkey, startIndex
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return 0, errors.New("Wallet is not initialized")
+		return 0, w.getUninitializedError()
 	}
 
 	// Find matching validator key
@@ -272,7 +336,7 @@ func (w *Wallet) TestRecoverValidatorKey(pubkey rptypes.ValidatorPubkey, startIn
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return 0, errors.New("Wallet is not initialized")
+		return 0, w.getUninitializedError()
 	}
 
 	// Find matching validator key
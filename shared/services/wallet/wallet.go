@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/goccy/go-json"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/signer"
 )
 
 // Config
@@ -31,6 +33,9 @@ const (
 	MyEtherWalletNodeKeyPath = "m/44'/60'/0'/%d"
 )
 
+// Returned by anything that needs the node's keys while the wallet is in watch-only mode
+var ErrWatchOnly = errors.New("the node is in watch-only mode and has no keys, so it can't sign transactions or messages")
+
 // Wallet
 type Wallet struct {
 
@@ -51,6 +56,15 @@ type Wallet struct {
 	nodeKey     *ecdsa.PrivateKey
 	nodeKeyPath string
 
+	// External node account signer
+	nodeSigner signer.NodeSigner
+
+	// If set, the node account's transactions are built but not signed or sent, and passed to this instead
+	offlineExport func(from common.Address, tx *types.Transaction)
+
+	// If set, the wallet has no keys and only reports this address as the node account
+	watchOnlyAddress *common.Address
+
 	// Validator key caches
 	validatorKeys map[uint]*eth2types.BLSPrivateKey
 
@@ -106,11 +120,44 @@ func (w *Wallet) GetChainID() *big.Int {
 	return copy
 }
 
+// Sets the desired gas price & limit used by the wallet's transactors
+func (w *Wallet) SetGasSettings(maxFee *big.Int, maxPriorityFee *big.Int, gasLimit uint64) {
+	w.maxFee = maxFee
+	w.maxPriorityFee = maxPriorityFee
+	w.gasLimit = gasLimit
+}
+
 // Add a keystore to the wallet
 func (w *Wallet) AddKeystore(name string, ks keystore.Keystore) {
 	w.keystores[name] = ks
 }
 
+// Back the node account with an external signer
+func (w *Wallet) SetNodeSigner(nodeSigner signer.NodeSigner) {
+	w.nodeSigner = nodeSigner
+}
+
+// Check if the node account is held by an external signer
+func (w *Wallet) IsNodeAccountExternal() bool {
+	return w.nodeSigner != nil
+}
+
+// Build the node account's transactions without signing or sending them, passing them to export instead so they can be signed offline.
+// Set export to nil to sign and send transactions normally.
+func (w *Wallet) SetOfflineExport(export func(from common.Address, tx *types.Transaction)) {
+	w.offlineExport = export
+}
+
+// Put the wallet in watch-only mode, where the node account is the given address and nothing can be signed
+func (w *Wallet) SetWatchOnlyAddress(address common.Address) {
+	w.watchOnlyAddress = &address
+}
+
+// Check if the wallet is in watch-only mode
+func (w *Wallet) IsWatchOnly() bool {
+	return w.watchOnlyAddress != nil
+}
+
 // Check if the wallet has been initialized
 func (w *Wallet) IsInitialized() bool {
 	return (w.ws != nil && w.seed != nil && w.mk != nil)
@@ -118,7 +165,7 @@ func (w *Wallet) IsInitialized() bool {
 
 // Attempt to initialize the wallet if not initialized and return status
 func (w *Wallet) GetInitialized() (bool, error) {
-	if w.IsInitialized() {
+	if w.IsInitialized() || w.IsWatchOnly() {
 		return true, nil
 	}
 	return w.loadStore()
@@ -129,7 +176,7 @@ func (w *Wallet) String() (string, error) {
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return "", errors.New("Wallet is not initialized")
+		return "", w.getUninitializedError()
 	}
 
 	// Encode wallet store
@@ -146,6 +193,11 @@ func (w *Wallet) String() (string, error) {
 // Initialize the wallet from a random seed
 func (w *Wallet) Initialize(derivationPath string, walletIndex uint) (string, error) {
 
+	// Watch-only nodes never hold keys
+	if w.IsWatchOnly() {
+		return "", ErrWatchOnly
+	}
+
 	// Check wallet is not initialized
 	if w.IsInitialized() {
 		return "", errors.New("Wallet is already initialized")
@@ -176,6 +228,11 @@ func (w *Wallet) Initialize(derivationPath string, walletIndex uint) (string, er
 // Recover a wallet from a mnemonic
 func (w *Wallet) Recover(derivationPath string, walletIndex uint, mnemonic string) error {
 
+	// Watch-only nodes never hold keys
+	if w.IsWatchOnly() {
+		return ErrWatchOnly
+	}
+
 	// Check wallet is not initialized
 	if w.IsInitialized() {
 		return errors.New("Wallet is already initialized")
@@ -234,7 +291,7 @@ func (w *Wallet) Save() error {
 
 	// Check wallet is initialized
 	if !w.IsInitialized() {
-		return errors.New("Wallet is not initialized")
+		return w.getUninitializedError()
 	}
 
 	// Encode wallet store
@@ -270,22 +327,30 @@ func (w *Wallet) Delete() error {
 
 }
 
-// Signs a serialized TX using the wallet's private key
+// Signs a serialized TX using the node account
 func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
-	// Get private key
-	privateKey, _, err := w.getNodePrivateKey()
-	if err != nil {
-		return nil, err
+	if w.IsWatchOnly() {
+		return nil, ErrWatchOnly
 	}
 
 	tx := types.Transaction{}
-	err = tx.UnmarshalBinary(serializedTx)
+	err := tx.UnmarshalBinary(serializedTx)
 	if err != nil {
 		return nil, fmt.Errorf("Error unmarshalling TX: %w", err)
 	}
 
-	signer := types.NewLondonSigner(w.chainID)
-	signedTx, err := types.SignTx(&tx, signer, privateKey)
+	var signedTx *types.Transaction
+	if w.nodeSigner != nil {
+		// Sign with the external signer
+		signedTx, err = w.nodeSigner.SignTx(&tx, w.chainID)
+	} else {
+		// Get private key
+		privateKey, _, keyErr := w.getNodePrivateKey()
+		if keyErr != nil {
+			return nil, keyErr
+		}
+		signedTx, err = types.SignTx(&tx, types.NewLondonSigner(w.chainID), privateKey)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("Error signing TX: %w", err)
 	}
@@ -298,8 +363,21 @@ func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
 	return signedData, nil
 }
 
-// Signs an arbitrary message using the wallet's private key
+// Signs an arbitrary message using the node account
 func (w *Wallet) SignMessage(message string) ([]byte, error) {
+	if w.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
+
+	// Sign with the external signer if there is one; messages always come from the node account itself
+	if w.nodeSigner != nil {
+		signedMessage, err := w.nodeSigner.SignMessage([]byte(message))
+		if err != nil {
+			return nil, fmt.Errorf("Error signing message: %w", err)
+		}
+		return signedMessage, nil
+	}
+
 	// Get the wallet's private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
@@ -406,3 +484,11 @@ func (w *Wallet) initializeStore(derivationPath string, walletIndex uint, mnemon
 	return nil
 
 }
+
+// Get the error for an operation that needs the wallet's keys when they aren't loaded
+func (w *Wallet) getUninitializedError() error {
+	if w.IsWatchOnly() {
+		return ErrWatchOnly
+	}
+	return errors.New("Wallet is not initialized")
+}
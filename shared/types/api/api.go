@@ -1,6 +1,41 @@
 package api
 
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
 type APIResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+// The unsigned transactions built by an API command run in offline export mode
+type OfflineTransactionsResponse struct {
+	OfflineTransactions []OfflineTransaction `json:"offlineTransactions,omitempty"`
+}
+
+// An unsigned transaction exported for signing on an offline machine
+type OfflineTransaction struct {
+	From        common.Address     `json:"from"`
+	Transaction *types.Transaction `json:"transaction"`
+}
+
+// A request to run an API command on the API server
+type APIServerRequest struct {
+	Args            []string `json:"args"`
+	MaxFee          float64  `json:"maxFee"`
+	MaxPrioFee      float64  `json:"maxPrioFee"`
+	GasLimit        uint64   `json:"gasLimit"`
+	Nonce           string   `json:"nonce"`
+	IgnoreSyncCheck bool     `json:"ignoreSyncCheck"`
+	ForceFallbacks  bool     `json:"forceFallbacks"`
+	OfflineExport   bool     `json:"offlineExport"`
+}
+
+// The API commands the API server can run, by their space-separated command path (e.g. node status)
+type APIServerCommandsResponse struct {
+	Status   string   `json:"status"`
+	Error    string   `json:"error"`
+	Commands []string `json:"commands"`
+}
@@ -36,12 +36,13 @@ type LotDetails struct {
 }
 
 type CanCreateLotResponse struct {
-	Status              string             `json:"status"`
-	Error               string             `json:"error"`
-	CanCreate           bool               `json:"canCreate"`
-	InsufficientBalance bool               `json:"insufficientBalance"`
-	CreateLotDisabled   bool               `json:"createLotDisabled"`
-	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Status              string                 `json:"status"`
+	Error               string                 `json:"error"`
+	CanCreate           bool                   `json:"canCreate"`
+	InsufficientBalance bool                   `json:"insufficientBalance"`
+	CreateLotDisabled   bool                   `json:"createLotDisabled"`
+	GasInfo             rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation          *TransactionSimulation `json:"simulation"`
 }
 type CreateLotResponse struct {
 	Status string      `json:"status"`
@@ -51,14 +52,15 @@ type CreateLotResponse struct {
 }
 
 type CanBidOnLotResponse struct {
-	Status           string             `json:"status"`
-	Error            string             `json:"error"`
-	CanBid           bool               `json:"canBid"`
-	DoesNotExist     bool               `json:"doesNotExist"`
-	BiddingEnded     bool               `json:"biddingEnded"`
-	RPLExhausted     bool               `json:"rplExhausted"`
-	BidOnLotDisabled bool               `json:"bidOnLotDisabled"`
-	GasInfo          rocketpool.GasInfo `json:"gasInfo"`
+	Status           string                 `json:"status"`
+	Error            string                 `json:"error"`
+	CanBid           bool                   `json:"canBid"`
+	DoesNotExist     bool                   `json:"doesNotExist"`
+	BiddingEnded     bool                   `json:"biddingEnded"`
+	RPLExhausted     bool                   `json:"rplExhausted"`
+	BidOnLotDisabled bool                   `json:"bidOnLotDisabled"`
+	GasInfo          rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation       *TransactionSimulation `json:"simulation"`
 }
 type BidOnLotResponse struct {
 	Status string      `json:"status"`
@@ -67,13 +69,14 @@ type BidOnLotResponse struct {
 }
 
 type CanClaimFromLotResponse struct {
-	Status           string             `json:"status"`
-	Error            string             `json:"error"`
-	CanClaim         bool               `json:"canClaim"`
-	DoesNotExist     bool               `json:"doesNotExist"`
-	NoBidFromAddress bool               `json:"noBidFromAddress"`
-	NotCleared       bool               `json:"notCleared"`
-	GasInfo          rocketpool.GasInfo `json:"gasInfo"`
+	Status           string                 `json:"status"`
+	Error            string                 `json:"error"`
+	CanClaim         bool                   `json:"canClaim"`
+	DoesNotExist     bool                   `json:"doesNotExist"`
+	NoBidFromAddress bool                   `json:"noBidFromAddress"`
+	NotCleared       bool                   `json:"notCleared"`
+	GasInfo          rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation       *TransactionSimulation `json:"simulation"`
 }
 type ClaimFromLotResponse struct {
 	Status string      `json:"status"`
@@ -82,14 +85,15 @@ type ClaimFromLotResponse struct {
 }
 
 type CanRecoverRPLFromLotResponse struct {
-	Status              string             `json:"status"`
-	Error               string             `json:"error"`
-	CanRecover          bool               `json:"canRecover"`
-	DoesNotExist        bool               `json:"doesNotExist"`
-	BiddingNotEnded     bool               `json:"biddingNotEnded"`
-	NoUnclaimedRPL      bool               `json:"noUnclaimedRpl"`
-	RPLAlreadyRecovered bool               `json:"rplAlreadyRecovered"`
-	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Status              string                 `json:"status"`
+	Error               string                 `json:"error"`
+	CanRecover          bool                   `json:"canRecover"`
+	DoesNotExist        bool                   `json:"doesNotExist"`
+	BiddingNotEnded     bool                   `json:"biddingNotEnded"`
+	NoUnclaimedRPL      bool                   `json:"noUnclaimedRpl"`
+	RPLAlreadyRecovered bool                   `json:"rplAlreadyRecovered"`
+	GasInfo             rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation          *TransactionSimulation `json:"simulation"`
 }
 type RecoverRPLFromLotResponse struct {
 	Status string      `json:"status"`
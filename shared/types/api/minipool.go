@@ -53,23 +53,25 @@ type ValidatorDetails struct {
 	NodeBalance *big.Int `json:"nodeBalance"`
 }
 type MinipoolBalanceDistributionDetails struct {
-	Address            common.Address       `json:"address"`
-	Balance            *big.Int             `json:"balance"`
-	Refund             *big.Int             `json:"refund"`
-	NodeShareOfBalance *big.Int             `json:"nodeShareOfBalance"`
-	MinipoolVersion    uint8                `json:"minipoolVersion"`
-	Status             types.MinipoolStatus `json:"status"`
-	IsFinalized        bool                 `json:"isFinalized"`
-	CanDistribute      bool                 `json:"canDistribute"`
-	GasInfo            rocketpool.GasInfo   `json:"gasInfo"`
+	Address            common.Address         `json:"address"`
+	Balance            *big.Int               `json:"balance"`
+	Refund             *big.Int               `json:"refund"`
+	NodeShareOfBalance *big.Int               `json:"nodeShareOfBalance"`
+	MinipoolVersion    uint8                  `json:"minipoolVersion"`
+	Status             types.MinipoolStatus   `json:"status"`
+	IsFinalized        bool                   `json:"isFinalized"`
+	CanDistribute      bool                   `json:"canDistribute"`
+	GasInfo            rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation         *TransactionSimulation `json:"simulation"`
 }
 
 type CanRefundMinipoolResponse struct {
-	Status                    string             `json:"status"`
-	Error                     string             `json:"error"`
-	CanRefund                 bool               `json:"canRefund"`
-	InsufficientRefundBalance bool               `json:"insufficientRefundBalance"`
-	GasInfo                   rocketpool.GasInfo `json:"gasInfo"`
+	Status                    string                 `json:"status"`
+	Error                     string                 `json:"error"`
+	CanRefund                 bool                   `json:"canRefund"`
+	InsufficientRefundBalance bool                   `json:"insufficientRefundBalance"`
+	GasInfo                   rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation                *TransactionSimulation `json:"simulation"`
 }
 type RefundMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -78,11 +80,12 @@ type RefundMinipoolResponse struct {
 }
 
 type CanDissolveMinipoolResponse struct {
-	Status        string             `json:"status"`
-	Error         string             `json:"error"`
-	CanDissolve   bool               `json:"canDissolve"`
-	InvalidStatus bool               `json:"invalidStatus"`
-	GasInfo       rocketpool.GasInfo `json:"gasInfo"`
+	Status        string                 `json:"status"`
+	Error         string                 `json:"error"`
+	CanDissolve   bool                   `json:"canDissolve"`
+	InvalidStatus bool                   `json:"invalidStatus"`
+	GasInfo       rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation    *TransactionSimulation `json:"simulation"`
 }
 type DissolveMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -143,18 +146,19 @@ type ProcessWithdrawalAndFinaliseResponse struct {
 }
 
 type MinipoolCloseDetails struct {
-	Address            common.Address        `json:"address"`
-	IsFinalized        bool                  `json:"isFinalized"`
-	MinipoolStatus     types.MinipoolStatus  `json:"minipoolStatus"`
-	MinipoolVersion    uint8                 `json:"minipoolVersion"`
-	Distributed        bool                  `json:"distributed"`
-	CanClose           bool                  `json:"canClose"`
-	Balance            *big.Int              `json:"balance"`
-	Refund             *big.Int              `json:"refund"`
-	UserDepositBalance *big.Int              `json:"userDepositBalance"`
-	BeaconState        beacon.ValidatorState `json:"beaconState"`
-	NodeShare          *big.Int              `json:"nodeShare"`
-	GasInfo            rocketpool.GasInfo    `json:"gasInfo"`
+	Address            common.Address         `json:"address"`
+	IsFinalized        bool                   `json:"isFinalized"`
+	MinipoolStatus     types.MinipoolStatus   `json:"minipoolStatus"`
+	MinipoolVersion    uint8                  `json:"minipoolVersion"`
+	Distributed        bool                   `json:"distributed"`
+	CanClose           bool                   `json:"canClose"`
+	Balance            *big.Int               `json:"balance"`
+	Refund             *big.Int               `json:"refund"`
+	UserDepositBalance *big.Int               `json:"userDepositBalance"`
+	BeaconState        beacon.ValidatorState  `json:"beaconState"`
+	NodeShare          *big.Int               `json:"nodeShare"`
+	GasInfo            rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation         *TransactionSimulation `json:"simulation"`
 }
 
 type GetMinipoolCloseDetailsForNodeResponse struct {
@@ -193,6 +197,22 @@ type DistributeBalanceResponse struct {
 	Error  string      `json:"error"`
 	TxHash common.Hash `json:"txHash"`
 }
+type CanBatchDistributeBalanceResponse struct {
+	Status      string                    `json:"status"`
+	Error       string                    `json:"error"`
+	Batches     []MinipoolDistributeBatch `json:"batches"`
+	Unbatchable []common.Address          `json:"unbatchable"`
+}
+type MinipoolDistributeBatch struct {
+	Minipools  []common.Address       `json:"minipools"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
+}
+type BatchDistributeBalanceResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
 
 type CanFinaliseMinipoolResponse struct {
 	Status  string             `json:"status"`
@@ -206,10 +226,11 @@ type FinaliseMinipoolResponse struct {
 }
 
 type CanDelegateUpgradeResponse struct {
-	Status                string             `json:"status"`
-	Error                 string             `json:"error"`
-	LatestDelegateAddress common.Address     `json:"latestDelegateAddress"`
-	GasInfo               rocketpool.GasInfo `json:"gasInfo"`
+	Status                string                 `json:"status"`
+	Error                 string                 `json:"error"`
+	LatestDelegateAddress common.Address         `json:"latestDelegateAddress"`
+	GasInfo               rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation            *TransactionSimulation `json:"simulation"`
 }
 type DelegateUpgradeResponse struct {
 	Status string      `json:"status"`
@@ -218,10 +239,11 @@ type DelegateUpgradeResponse struct {
 }
 
 type CanDelegateRollbackResponse struct {
-	Status          string             `json:"status"`
-	Error           string             `json:"error"`
-	RollbackAddress common.Address     `json:"rollbackAddress"`
-	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Status          string                 `json:"status"`
+	Error           string                 `json:"error"`
+	RollbackAddress common.Address         `json:"rollbackAddress"`
+	GasInfo         rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation      *TransactionSimulation `json:"simulation"`
 }
 type DelegateRollbackResponse struct {
 	Status string      `json:"status"`
@@ -230,9 +252,10 @@ type DelegateRollbackResponse struct {
 }
 
 type CanSetUseLatestDelegateResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type SetUseLatestDelegateResponse struct {
 	Status string      `json:"status"`
@@ -241,10 +264,11 @@ type SetUseLatestDelegateResponse struct {
 }
 
 type CanStakeMinipoolResponse struct {
-	Status   string             `json:"status"`
-	Error    string             `json:"error"`
-	CanStake bool               `json:"canStake"`
-	GasInfo  rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanStake   bool                   `json:"canStake"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type StakeMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -253,10 +277,11 @@ type StakeMinipoolResponse struct {
 }
 
 type CanPromoteMinipoolResponse struct {
-	Status     string             `json:"status"`
-	Error      string             `json:"error"`
-	CanPromote bool               `json:"canPromote"`
-	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanPromote bool                   `json:"canPromote"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type PromoteMinipoolResponse struct {
 	Status string      `json:"status"`
@@ -297,17 +322,18 @@ type GetVanityArtifactsResponse struct {
 }
 
 type CanBeginReduceBondAmountResponse struct {
-	Status                string                `json:"status"`
-	Error                 string                `json:"error"`
-	BondReductionDisabled bool                  `json:"bondReductionDisabled"`
-	MinipoolVersionTooLow bool                  `json:"minipoolVersionTooLow"`
-	Balance               uint64                `json:"balance"`
-	BalanceTooLow         bool                  `json:"balanceTooLow"`
-	MatchRequest          *big.Int              `json:"matchRequest"`
-	BeaconState           beacon.ValidatorState `json:"beaconState"`
-	InvalidBeaconState    bool                  `json:"invalidBeaconState"`
-	CanReduce             bool                  `json:"canReduce"`
-	GasInfo               rocketpool.GasInfo    `json:"gasInfo"`
+	Status                string                 `json:"status"`
+	Error                 string                 `json:"error"`
+	BondReductionDisabled bool                   `json:"bondReductionDisabled"`
+	MinipoolVersionTooLow bool                   `json:"minipoolVersionTooLow"`
+	Balance               uint64                 `json:"balance"`
+	BalanceTooLow         bool                   `json:"balanceTooLow"`
+	MatchRequest          *big.Int               `json:"matchRequest"`
+	BeaconState           beacon.ValidatorState  `json:"beaconState"`
+	InvalidBeaconState    bool                   `json:"invalidBeaconState"`
+	CanReduce             bool                   `json:"canReduce"`
+	GasInfo               rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation            *TransactionSimulation `json:"simulation"`
 }
 type BeginReduceBondAmountResponse struct {
 	Status string      `json:"status"`
@@ -316,11 +342,12 @@ type BeginReduceBondAmountResponse struct {
 }
 
 type CanReduceBondAmountResponse struct {
-	Status          string             `json:"status"`
-	Error           string             `json:"error"`
-	MinipoolVersion uint8              `json:"minipoolVersion"`
-	CanReduce       bool               `json:"canReduce"`
-	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Status          string                 `json:"status"`
+	Error           string                 `json:"error"`
+	MinipoolVersion uint8                  `json:"minipoolVersion"`
+	CanReduce       bool                   `json:"canReduce"`
+	GasInfo         rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation      *TransactionSimulation `json:"simulation"`
 }
 type ReduceBondAmountResponse struct {
 	Status string      `json:"status"`
@@ -344,6 +371,12 @@ type GetMinipoolRescueDissolvedDetailsForNodeResponse struct {
 	Error   string                           `json:"error"`
 	Details []MinipoolRescueDissolvedDetails `json:"details"`
 }
+type CanRescueDissolvedMinipoolResponse struct {
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
+}
 type RescueDissolvedMinipoolResponse struct {
 	Status string      `json:"status"`
 	Error  string      `json:"error"`
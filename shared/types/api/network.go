@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/gas/feehistory"
 )
 
 type NodeFeeResponse struct {
@@ -93,3 +95,9 @@ type GetLatestDelegateResponse struct {
 	Error   string         `json:"error"`
 	Address common.Address `json:"address"`
 }
+
+type GasFeeSuggestionResponse struct {
+	Status     string                      `json:"status"`
+	Error      string                      `json:"error"`
+	Suggestion feehistory.GasFeeSuggestion `json:"suggestion"`
+}
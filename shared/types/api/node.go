@@ -11,6 +11,8 @@ import (
 	"github.com/rocket-pool/rocketpool-go/tokens"
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
@@ -153,12 +155,13 @@ func (n NodeAlert) ColorString() string {
 }
 
 type CanRegisterNodeResponse struct {
-	Status               string             `json:"status"`
-	Error                string             `json:"error"`
-	CanRegister          bool               `json:"canRegister"`
-	AlreadyRegistered    bool               `json:"alreadyRegistered"`
-	RegistrationDisabled bool               `json:"registrationDisabled"`
-	GasInfo              rocketpool.GasInfo `json:"gasInfo"`
+	Status               string                 `json:"status"`
+	Error                string                 `json:"error"`
+	CanRegister          bool                   `json:"canRegister"`
+	AlreadyRegistered    bool                   `json:"alreadyRegistered"`
+	RegistrationDisabled bool                   `json:"registrationDisabled"`
+	GasInfo              rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation           *TransactionSimulation `json:"simulation"`
 }
 type RegisterNodeResponse struct {
 	Status string      `json:"status"`
@@ -167,10 +170,11 @@ type RegisterNodeResponse struct {
 }
 
 type CanSetNodePrimaryWithdrawalAddressResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	CanSet  bool               `json:"canSet"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanSet     bool                   `json:"canSet"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type SetNodePrimaryWithdrawalAddressResponse struct {
 	Status string      `json:"status"`
@@ -179,10 +183,11 @@ type SetNodePrimaryWithdrawalAddressResponse struct {
 }
 
 type CanConfirmNodePrimaryWithdrawalAddressResponse struct {
-	Status     string             `json:"status"`
-	Error      string             `json:"error"`
-	CanConfirm bool               `json:"canConfirm"`
-	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanConfirm bool                   `json:"canConfirm"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type ConfirmNodePrimaryWithdrawalAddressResponse struct {
 	Status string      `json:"status"`
@@ -191,13 +196,14 @@ type ConfirmNodePrimaryWithdrawalAddressResponse struct {
 }
 
 type CanSetNodeRPLWithdrawalAddressResponse struct {
-	Status                string             `json:"status"`
-	Error                 string             `json:"error"`
-	CanSet                bool               `json:"canSet"`
-	PrimaryAddressDiffers bool               `json:"primaryAddressDiffers"`
-	RPLAddressDiffers     bool               `json:"rplAddressDiffers"`
-	RPLStake              *big.Int           `json:"rplStake"`
-	GasInfo               rocketpool.GasInfo `json:"gasInfo"`
+	Status                string                 `json:"status"`
+	Error                 string                 `json:"error"`
+	CanSet                bool                   `json:"canSet"`
+	PrimaryAddressDiffers bool                   `json:"primaryAddressDiffers"`
+	RPLAddressDiffers     bool                   `json:"rplAddressDiffers"`
+	RPLStake              *big.Int               `json:"rplStake"`
+	GasInfo               rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation            *TransactionSimulation `json:"simulation"`
 }
 type SetNodeRPLWithdrawalAddressResponse struct {
 	Status string      `json:"status"`
@@ -206,10 +212,11 @@ type SetNodeRPLWithdrawalAddressResponse struct {
 }
 
 type CanConfirmNodeRPLWithdrawalAddressResponse struct {
-	Status     string             `json:"status"`
-	Error      string             `json:"error"`
-	CanConfirm bool               `json:"canConfirm"`
-	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanConfirm bool                   `json:"canConfirm"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type ConfirmNodeRPLWithdrawalAddressResponse struct {
 	Status string      `json:"status"`
@@ -230,10 +237,11 @@ type GetNodePendingPrimaryWithdrawalAddressResponse struct {
 }
 
 type CanSetNodeTimezoneResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	CanSet  bool               `json:"canSet"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanSet     bool                   `json:"canSet"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type SetNodeTimezoneResponse struct {
 	Status string      `json:"status"`
@@ -242,16 +250,18 @@ type SetNodeTimezoneResponse struct {
 }
 
 type CanNodeSwapRplResponse struct {
-	Status              string             `json:"status"`
-	Error               string             `json:"error"`
-	CanSwap             bool               `json:"canSwap"`
-	InsufficientBalance bool               `json:"insufficientBalance"`
-	GasInfo             rocketpool.GasInfo `json:"GasInfo"`
+	Status              string                 `json:"status"`
+	Error               string                 `json:"error"`
+	CanSwap             bool                   `json:"canSwap"`
+	InsufficientBalance bool                   `json:"insufficientBalance"`
+	GasInfo             rocketpool.GasInfo     `json:"GasInfo"`
+	Simulation          *TransactionSimulation `json:"simulation"`
 }
 type NodeSwapRplApproveGasResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type NodeSwapRplApproveResponse struct {
 	Status        string      `json:"status"`
@@ -270,17 +280,19 @@ type NodeSwapRplAllowanceResponse struct {
 }
 
 type CanNodeStakeRplResponse struct {
-	Status              string             `json:"status"`
-	Error               string             `json:"error"`
-	CanStake            bool               `json:"canStake"`
-	InsufficientBalance bool               `json:"insufficientBalance"`
-	InConsensus         bool               `json:"inConsensus"`
-	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Status              string                 `json:"status"`
+	Error               string                 `json:"error"`
+	CanStake            bool                   `json:"canStake"`
+	InsufficientBalance bool                   `json:"insufficientBalance"`
+	InConsensus         bool                   `json:"inConsensus"`
+	GasInfo             rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation          *TransactionSimulation `json:"simulation"`
 }
 type NodeStakeRplApproveGasResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type NodeStakeRplApproveResponse struct {
 	Status        string      `json:"status"`
@@ -299,10 +311,11 @@ type NodeStakeRplAllowanceResponse struct {
 }
 
 type CanSetRplLockingAllowedResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	CanSet  bool               `json:"canSet"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanSet     bool                   `json:"canSet"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 
 type SetRplLockingAllowedResponse struct {
@@ -311,10 +324,11 @@ type SetRplLockingAllowedResponse struct {
 	SetTxHash common.Hash `json:"setTxHash"`
 }
 type CanSetStakeRplForAllowedResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	CanSet  bool               `json:"canSet"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanSet     bool                   `json:"canSet"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type SetStakeRplForAllowedResponse struct {
 	Status    string      `json:"status"`
@@ -322,12 +336,13 @@ type SetStakeRplForAllowedResponse struct {
 	SetTxHash common.Hash `json:"setTxHash"`
 }
 type CanNodeWithdrawEthResponse struct {
-	Status                        string             `json:"status"`
-	Error                         string             `json:"error"`
-	CanWithdraw                   bool               `json:"canWithdraw"`
-	InsufficientBalance           bool               `json:"insufficientBalance"`
-	HasDifferentWithdrawalAddress bool               `json:"hasDifferentWithdrawalAddress"`
-	GasInfo                       rocketpool.GasInfo `json:"gasInfo"`
+	Status                        string                 `json:"status"`
+	Error                         string                 `json:"error"`
+	CanWithdraw                   bool                   `json:"canWithdraw"`
+	InsufficientBalance           bool                   `json:"insufficientBalance"`
+	HasDifferentWithdrawalAddress bool                   `json:"hasDifferentWithdrawalAddress"`
+	GasInfo                       rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation                    *TransactionSimulation `json:"simulation"`
 }
 type NodeWithdrawEthResponse struct {
 	Status string      `json:"status"`
@@ -335,15 +350,16 @@ type NodeWithdrawEthResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 type CanNodeWithdrawRplResponse struct {
-	Status                           string             `json:"status"`
-	Error                            string             `json:"error"`
-	CanWithdraw                      bool               `json:"canWithdraw"`
-	InsufficientBalance              bool               `json:"insufficientBalance"`
-	BelowMaxRPLStake                 bool               `json:"belowMaxRPLStake"`
-	MinipoolsUndercollateralized     bool               `json:"minipoolsUndercollateralized"`
-	WithdrawalDelayActive            bool               `json:"withdrawalDelayActive"`
-	HasDifferentRPLWithdrawalAddress bool               `json:"hasDifferentRPLWithdrawalAddress"`
-	GasInfo                          rocketpool.GasInfo `json:"gasInfo"`
+	Status                           string                 `json:"status"`
+	Error                            string                 `json:"error"`
+	CanWithdraw                      bool                   `json:"canWithdraw"`
+	InsufficientBalance              bool                   `json:"insufficientBalance"`
+	BelowMaxRPLStake                 bool                   `json:"belowMaxRPLStake"`
+	MinipoolsUndercollateralized     bool                   `json:"minipoolsUndercollateralized"`
+	WithdrawalDelayActive            bool                   `json:"withdrawalDelayActive"`
+	HasDifferentRPLWithdrawalAddress bool                   `json:"hasDifferentRPLWithdrawalAddress"`
+	GasInfo                          rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation                       *TransactionSimulation `json:"simulation"`
 }
 type NodeWithdrawRplResponse struct {
 	Status string      `json:"status"`
@@ -352,22 +368,23 @@ type NodeWithdrawRplResponse struct {
 }
 
 type CanNodeDepositResponse struct {
-	Status                           string             `json:"status"`
-	Error                            string             `json:"error"`
-	CanDeposit                       bool               `json:"canDeposit"`
-	CreditBalance                    *big.Int           `json:"creditBalance"`
-	DepositBalance                   *big.Int           `json:"depositBalance"`
-	CanUseCredit                     bool               `json:"canUseCredit"`
-	NodeBalance                      *big.Int           `json:"nodeBalance"`
-	InsufficientBalance              bool               `json:"insufficientBalance"`
-	InsufficientBalanceWithoutCredit bool               `json:"insufficientBalanceWithoutCredit"`
-	InsufficientRplStake             bool               `json:"insufficientRplStake"`
-	InvalidAmount                    bool               `json:"invalidAmount"`
-	UnbondedMinipoolsAtMax           bool               `json:"unbondedMinipoolsAtMax"`
-	DepositDisabled                  bool               `json:"depositDisabled"`
-	InConsensus                      bool               `json:"inConsensus"`
-	MinipoolAddress                  common.Address     `json:"minipoolAddress"`
-	GasInfo                          rocketpool.GasInfo `json:"gasInfo"`
+	Status                           string                 `json:"status"`
+	Error                            string                 `json:"error"`
+	CanDeposit                       bool                   `json:"canDeposit"`
+	CreditBalance                    *big.Int               `json:"creditBalance"`
+	DepositBalance                   *big.Int               `json:"depositBalance"`
+	CanUseCredit                     bool                   `json:"canUseCredit"`
+	NodeBalance                      *big.Int               `json:"nodeBalance"`
+	InsufficientBalance              bool                   `json:"insufficientBalance"`
+	InsufficientBalanceWithoutCredit bool                   `json:"insufficientBalanceWithoutCredit"`
+	InsufficientRplStake             bool                   `json:"insufficientRplStake"`
+	InvalidAmount                    bool                   `json:"invalidAmount"`
+	UnbondedMinipoolsAtMax           bool                   `json:"unbondedMinipoolsAtMax"`
+	DepositDisabled                  bool                   `json:"depositDisabled"`
+	InConsensus                      bool                   `json:"inConsensus"`
+	MinipoolAddress                  common.Address         `json:"minipoolAddress"`
+	GasInfo                          rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation                       *TransactionSimulation `json:"simulation"`
 }
 type NodeDepositResponse struct {
 	Status          string                  `json:"status"`
@@ -379,14 +396,15 @@ type NodeDepositResponse struct {
 }
 
 type CanCreateVacantMinipoolResponse struct {
-	Status               string             `json:"status"`
-	Error                string             `json:"error"`
-	CanDeposit           bool               `json:"canDeposit"`
-	InsufficientRplStake bool               `json:"insufficientRplStake"`
-	InvalidAmount        bool               `json:"invalidAmount"`
-	DepositDisabled      bool               `json:"depositDisabled"`
-	MinipoolAddress      common.Address     `json:"minipoolAddress"`
-	GasInfo              rocketpool.GasInfo `json:"gasInfo"`
+	Status               string                 `json:"status"`
+	Error                string                 `json:"error"`
+	CanDeposit           bool                   `json:"canDeposit"`
+	InsufficientRplStake bool                   `json:"insufficientRplStake"`
+	InvalidAmount        bool                   `json:"invalidAmount"`
+	DepositDisabled      bool                   `json:"depositDisabled"`
+	MinipoolAddress      common.Address         `json:"minipoolAddress"`
+	GasInfo              rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation           *TransactionSimulation `json:"simulation"`
 }
 type CreateVacantMinipoolResponse struct {
 	Status                string         `json:"status"`
@@ -398,14 +416,15 @@ type CreateVacantMinipoolResponse struct {
 }
 
 type CanNodeSendResponse struct {
-	Status              string             `json:"status"`
-	Error               string             `json:"error"`
-	Balance             *big.Int           `json:"balance"`
-	TokenName           string             `json:"name"`
-	TokenSymbol         string             `json:"symbol"`
-	CanSend             bool               `json:"canSend"`
-	InsufficientBalance bool               `json:"insufficientBalance"`
-	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Status              string                 `json:"status"`
+	Error               string                 `json:"error"`
+	Balance             *big.Int               `json:"balance"`
+	TokenName           string                 `json:"name"`
+	TokenSymbol         string                 `json:"symbol"`
+	CanSend             bool                   `json:"canSend"`
+	InsufficientBalance bool                   `json:"insufficientBalance"`
+	GasInfo             rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation          *TransactionSimulation `json:"simulation"`
 }
 type NodeSendResponse struct {
 	Status string      `json:"status"`
@@ -414,9 +433,10 @@ type NodeSendResponse struct {
 }
 
 type CanNodeSendMessageResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type NodeSendMessageResponse struct {
 	Status string      `json:"status"`
@@ -425,12 +445,13 @@ type NodeSendMessageResponse struct {
 }
 
 type CanNodeBurnResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanBurn                bool               `json:"canBurn"`
-	InsufficientBalance    bool               `json:"insufficientBalance"`
-	InsufficientCollateral bool               `json:"insufficientCollateral"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanBurn                bool                   `json:"canBurn"`
+	InsufficientBalance    bool                   `json:"insufficientBalance"`
+	InsufficientCollateral bool                   `json:"insufficientCollateral"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
 }
 type NodeBurnResponse struct {
 	Status string      `json:"status"`
@@ -446,10 +467,11 @@ type NodeSyncProgressResponse struct {
 }
 
 type CanNodeClaimRplResponse struct {
-	Status    string             `json:"status"`
-	Error     string             `json:"error"`
-	RplAmount *big.Int           `json:"rplAmount"`
-	GasInfo   rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	RplAmount  *big.Int               `json:"rplAmount"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type NodeClaimRplResponse struct {
 	Status string      `json:"status"`
@@ -480,6 +502,13 @@ type NodeRewardsResponse struct {
 	TxHash                      common.Hash   `json:"txHash"`
 }
 
+type NodeRewardsLedgerResponse struct {
+	Status           string                `json:"status"`
+	Error            string                `json:"error"`
+	Events           []rewards.LedgerEvent `json:"events"`
+	MissingIntervals []uint64              `json:"missingIntervals"`
+}
+
 type DepositContractInfoResponse struct {
 	Status                string         `json:"status"`
 	Error                 string         `json:"error"`
@@ -497,9 +526,10 @@ type NodeSignResponse struct {
 }
 
 type EstimateSetSnapshotDelegateGasResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 
 type SetSnapshotDelegateResponse struct {
@@ -509,9 +539,10 @@ type SetSnapshotDelegateResponse struct {
 }
 
 type EstimateClearSnapshotDelegateGasResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 
 type ClearSnapshotDelegateResponse struct {
@@ -526,10 +557,11 @@ type NodeIsFeeDistributorInitializedResponse struct {
 	IsInitialized bool   `json:"isInitialized"`
 }
 type NodeInitializeFeeDistributorGasResponse struct {
-	Status      string             `json:"status"`
-	Error       string             `json:"error"`
-	Distributor common.Address     `json:"distributor"`
-	GasInfo     rocketpool.GasInfo `json:"gasInfo"`
+	Status      string                 `json:"status"`
+	Error       string                 `json:"error"`
+	Distributor common.Address         `json:"distributor"`
+	GasInfo     rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation  *TransactionSimulation `json:"simulation"`
 }
 type NodeInitializeFeeDistributorResponse struct {
 	Status string      `json:"status"`
@@ -537,11 +569,12 @@ type NodeInitializeFeeDistributorResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 type NodeCanDistributeResponse struct {
-	Status    string             `json:"status"`
-	Error     string             `json:"error"`
-	Balance   *big.Int           `json:"balance"`
-	NodeShare float64            `json:"nodeShare"`
-	GasInfo   rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	Balance    *big.Int               `json:"balance"`
+	NodeShare  float64                `json:"nodeShare"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type NodeDistributeResponse struct {
 	Status string      `json:"status"`
@@ -569,9 +602,10 @@ type NodeGetRewardsInfoResponse struct {
 }
 
 type CanNodeClaimRewardsResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type NodeClaimRewardsResponse struct {
 	Status string      `json:"status"`
@@ -580,9 +614,10 @@ type NodeClaimRewardsResponse struct {
 }
 
 type CanNodeClaimAndStakeRewardsResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type NodeClaimAndStakeRewardsResponse struct {
 	Status string      `json:"status"`
@@ -597,9 +632,10 @@ type GetSmoothingPoolRegistrationStatusResponse struct {
 	TimeLeftUntilChangeable time.Duration `json:"timeLeftUntilChangeable"`
 }
 type CanSetSmoothingPoolRegistrationStatusResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type SetSmoothingPoolRegistrationStatusResponse struct {
 	Status string      `json:"status"`
@@ -671,6 +707,70 @@ type CheckCollateralResponse struct {
 	InsufficientCollateral bool     `json:"insufficientCollateral"`
 }
 
+type NodeCollateralProjectionResponse struct {
+	Status                   string   `json:"status"`
+	Error                    string   `json:"error"`
+	RplStake                 *big.Int `json:"rplStake"`
+	RplPrice                 *big.Int `json:"rplPrice"`
+	BorrowedEth              *big.Int `json:"borrowedEth"`
+	BondedEth                *big.Int `json:"bondedEth"`
+	MinimumCollateralPercent float64  `json:"minimumCollateralPercent"`
+	MaximumCollateralPercent float64  `json:"maximumCollateralPercent"`
+	BorrowedCollateralRatio  float64  `json:"borrowedCollateralRatio"`
+	BondedCollateralRatio    float64  `json:"bondedCollateralRatio"`
+	MinimumRplPrice          *big.Int `json:"minimumRplPrice"`
+	MaximumRplPrice          *big.Int `json:"maximumRplPrice"`
+	TargetRatio              float64  `json:"targetRatio"`
+	RplNeededForTarget       *big.Int `json:"rplNeededForTarget"`
+}
+
+type NodeSnapshotStatusResponse struct {
+	Status                   string                    `json:"status"`
+	Error                    string                    `json:"error"`
+	Slot                     uint64                    `json:"slot"`
+	Block                    uint64                    `json:"block"`
+	Time                     time.Time                 `json:"time"`
+	AccountAddress           common.Address            `json:"accountAddress"`
+	Registered               bool                      `json:"registered"`
+	WithdrawalAddress        common.Address            `json:"withdrawalAddress"`
+	PendingWithdrawalAddress common.Address            `json:"pendingWithdrawalAddress"`
+	TimezoneLocation         string                    `json:"timezoneLocation"`
+	AccountBalances          tokens.Balances           `json:"accountBalances"`
+	DepositCreditBalance     *big.Int                  `json:"depositCreditBalance"`
+	RplPrice                 *big.Int                  `json:"rplPrice"`
+	RplStake                 *big.Int                  `json:"rplStake"`
+	EffectiveRplStake        *big.Int                  `json:"effectiveRplStake"`
+	MinimumRplStake          *big.Int                  `json:"minimumRplStake"`
+	MaximumRplStake          *big.Int                  `json:"maximumRplStake"`
+	EthMatched               *big.Int                  `json:"ethMatched"`
+	EthMatchedLimit          *big.Int                  `json:"ethMatchedLimit"`
+	BorrowedCollateralRatio  float64                   `json:"borrowedCollateralRatio"`
+	BondedCollateralRatio    float64                   `json:"bondedCollateralRatio"`
+	SmoothingPoolRegistered  bool                      `json:"smoothingPoolRegistered"`
+	FeeDistributorAddress    common.Address            `json:"feeDistributorAddress"`
+	FeeDistributorBalance    *big.Int                  `json:"feeDistributorBalance"`
+	Minipools                []SnapshotMinipoolDetails `json:"minipools"`
+}
+type SnapshotMinipoolDetails struct {
+	Address            common.Address          `json:"address"`
+	ValidatorPubkey    rptypes.ValidatorPubkey `json:"validatorPubkey"`
+	Status             rptypes.MinipoolStatus  `json:"status"`
+	StatusTime         time.Time               `json:"statusTime"`
+	Finalised          bool                    `json:"finalised"`
+	NodeFee            float64                 `json:"nodeFee"`
+	NodeDepositBalance *big.Int                `json:"nodeDepositBalance"`
+	UserDepositBalance *big.Int                `json:"userDepositBalance"`
+	Balance            *big.Int                `json:"balance"`
+	NodeShareOfBalance *big.Int                `json:"nodeShareOfBalance"`
+	Validator          ValidatorDetails        `json:"validator"`
+}
+
+type NodeSnapshotsResponse struct {
+	Status    string               `json:"status"`
+	Error     string               `json:"error"`
+	Snapshots []state.SnapshotInfo `json:"snapshots"`
+}
+
 type NodeEthBalanceResponse struct {
 	Status  string   `json:"status"`
 	Error   string   `json:"error"`
@@ -683,3 +783,60 @@ type NodeAlertsResponse struct {
 	// TODO: change to GettableAlerts
 	Message string `json:"message"`
 }
+
+type BroadcastTransactionResponse struct {
+	Status string         `json:"status"`
+	Error  string         `json:"error"`
+	From   common.Address `json:"from"`
+	TxHash common.Hash    `json:"txHash"`
+}
+
+type PendingTransaction struct {
+	Daemon               string          `json:"daemon"`
+	From                 common.Address  `json:"from"`
+	Nonce                uint64          `json:"nonce"`
+	TxHash               common.Hash     `json:"txHash"`
+	To                   *common.Address `json:"to"`
+	MaxFeeWei            *big.Int        `json:"maxFeeWei"`
+	MaxPriorityFeeWei    *big.Int        `json:"maxPriorityFeeWei"`
+	Replacements         int             `json:"replacements"`
+	FirstSent            time.Time       `json:"firstSent"`
+	LastSent             time.Time       `json:"lastSent"`
+	CancelMaxFeeWei      *big.Int        `json:"cancelMaxFeeWei"`
+	CancelPriorityFeeWei *big.Int        `json:"cancelPriorityFeeWei"`
+}
+type PendingAccountNonces struct {
+	Address         common.Address `json:"address"`
+	ConfirmedNonce  uint64         `json:"confirmedNonce"`
+	PendingNonce    uint64         `json:"pendingNonce"`
+	UntrackedNonces []uint64       `json:"untrackedNonces"`
+}
+type PendingTransactionsResponse struct {
+	Status       string                 `json:"status"`
+	Error        string                 `json:"error"`
+	Transactions []PendingTransaction   `json:"transactions"`
+	Accounts     []PendingAccountNonces `json:"accounts"`
+}
+
+type CancelPendingTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
+type DeferTransactionResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	ID     uint64 `json:"id"`
+}
+
+type DeferredTransactionsResponse struct {
+	Status       string                          `json:"status"`
+	Error        string                          `json:"error"`
+	Transactions []txmanager.DeferredTransaction `json:"transactions"`
+}
+
+type CancelDeferredTransactionResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
@@ -48,12 +48,13 @@ type TNDAOProposalResponse struct {
 }
 
 type CanProposeTNDAOInviteResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanPropose             bool               `json:"canPropose"`
-	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
-	MemberAlreadyExists    bool               `json:"memberAlreadyExists"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanPropose             bool                   `json:"canPropose"`
+	ProposalCooldownActive bool                   `json:"proposalCooldownActive"`
+	MemberAlreadyExists    bool                   `json:"memberAlreadyExists"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
 }
 type ProposeTNDAOInviteResponse struct {
 	Status     string      `json:"status"`
@@ -63,12 +64,13 @@ type ProposeTNDAOInviteResponse struct {
 }
 
 type CanProposeTNDAOLeaveResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanPropose             bool               `json:"canPropose"`
-	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
-	InsufficientMembers    bool               `json:"insufficientMembers"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanPropose             bool                   `json:"canPropose"`
+	ProposalCooldownActive bool                   `json:"proposalCooldownActive"`
+	InsufficientMembers    bool                   `json:"insufficientMembers"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
 }
 type ProposeTNDAOLeaveResponse struct {
 	Status     string      `json:"status"`
@@ -93,12 +95,13 @@ type ProposeTNDAOReplaceResponse struct {
 }
 
 type CanProposeTNDAOKickResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanPropose             bool               `json:"canPropose"`
-	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
-	InsufficientRplBond    bool               `json:"insufficientRplBond"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanPropose             bool                   `json:"canPropose"`
+	ProposalCooldownActive bool                   `json:"proposalCooldownActive"`
+	InsufficientRplBond    bool                   `json:"insufficientRplBond"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
 }
 type ProposeTNDAOKickResponse struct {
 	Status     string      `json:"status"`
@@ -108,13 +111,14 @@ type ProposeTNDAOKickResponse struct {
 }
 
 type CanCancelTNDAOProposalResponse struct {
-	Status          string             `json:"status"`
-	Error           string             `json:"error"`
-	CanCancel       bool               `json:"canCancel"`
-	DoesNotExist    bool               `json:"doesNotExist"`
-	InvalidState    bool               `json:"invalidState"`
-	InvalidProposer bool               `json:"invalidProposer"`
-	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Status          string                 `json:"status"`
+	Error           string                 `json:"error"`
+	CanCancel       bool                   `json:"canCancel"`
+	DoesNotExist    bool                   `json:"doesNotExist"`
+	InvalidState    bool                   `json:"invalidState"`
+	InvalidProposer bool                   `json:"invalidProposer"`
+	GasInfo         rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation      *TransactionSimulation `json:"simulation"`
 }
 type CancelTNDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -123,14 +127,15 @@ type CancelTNDAOProposalResponse struct {
 }
 
 type CanVoteOnTNDAOProposalResponse struct {
-	Status             string             `json:"status"`
-	Error              string             `json:"error"`
-	CanVote            bool               `json:"canVote"`
-	DoesNotExist       bool               `json:"doesNotExist"`
-	InvalidState       bool               `json:"invalidState"`
-	JoinedAfterCreated bool               `json:"joinedAfterCreated"`
-	AlreadyVoted       bool               `json:"alreadyVoted"`
-	GasInfo            rocketpool.GasInfo `json:"gasInfo"`
+	Status             string                 `json:"status"`
+	Error              string                 `json:"error"`
+	CanVote            bool                   `json:"canVote"`
+	DoesNotExist       bool                   `json:"doesNotExist"`
+	InvalidState       bool                   `json:"invalidState"`
+	JoinedAfterCreated bool                   `json:"joinedAfterCreated"`
+	AlreadyVoted       bool                   `json:"alreadyVoted"`
+	GasInfo            rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation         *TransactionSimulation `json:"simulation"`
 }
 type VoteOnTNDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -139,12 +144,13 @@ type VoteOnTNDAOProposalResponse struct {
 }
 
 type CanExecuteTNDAOProposalResponse struct {
-	Status       string             `json:"status"`
-	Error        string             `json:"error"`
-	CanExecute   bool               `json:"canExecute"`
-	DoesNotExist bool               `json:"doesNotExist"`
-	InvalidState bool               `json:"invalidState"`
-	GasInfo      rocketpool.GasInfo `json:"gasInfo"`
+	Status       string                 `json:"status"`
+	Error        string                 `json:"error"`
+	CanExecute   bool                   `json:"canExecute"`
+	DoesNotExist bool                   `json:"doesNotExist"`
+	InvalidState bool                   `json:"invalidState"`
+	GasInfo      rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation   *TransactionSimulation `json:"simulation"`
 }
 type ExecuteTNDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -153,13 +159,14 @@ type ExecuteTNDAOProposalResponse struct {
 }
 
 type CanJoinTNDAOResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanJoin                bool               `json:"canJoin"`
-	ProposalExpired        bool               `json:"proposalExpired"`
-	AlreadyMember          bool               `json:"alreadyMember"`
-	InsufficientRplBalance bool               `json:"insufficientRplBalance"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanJoin                bool                   `json:"canJoin"`
+	ProposalExpired        bool                   `json:"proposalExpired"`
+	AlreadyMember          bool                   `json:"alreadyMember"`
+	InsufficientRplBalance bool                   `json:"insufficientRplBalance"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
 }
 type JoinTNDAOApproveResponse struct {
 	Status        string      `json:"status"`
@@ -173,12 +180,13 @@ type JoinTNDAOJoinResponse struct {
 }
 
 type CanLeaveTNDAOResponse struct {
-	Status              string             `json:"status"`
-	Error               string             `json:"error"`
-	CanLeave            bool               `json:"canLeave"`
-	ProposalExpired     bool               `json:"proposalExpired"`
-	InsufficientMembers bool               `json:"insufficientMembers"`
-	GasInfo             rocketpool.GasInfo `json:"gasInfo"`
+	Status              string                 `json:"status"`
+	Error               string                 `json:"error"`
+	CanLeave            bool                   `json:"canLeave"`
+	ProposalExpired     bool                   `json:"proposalExpired"`
+	InsufficientMembers bool                   `json:"insufficientMembers"`
+	GasInfo             rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation          *TransactionSimulation `json:"simulation"`
 }
 type LeaveTNDAOResponse struct {
 	Status string      `json:"status"`
@@ -201,11 +209,12 @@ type ReplaceTNDAOPositionResponse struct {
 }
 
 type CanProposeTNDAOSettingResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanPropose             bool               `json:"canPropose"`
-	ProposalCooldownActive bool               `json:"proposalCooldownActive"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanPropose             bool                   `json:"canPropose"`
+	ProposalCooldownActive bool                   `json:"proposalCooldownActive"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
 }
 type ProposeTNDAOSettingMembersQuorumResponse struct {
 	Status     string      `json:"status"`
@@ -0,0 +1,42 @@
+package api
+
+import "github.com/ethereum/go-ethereum/common"
+
+// The format that CLI commands print their output in
+type OutputFormat string
+
+const (
+	OutputFormat_Text OutputFormat = "text"
+	OutputFormat_Json OutputFormat = "json"
+	OutputFormat_Yaml OutputFormat = "yaml"
+)
+
+// True if the format is a machine-readable one instead of the default prose
+func (f OutputFormat) IsStructured() bool {
+	return f == OutputFormat_Json || f == OutputFormat_Yaml
+}
+
+// Structured output of `node sync`
+type NodeSyncStatusOutput struct {
+	DepositContractInfo DepositContractInfoResponse `json:"depositContractInfo"`
+	SyncProgress        NodeSyncProgressResponse    `json:"syncProgress"`
+}
+
+// Structured output of `node rewards`
+type NodeRewardsOutput struct {
+	RewardsInfo NodeGetRewardsInfoResponse `json:"rewardsInfo"`
+	Rewards     *NodeRewardsResponse       `json:"rewards,omitempty"`
+}
+
+// Structured output of `network dao-proposals`
+type NetworkDAOProposalsOutput struct {
+	Proposals             NetworkDAOProposalsResponse       `json:"proposals"`
+	CurrentVotingDelegate PDAOCurrentVotingDelegateResponse `json:"currentVotingDelegate"`
+}
+
+// Structured output of `pdao voting-power`
+type PDAOVotingPowerOutput struct {
+	VotingPower           GetPDAOVotePowerResponse `json:"votingPower"`
+	IsVotingInitialized   bool                     `json:"isVotingInitialized"`
+	OnchainVotingDelegate common.Address           `json:"onchainVotingDelegate"`
+}
@@ -8,11 +8,15 @@ import (
 	"github.com/rocket-pool/rocketpool-go/dao/protocol"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 )
 
 type PDAOProposalWithNodeVoteDirection struct {
 	protocol.ProtocolDaoProposalDetails
-	NodeVoteDirection types.VoteDirection `json:"nodeVoteDirection"`
+	NodeVoteDirection types.VoteDirection        `json:"nodeVoteDirection"`
+	DecodedPayload    *proposals.ProposalPayload `json:"decodedPayload"`
+	PayloadError      string                     `json:"payloadError,omitempty"`
+	CurrentValueError string                     `json:"currentValueError,omitempty"`
 }
 
 type PDAOProposalsResponse struct {
@@ -43,15 +47,16 @@ type CancelPDAOProposalResponse struct {
 }
 
 type CanVoteOnPDAOProposalResponse struct {
-	Status            string             `json:"status"`
-	Error             string             `json:"error"`
-	CanVote           bool               `json:"canVote"`
-	DoesNotExist      bool               `json:"doesNotExist"`
-	InvalidState      bool               `json:"invalidState"`
-	InsufficientPower bool               `json:"insufficientPower"`
-	AlreadyVoted      bool               `json:"alreadyVoted"`
-	VotingPower       *big.Int           `json:"votingPower"`
-	GasInfo           rocketpool.GasInfo `json:"gasInfo"`
+	Status            string                 `json:"status"`
+	Error             string                 `json:"error"`
+	CanVote           bool                   `json:"canVote"`
+	DoesNotExist      bool                   `json:"doesNotExist"`
+	InvalidState      bool                   `json:"invalidState"`
+	InsufficientPower bool                   `json:"insufficientPower"`
+	AlreadyVoted      bool                   `json:"alreadyVoted"`
+	VotingPower       *big.Int               `json:"votingPower"`
+	GasInfo           rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation        *TransactionSimulation `json:"simulation"`
 }
 type VoteOnPDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -60,12 +65,13 @@ type VoteOnPDAOProposalResponse struct {
 }
 
 type CanExecutePDAOProposalResponse struct {
-	Status       string             `json:"status"`
-	Error        string             `json:"error"`
-	CanExecute   bool               `json:"canExecute"`
-	DoesNotExist bool               `json:"doesNotExist"`
-	InvalidState bool               `json:"invalidState"`
-	GasInfo      rocketpool.GasInfo `json:"gasInfo"`
+	Status       string                 `json:"status"`
+	Error        string                 `json:"error"`
+	CanExecute   bool                   `json:"canExecute"`
+	DoesNotExist bool                   `json:"doesNotExist"`
+	InvalidState bool                   `json:"invalidState"`
+	GasInfo      rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation   *TransactionSimulation `json:"simulation"`
 }
 type ExecutePDAOProposalResponse struct {
 	Status string      `json:"status"`
@@ -162,16 +168,17 @@ type GetPDAOSettingsResponse struct {
 }
 
 type CanProposePDAOSettingResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanPropose             bool               `json:"canPropose"`
-	InsufficientRpl        bool               `json:"proposalCooldownActive"`
-	StakedRpl              *big.Int           `json:"stakedRpl"`
-	LockedRpl              *big.Int           `json:"lockedRpl"`
-	ProposalBond           *big.Int           `json:"proposalBond"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanPropose             bool                   `json:"canPropose"`
+	InsufficientRpl        bool                   `json:"proposalCooldownActive"`
+	StakedRpl              *big.Int               `json:"stakedRpl"`
+	LockedRpl              *big.Int               `json:"lockedRpl"`
+	ProposalBond           *big.Int               `json:"proposalBond"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 type ProposePDAOSettingResponse struct {
 	Status     string      `json:"status"`
@@ -189,12 +196,13 @@ type PDAOGetRewardsPercentagesResponse struct {
 }
 
 type PDAOCanProposeRewardsPercentagesResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	CanPropose             bool               `json:"canPropose"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	CanPropose             bool                   `json:"canPropose"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 
 type PDAOProposeRewardsPercentagesResponse struct {
@@ -205,12 +213,13 @@ type PDAOProposeRewardsPercentagesResponse struct {
 }
 
 type PDAOCanProposeOneTimeSpendResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	CanPropose             bool               `json:"canPropose"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	CanPropose             bool                   `json:"canPropose"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 type PDAOProposeOneTimeSpendResponse struct {
 	Status     string      `json:"status"`
@@ -220,12 +229,13 @@ type PDAOProposeOneTimeSpendResponse struct {
 }
 
 type PDAOCanProposeRecurringSpendResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	CanPropose             bool               `json:"canPropose"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	CanPropose             bool                   `json:"canPropose"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 
 type PDAOProposeRecurringSpendResponse struct {
@@ -236,12 +246,13 @@ type PDAOProposeRecurringSpendResponse struct {
 }
 
 type PDAOCanProposeRecurringSpendUpdateResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	CanPropose             bool               `json:"canPropose"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	CanPropose             bool                   `json:"canPropose"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 
 type PDAOProposeRecurringSpendUpdateResponse struct {
@@ -252,13 +263,14 @@ type PDAOProposeRecurringSpendUpdateResponse struct {
 }
 
 type PDAOCanProposeInviteToSecurityCouncilResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanPropose             bool               `json:"canPropose"`
-	MemberAlreadyExists    bool               `json:"memberAlreadyExists"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanPropose             bool                   `json:"canPropose"`
+	MemberAlreadyExists    bool                   `json:"memberAlreadyExists"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 type PDAOProposeInviteToSecurityCouncilResponse struct {
 	Status     string      `json:"status"`
@@ -268,12 +280,13 @@ type PDAOProposeInviteToSecurityCouncilResponse struct {
 }
 
 type PDAOCanProposeKickFromSecurityCouncilResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	CanPropose             bool               `json:"canPropose"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	CanPropose             bool                   `json:"canPropose"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 type PDAOProposeKickFromSecurityCouncilResponse struct {
 	Status     string      `json:"status"`
@@ -283,10 +296,11 @@ type PDAOProposeKickFromSecurityCouncilResponse struct {
 }
 
 type PDAOCanProposeKickMultiFromSecurityCouncilResponse struct {
-	Status      string             `json:"status"`
-	Error       string             `json:"error"`
-	BlockNumber uint32             `json:"blockNumber"`
-	GasInfo     rocketpool.GasInfo `json:"gasInfo"`
+	Status      string                 `json:"status"`
+	Error       string                 `json:"error"`
+	BlockNumber uint32                 `json:"blockNumber"`
+	GasInfo     rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation  *TransactionSimulation `json:"simulation"`
 }
 type PDAOProposeKickMultiFromSecurityCouncilResponse struct {
 	Status     string      `json:"status"`
@@ -296,12 +310,13 @@ type PDAOProposeKickMultiFromSecurityCouncilResponse struct {
 }
 
 type PDAOCanProposeReplaceMemberOfSecurityCouncilResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	BlockNumber            uint32             `json:"blockNumber"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
-	CanPropose             bool               `json:"canPropose"`
-	IsRplLockingDisallowed bool               `json:"isRplLockingDisallowed"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	BlockNumber            uint32                 `json:"blockNumber"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
+	CanPropose             bool                   `json:"canPropose"`
+	IsRplLockingDisallowed bool                   `json:"isRplLockingDisallowed"`
 }
 
 type PDAOProposeReplaceMemberOfSecurityCouncilResponse struct {
@@ -327,13 +342,14 @@ type PDAOGetClaimableBondsResponse struct {
 }
 
 type PDAOCanClaimBondsResponse struct {
-	Status       string             `json:"status"`
-	Error        string             `json:"error"`
-	IsProposer   bool               `json:"isProposer"`
-	CanClaim     bool               `json:"canClaim"`
-	DoesNotExist bool               `json:"doesNotExist"`
-	InvalidState bool               `json:"invalidState"`
-	GasInfo      rocketpool.GasInfo `json:"gasInfo"`
+	Status       string                 `json:"status"`
+	Error        string                 `json:"error"`
+	IsProposer   bool                   `json:"isProposer"`
+	CanClaim     bool                   `json:"canClaim"`
+	DoesNotExist bool                   `json:"doesNotExist"`
+	InvalidState bool                   `json:"invalidState"`
+	GasInfo      rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation   *TransactionSimulation `json:"simulation"`
 }
 type PDAOClaimBondsResponse struct {
 	Status string      `json:"status"`
@@ -342,14 +358,15 @@ type PDAOClaimBondsResponse struct {
 }
 
 type PDAOCanDefeatProposalResponse struct {
-	Status                 string             `json:"status"`
-	Error                  string             `json:"error"`
-	CanDefeat              bool               `json:"canDefeat"`
-	DoesNotExist           bool               `json:"doesNotExist"`
-	AlreadyDefeated        bool               `json:"alreadyDefeated"`
-	StillInChallengeWindow bool               `json:"stillInChallengeWindow"`
-	InvalidChallengeState  bool               `json:"invalidChallengeState"`
-	GasInfo                rocketpool.GasInfo `json:"gasInfo"`
+	Status                 string                 `json:"status"`
+	Error                  string                 `json:"error"`
+	CanDefeat              bool                   `json:"canDefeat"`
+	DoesNotExist           bool                   `json:"doesNotExist"`
+	AlreadyDefeated        bool                   `json:"alreadyDefeated"`
+	StillInChallengeWindow bool                   `json:"stillInChallengeWindow"`
+	InvalidChallengeState  bool                   `json:"invalidChallengeState"`
+	GasInfo                rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation             *TransactionSimulation `json:"simulation"`
 }
 type PDAODefeatProposalResponse struct {
 	Status string      `json:"status"`
@@ -358,13 +375,14 @@ type PDAODefeatProposalResponse struct {
 }
 
 type PDAOCanFinalizeProposalResponse struct {
-	Status           string             `json:"status"`
-	Error            string             `json:"error"`
-	CanFinalize      bool               `json:"canFinalize"`
-	DoesNotExist     bool               `json:"doesNotExist"`
-	InvalidState     bool               `json:"invalidState"`
-	AlreadyFinalized bool               `json:"alreadyFinalized"`
-	GasInfo          rocketpool.GasInfo `json:"gasInfo"`
+	Status           string                 `json:"status"`
+	Error            string                 `json:"error"`
+	CanFinalize      bool                   `json:"canFinalize"`
+	DoesNotExist     bool                   `json:"doesNotExist"`
+	InvalidState     bool                   `json:"invalidState"`
+	AlreadyFinalized bool                   `json:"alreadyFinalized"`
+	GasInfo          rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation       *TransactionSimulation `json:"simulation"`
 }
 type PDAOFinalizeProposalResponse struct {
 	Status string      `json:"status"`
@@ -373,9 +391,10 @@ type PDAOFinalizeProposalResponse struct {
 }
 
 type PDAOCanSetVotingDelegateResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 
 type PDAOSetVotingDelegateResponse struct {
@@ -392,10 +411,11 @@ type PDAOCurrentVotingDelegateResponse struct {
 }
 
 type PDAOCanInitializeVotingResponse struct {
-	Status            string             `json:"status"`
-	Error             string             `json:"error"`
-	VotingInitialized bool               `json:"votingInitialized"`
-	GasInfo           rocketpool.GasInfo `json:"gasInfo"`
+	Status            string                 `json:"status"`
+	Error             string                 `json:"error"`
+	VotingInitialized bool                   `json:"votingInitialized"`
+	GasInfo           rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation        *TransactionSimulation `json:"simulation"`
 }
 
 type PDAOInitializeVotingResponse struct {
@@ -412,3 +432,90 @@ type GetPDAOVotePowerResponse struct {
 	OnchainVotingDelegateFormatted string         `json:"onchainVotingDelegateFormatted"`
 	BlockNumber                    uint32         `json:"blockNumber"`
 }
+
+type PDAODelegator struct {
+	Address          common.Address `json:"address"`
+	AddressFormatted string         `json:"addressFormatted"`
+	VotingPower      *big.Int       `json:"votingPower"`
+}
+type PDAODelegationGraphResponse struct {
+	Status               string          `json:"status"`
+	Error                string          `json:"error"`
+	BlockNumber          uint32          `json:"blockNumber"`
+	NodeAddress          common.Address  `json:"nodeAddress"`
+	VotingPower          *big.Int        `json:"votingPower"`
+	Delegate             common.Address  `json:"delegate"`
+	DelegateFormatted    string          `json:"delegateFormatted"`
+	DelegateVotingPower  *big.Int        `json:"delegateVotingPower"`
+	Delegators           []PDAODelegator `json:"delegators"`
+	DelegatedVotingPower *big.Int        `json:"delegatedVotingPower"`
+	TotalVotingPower     *big.Int        `json:"totalVotingPower"`
+}
+
+type PDAOSimulateVotingPowerResponse struct {
+	Status      string `json:"status"`
+	Error       string `json:"error"`
+	BlockNumber uint32 `json:"blockNumber"`
+
+	// The inputs to the voting power formula at the block
+	RplStake         *big.Int `json:"rplStake"`
+	EthMatched       *big.Int `json:"ethMatched"`
+	RplPrice         *big.Int `json:"rplPrice"`
+	MaxStakeFraction *big.Int `json:"maxStakeFraction"`
+
+	// The node's voting power at the block, as reported by the contracts and as calculated locally
+	OnchainVotingPower *big.Int `json:"onchainVotingPower"`
+	VotingPower        *big.Int `json:"votingPower"`
+
+	// The inputs and voting power after the hypothetical changes
+	SimulatedRplStake    *big.Int `json:"simulatedRplStake"`
+	SimulatedEthMatched  *big.Int `json:"simulatedEthMatched"`
+	SimulatedVotingPower *big.Int `json:"simulatedVotingPower"`
+
+	// The voting power delegated to the node, before and after the changes
+	IsSelfDelegated               bool            `json:"isSelfDelegated"`
+	DelegatedVotingPower          *big.Int        `json:"delegatedVotingPower"`
+	NewDelegators                 []PDAODelegator `json:"newDelegators"`
+	SimulatedDelegatedVotingPower *big.Int        `json:"simulatedDelegatedVotingPower"`
+	TotalVotingPower              *big.Int        `json:"totalVotingPower"`
+	SimulatedTotalVotingPower     *big.Int        `json:"simulatedTotalVotingPower"`
+}
+
+type PDAOVotingPolicyProposal struct {
+	ID                uint64                         `json:"id"`
+	Message           string                         `json:"message"`
+	State             types.ProtocolDaoProposalState `json:"state"`
+	NodeVoteDirection types.VoteDirection            `json:"nodeVoteDirection"`
+	Payload           *proposals.ProposalPayload     `json:"payload"`
+	PayloadError      string                         `json:"payloadError,omitempty"`
+	Decision          proposals.VotingPolicyDecision `json:"decision"`
+}
+type PDAOVotingPolicyResponse struct {
+	Status       string                     `json:"status"`
+	Error        string                     `json:"error"`
+	PolicyExists bool                       `json:"policyExists"`
+	Policy       *proposals.VotingPolicy    `json:"policy"`
+	Proposals    []PDAOVotingPolicyProposal `json:"proposals"`
+}
+
+type PDAORootSubmissionAudit struct {
+	proposals.RootSubmissionAudit
+	ChallengeState types.ChallengeState `json:"challengeState"`
+}
+type PDAOAuditProposalResponse struct {
+	Status           string                         `json:"status"`
+	Error            string                         `json:"error"`
+	SmartnodeVersion string                         `json:"smartnodeVersion"`
+	Network          string                         `json:"network"`
+	ProposalID       uint64                         `json:"proposalId"`
+	Message          string                         `json:"message"`
+	State            types.ProtocolDaoProposalState `json:"state"`
+	Proposer         common.Address                 `json:"proposer"`
+	TargetBlock      uint32                         `json:"targetBlock"`
+	OnchainRoot      types.VotingTreeNode           `json:"onchainRoot"`
+	LocalRoot        types.VotingTreeNode           `json:"localRoot"`
+	RootMatches      bool                           `json:"rootMatches"`
+	StartBlock       uint64                         `json:"startBlock"`
+	EndBlock         uint64                         `json:"endBlock"`
+	Submissions      []PDAORootSubmissionAudit      `json:"submissions"`
+}
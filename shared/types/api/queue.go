@@ -16,13 +16,14 @@ type QueueStatusResponse struct {
 }
 
 type CanProcessQueueResponse struct {
-	Status                     string             `json:"status"`
-	Error                      string             `json:"error"`
-	CanProcess                 bool               `json:"canProcess"`
-	AssignDepositsDisabled     bool               `json:"assignDepositsDisabled"`
-	NoMinipoolsAvailable       bool               `json:"noMinipoolsAvailable"`
-	InsufficientDepositBalance bool               `json:"insufficientDepositBalance"`
-	GasInfo                    rocketpool.GasInfo `json:"gasInfo"`
+	Status                     string                 `json:"status"`
+	Error                      string                 `json:"error"`
+	CanProcess                 bool                   `json:"canProcess"`
+	AssignDepositsDisabled     bool                   `json:"assignDepositsDisabled"`
+	NoMinipoolsAvailable       bool                   `json:"noMinipoolsAvailable"`
+	InsufficientDepositBalance bool                   `json:"insufficientDepositBalance"`
+	GasInfo                    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation                 *TransactionSimulation `json:"simulation"`
 }
 type ProcessQueueResponse struct {
 	Status string      `json:"status"`
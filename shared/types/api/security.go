@@ -59,11 +59,12 @@ type SecurityProposeInviteResponse struct {
 }
 
 type SecurityCanProposeLeaveResponse struct {
-	Status            string             `json:"status"`
-	Error             string             `json:"error"`
-	CanPropose        bool               `json:"canPropose"`
-	MemberDoesntExist bool               `json:"memberDoesntExist"`
-	GasInfo           rocketpool.GasInfo `json:"gasInfo"`
+	Status            string                 `json:"status"`
+	Error             string                 `json:"error"`
+	CanPropose        bool                   `json:"canPropose"`
+	MemberDoesntExist bool                   `json:"memberDoesntExist"`
+	GasInfo           rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation        *TransactionSimulation `json:"simulation"`
 }
 type SecurityProposeLeaveResponse struct {
 	Status string      `json:"status"`
@@ -98,10 +99,11 @@ type SecurityProposeKickMultiResponse struct {
 }
 
 type SecurityCanProposeSettingResponse struct {
-	Status     string             `json:"status"`
-	Error      string             `json:"error"`
-	CanPropose bool               `json:"canPropose"`
-	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	CanPropose bool                   `json:"canPropose"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 type SecurityProposeSettingResponse struct {
 	Status     string      `json:"status"`
@@ -126,13 +128,14 @@ type SecurityProposeReplaceResponse struct {
 }
 
 type SecurityCanCancelProposalResponse struct {
-	Status          string             `json:"status"`
-	Error           string             `json:"error"`
-	CanCancel       bool               `json:"canCancel"`
-	DoesNotExist    bool               `json:"doesNotExist"`
-	InvalidState    bool               `json:"invalidState"`
-	InvalidProposer bool               `json:"invalidProposer"`
-	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Status          string                 `json:"status"`
+	Error           string                 `json:"error"`
+	CanCancel       bool                   `json:"canCancel"`
+	DoesNotExist    bool                   `json:"doesNotExist"`
+	InvalidState    bool                   `json:"invalidState"`
+	InvalidProposer bool                   `json:"invalidProposer"`
+	GasInfo         rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation      *TransactionSimulation `json:"simulation"`
 }
 type SecurityCancelProposalResponse struct {
 	Status string      `json:"status"`
@@ -141,14 +144,15 @@ type SecurityCancelProposalResponse struct {
 }
 
 type SecurityCanVoteOnProposalResponse struct {
-	Status             string             `json:"status"`
-	Error              string             `json:"error"`
-	CanVote            bool               `json:"canVote"`
-	DoesNotExist       bool               `json:"doesNotExist"`
-	InvalidState       bool               `json:"invalidState"`
-	JoinedAfterCreated bool               `json:"joinedAfterCreated"`
-	AlreadyVoted       bool               `json:"alreadyVoted"`
-	GasInfo            rocketpool.GasInfo `json:"gasInfo"`
+	Status             string                 `json:"status"`
+	Error              string                 `json:"error"`
+	CanVote            bool                   `json:"canVote"`
+	DoesNotExist       bool                   `json:"doesNotExist"`
+	InvalidState       bool                   `json:"invalidState"`
+	JoinedAfterCreated bool                   `json:"joinedAfterCreated"`
+	AlreadyVoted       bool                   `json:"alreadyVoted"`
+	GasInfo            rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation         *TransactionSimulation `json:"simulation"`
 }
 type SecurityVoteOnProposalResponse struct {
 	Status string      `json:"status"`
@@ -157,12 +161,13 @@ type SecurityVoteOnProposalResponse struct {
 }
 
 type SecurityCanExecuteProposalResponse struct {
-	Status       string             `json:"status"`
-	Error        string             `json:"error"`
-	CanExecute   bool               `json:"canExecute"`
-	DoesNotExist bool               `json:"doesNotExist"`
-	InvalidState bool               `json:"invalidState"`
-	GasInfo      rocketpool.GasInfo `json:"gasInfo"`
+	Status       string                 `json:"status"`
+	Error        string                 `json:"error"`
+	CanExecute   bool                   `json:"canExecute"`
+	DoesNotExist bool                   `json:"doesNotExist"`
+	InvalidState bool                   `json:"invalidState"`
+	GasInfo      rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation   *TransactionSimulation `json:"simulation"`
 }
 type SecurityExecuteProposalResponse struct {
 	Status string      `json:"status"`
@@ -171,12 +176,13 @@ type SecurityExecuteProposalResponse struct {
 }
 
 type SecurityCanJoinResponse struct {
-	Status          string             `json:"status"`
-	Error           string             `json:"error"`
-	CanJoin         bool               `json:"canJoin"`
-	ProposalExpired bool               `json:"proposalExpired"`
-	AlreadyMember   bool               `json:"alreadyMember"`
-	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Status          string                 `json:"status"`
+	Error           string                 `json:"error"`
+	CanJoin         bool                   `json:"canJoin"`
+	ProposalExpired bool                   `json:"proposalExpired"`
+	AlreadyMember   bool                   `json:"alreadyMember"`
+	GasInfo         rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation      *TransactionSimulation `json:"simulation"`
 }
 type SecurityJoinResponse struct {
 	Status string      `json:"status"`
@@ -185,11 +191,12 @@ type SecurityJoinResponse struct {
 }
 
 type SecurityCanLeaveResponse struct {
-	Status          string             `json:"status"`
-	Error           string             `json:"error"`
-	CanLeave        bool               `json:"canLeave"`
-	ProposalExpired bool               `json:"proposalExpired"`
-	GasInfo         rocketpool.GasInfo `json:"gasInfo"`
+	Status          string                 `json:"status"`
+	Error           string                 `json:"error"`
+	CanLeave        bool                   `json:"canLeave"`
+	ProposalExpired bool                   `json:"proposalExpired"`
+	GasInfo         rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation      *TransactionSimulation `json:"simulation"`
 }
 type SecurityLeaveResponse struct {
 	Status string      `json:"status"`
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The result of simulating a transaction against the pending block before it's signed
+type TransactionSimulation struct {
+	// True if the client supports call tracing, so the events and balance changes are available
+	Traced         bool                     `json:"traced"`
+	Reverted       bool                     `json:"reverted"`
+	RevertReason   string                   `json:"revertReason"`
+	Events         []SimulatedEvent         `json:"events"`
+	BalanceChanges []SimulatedBalanceChange `json:"balanceChanges"`
+
+	// Set if the simulation couldn't be run at all
+	Error string `json:"error"`
+}
+
+// An event emitted during a simulated transaction
+type SimulatedEvent struct {
+	Address  common.Address      `json:"address"`
+	Contract string              `json:"contract"`
+	Name     string              `json:"name"`
+	Args     []SimulatedEventArg `json:"args"`
+}
+
+// A decoded argument of a simulated event
+type SimulatedEventArg struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// The change in an address's balance of ETH or a token caused by a simulated transaction
+type SimulatedBalanceChange struct {
+	Address common.Address `json:"address"`
+	Label   string         `json:"label"`
+	Token   string         `json:"token"`
+	Change  *big.Int       `json:"change"`
+}
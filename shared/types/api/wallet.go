@@ -22,7 +22,10 @@ type WalletStatusResponse struct {
 	Error             string         `json:"error"`
 	PasswordSet       bool           `json:"passwordSet"`
 	WalletInitialized bool           `json:"walletInitialized"`
+	WatchOnly         bool           `json:"watchOnly"`
 	AccountAddress    common.Address `json:"accountAddress"`
+	AccountExternal   bool           `json:"accountExternal"`
+	DelegateAddress   common.Address `json:"delegateAddress"`
 }
 
 type SetPasswordResponse struct {
@@ -69,12 +72,13 @@ type ExportWalletResponse struct {
 }
 
 type SetEnsNameResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	Address common.Address     `json:"address"`
-	EnsName string             `json:"ensName"`
-	TxHash  common.Hash        `json:"txHash"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status     string                 `json:"status"`
+	Error      string                 `json:"error"`
+	Address    common.Address         `json:"address"`
+	EnsName    string                 `json:"ensName"`
+	TxHash     common.Hash            `json:"txHash"`
+	GasInfo    rocketpool.GasInfo     `json:"gasInfo"`
+	Simulation *TransactionSimulation `json:"simulation"`
 }
 
 type TestMnemonicResponse struct {
@@ -88,3 +92,10 @@ type PurgeResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type SignTransactionResponse struct {
+	Status   string      `json:"status"`
+	Error    string      `json:"error"`
+	SignedTx string      `json:"signedTx"`
+	TxHash   common.Hash `json:"txHash"`
+}
@@ -7,10 +7,15 @@ type ParameterType string
 type ExecutionClient string
 type ConsensusClient string
 type RewardsMode string
+type AutoClaimMode string
+type PdaoAutoVoteMode string
 type MevRelayID string
 type MevSelectionMode string
 type NimbusPruningMode string
 type PBSubmissionRef int
+type AlertSeverity string
+type ValidatorSigner string
+type NodeSigner string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -84,6 +89,20 @@ const (
 	RewardsMode_Generate RewardsMode = "generate"
 )
 
+// Enum to describe how the node daemon restakes automatically claimed rewards
+const (
+	AutoClaimMode_Disabled       AutoClaimMode = "disabled"
+	AutoClaimMode_RestakePercent AutoClaimMode = "restakePercent"
+	AutoClaimMode_TargetRatio    AutoClaimMode = "targetRatio"
+)
+
+// Enum to describe how the node daemon applies the Protocol DAO voting policy
+const (
+	PdaoAutoVoteMode_Disabled PdaoAutoVoteMode = "disabled"
+	PdaoAutoVoteMode_DryRun   PdaoAutoVoteMode = "dryRun"
+	PdaoAutoVoteMode_Enabled  PdaoAutoVoteMode = "enabled"
+)
+
 const (
 	PBSubmission_6AM PBSubmissionRef = 1713420000
 )
@@ -112,6 +131,26 @@ const (
 	NimbusPruningMode_Prune   NimbusPruningMode = "prune"
 )
 
+// Enum to describe alert severities, used to route alerts to notification channels
+const (
+	AlertSeverity_Info     AlertSeverity = "info"
+	AlertSeverity_Warning  AlertSeverity = "warning"
+	AlertSeverity_Critical AlertSeverity = "critical"
+)
+
+// Enum to describe where validator keys are stored and used for signing
+const (
+	ValidatorSigner_Local      ValidatorSigner = "local"
+	ValidatorSigner_Web3Signer ValidatorSigner = "web3signer"
+)
+
+// Enum to describe what signs transactions and messages for the node account
+const (
+	NodeSigner_Local   NodeSigner = "local"
+	NodeSigner_Clef    NodeSigner = "clef"
+	NodeSigner_Eip1193 NodeSigner = "eip1193"
+)
+
 type Config interface {
 	GetConfigTitle() string
 	GetParameters() []*Parameter
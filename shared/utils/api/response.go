@@ -3,14 +3,51 @@ package api
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"reflect"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
+// The metadata key of a command's response printer
+const responsePrinterKey string = "responsePrinter"
+
+// Prints the response of an API command to its app's writer, along with any transactions the command exported
+// instead of sending. Each command gets its own printer, so the API server can run several of them at once.
+type ResponsePrinter struct {
+	writer              io.Writer
+	offlineTransactions []api.OfflineTransaction
+}
+
+// Get the response printer of an API command
+func GetResponsePrinter(c *cli.Context) *ResponsePrinter {
+	if printer, ok := c.App.Metadata[responsePrinterKey].(*ResponsePrinter); ok {
+		return printer
+	}
+	printer := &ResponsePrinter{
+		writer: c.App.Writer,
+	}
+	if c.App.Metadata == nil {
+		c.App.Metadata = map[string]interface{}{}
+	}
+	c.App.Metadata[responsePrinterKey] = printer
+	return printer
+}
+
+// Attach an unsigned transaction to the command's response instead of sending it
+func (p *ResponsePrinter) AddOfflineTransaction(from common.Address, tx *types.Transaction) {
+	p.offlineTransactions = append(p.offlineTransactions, api.OfflineTransaction{
+		From:        from,
+		Transaction: tx,
+	})
+}
+
 func ZeroIfNil(in **big.Int) {
 	if *in == nil {
 		*in = big.NewInt(0)
@@ -19,12 +56,12 @@ func ZeroIfNil(in **big.Int) {
 
 // Print an API response
 // response must be a pointer to a struct type with Error and Status string fields
-func PrintResponse(response interface{}, responseError error) {
+func (p *ResponsePrinter) PrintResponse(response interface{}, responseError error) {
 
 	// Check response type
 	r := reflect.ValueOf(response)
 	if !(r.Kind() == reflect.Ptr && r.Type().Elem().Kind() == reflect.Struct) {
-		PrintErrorResponse(errors.New("Invalid API response"))
+		p.PrintErrorResponse(errors.New("Invalid API response"))
 		return
 	}
 
@@ -38,7 +75,7 @@ func PrintResponse(response interface{}, responseError error) {
 	sf := r.Elem().FieldByName("Status")
 	ef := r.Elem().FieldByName("Error")
 	if !(sf.IsValid() && sf.CanSet() && sf.Kind() == reflect.String && ef.IsValid() && ef.CanSet() && ef.Kind() == reflect.String) {
-		PrintErrorResponse(errors.New("Invalid API response"))
+		p.PrintErrorResponse(errors.New("Invalid API response"))
 		return
 	}
 
@@ -57,16 +94,52 @@ func PrintResponse(response interface{}, responseError error) {
 	// Encode
 	responseBytes, err := json.Marshal(response)
 	if err != nil {
-		PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+		p.PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
 		return
 	}
 
+	// Attach any transactions that were exported instead of sent
+	if len(p.offlineTransactions) > 0 {
+		responseBytes, err = p.attachOfflineTransactions(responseBytes)
+		if err != nil {
+			p.PrintErrorResponse(err)
+			return
+		}
+	}
+
 	// Print
-	fmt.Println(string(responseBytes))
+	fmt.Fprintln(p.writer, string(responseBytes))
 
 }
 
 // Print an API error response
-func PrintErrorResponse(err error) {
-	PrintResponse(&api.APIResponse{}, err)
+func (p *ResponsePrinter) PrintErrorResponse(err error) {
+	p.PrintResponse(&api.APIResponse{}, err)
+}
+
+// Print an API error response for an app that couldn't run its command
+func PrintErrorResponse(app *cli.App, err error) {
+	printer := &ResponsePrinter{
+		writer: app.Writer,
+	}
+	printer.PrintErrorResponse(err)
+}
+
+// Add the exported transactions to an encoded response and clear them
+func (p *ResponsePrinter) attachOfflineTransactions(responseBytes []byte) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(responseBytes, &fields); err != nil {
+		return nil, fmt.Errorf("Could not decode API response: %w", err)
+	}
+	txBytes, err := json.Marshal(p.offlineTransactions)
+	p.offlineTransactions = nil
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode offline transactions: %w", err)
+	}
+	fields["offlineTransactions"] = txBytes
+	responseBytes, err = json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode API response: %w", err)
+	}
+	return responseBytes, nil
 }
@@ -6,11 +6,14 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/settings/protocol"
 	"github.com/rocket-pool/rocketpool-go/utils"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
@@ -18,6 +21,19 @@ import (
 // The fraction of the timeout period to trigger overdue transactions
 const TimeoutSafetyFactor int = 2
 
+// Waits for a transaction to be included in a block, replacing it if it gets stuck
+type TransactionWaiter interface {
+	WaitForTransaction(hash common.Hash, logger *log.ColorLogger) (*types.Receipt, error)
+}
+
+// The daemon's transaction manager, if it has one
+var transactionWaiter TransactionWaiter
+
+// Set the waiter that PrintAndWaitForTransaction hands transactions to
+func SetTransactionWaiter(waiter TransactionWaiter) {
+	transactionWaiter = waiter
+}
+
 // Print the gas price and cost of a TX
 func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasThresholdGwei float64, logger *log.ColorLogger, maxFeeWei *big.Int, gasLimit uint64) bool {
 
@@ -68,6 +84,12 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 	logger.Println("Waiting for the transaction to be validated...")
 
 	// Wait for the TX to be included in a block
+	if transactionWaiter != nil {
+		if _, err := transactionWaiter.WaitForTransaction(hash, logger); err != nil {
+			return fmt.Errorf("Error waiting for transaction: %w", err)
+		}
+		return nil
+	}
 	if _, err := utils.WaitForTransaction(ec, hash); err != nil {
 		return fmt.Errorf("Error waiting for transaction: %w", err)
 	}
@@ -76,6 +98,18 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 
 }
 
+// Check if a daemon task can sign a transaction with the node account. Tasks can't wait for an external signer to approve
+// their transactions, so if the node account is held by one, this logs an error and sends an alert so the operator does the task manually.
+// Tasks check this every interval that they have something to send, so the alert stays active until it's done.
+func CheckNodeKeyAvailable(cfg *config.RocketPoolConfig, w *wallet.Wallet, task string, logger *log.ColorLogger) bool {
+	if !w.IsNodeAccountExternal() {
+		return true
+	}
+	logger.Printlnf("ERROR: %s needs a transaction from the node account, but it's held by an external signer. Please do it manually from the CLI.", task)
+	alerting.AlertNodeKeyUnavailable(cfg, task)
+	return false
+}
+
 // True if a transaction is due and needs to bypass the gas threshold
 func IsTransactionDue(rp *rocketpool.RocketPool, startTime time.Time) (bool, time.Duration, error) {
 
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"github.com/urfave/cli"
+)
+
+// Metadata key for the collector that holds a command's response while it runs against one of several nodes
+const fleetCollectorKey string = "fleet-collector"
+
+// The response of a command run against one of several node profiles
+type FleetResponse struct {
+	Node     string      `json:"node"`
+	Response interface{} `json:"response,omitempty"`
+	Error    string      `json:"error,omitempty"`
+}
+
+// Prints the combined responses of a command that was run against several nodes
+type FleetTablePrinter func(c *cli.Context, responses []FleetResponse) error
+
+// Holds the structured response of a command instead of printing it
+type fleetCollector struct {
+	response interface{}
+}
+
+// The commands that can print a combined table for several nodes, by full command name
+var fleetTablePrinters = map[string]FleetTablePrinter{}
+
+// Register a printer that combines a command's structured responses from several nodes into one table
+func RegisterFleetTable(commandName string, printer FleetTablePrinter) {
+	fleetTablePrinters[commandName] = printer
+}
+
+// Get the combined table printer for a command, if it has one
+func GetFleetTable(commandName string) (FleetTablePrinter, bool) {
+	printer, exists := fleetTablePrinters[commandName]
+	return printer, exists
+}
+
+// Make the next structured response get collected instead of printed
+func StartCollectingFleetResponse(c *cli.Context) {
+	c.App.Metadata[fleetCollectorKey] = &fleetCollector{}
+}
+
+// Stop collecting and return the structured response the command produced, if any
+func StopCollectingFleetResponse(c *cli.Context) interface{} {
+	collector, _ := c.App.Metadata[fleetCollectorKey].(*fleetCollector)
+	delete(c.App.Metadata, fleetCollectorKey)
+	if collector == nil {
+		return nil
+	}
+	return collector.response
+}
+
+// Check if a command's structured response is being collected for a combined multi-node response
+func isCollectingFleetResponse(c *cli.Context) bool {
+	_, collecting := c.App.Metadata[fleetCollectorKey].(*fleetCollector)
+	return collecting
+}
+
+// Store a structured response if it's being collected, returning true if it was
+func collectFleetResponse(c *cli.Context, response interface{}) bool {
+	collector, collecting := c.App.Metadata[fleetCollectorKey].(*fleetCollector)
+	if !collecting {
+		return false
+	}
+	collector.response = response
+	return true
+}
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get the output format requested with the global --output flag
+func GetOutputFormat(c *cli.Context) api.OutputFormat {
+	format := api.OutputFormat(strings.ToLower(c.GlobalString("output")))
+	if format == "" {
+		return api.OutputFormat_Text
+	}
+	return format
+}
+
+// The commands that can print a structured response, by command path
+var structuredOutputCommands = map[string]bool{}
+
+// Register subcommands of a command that print a structured response when a structured output format is selected
+func RegisterStructuredOutput(commandPath string, subcommandNames ...string) {
+	for _, name := range subcommandNames {
+		structuredOutputCommands[commandPath+" "+name] = true
+	}
+}
+
+// Check if a command prints a structured response when a structured output format is selected
+func SupportsStructuredOutput(commandPath string) bool {
+	return structuredOutputCommands[commandPath]
+}
+
+// Get the full path of the running command below the app, e.g. "pdao proposals list"
+func GetCommandPath(c *cli.Context) string {
+	_, path, _ := strings.Cut(c.Command.HelpName, " ")
+	return path
+}
+
+// Wrap every command's action so it fails if a structured output format is selected but the command only prints text
+func RequireStructuredOutputSupport(commands []cli.Command) {
+	for i := range commands {
+		command := &commands[i]
+		if action, ok := command.Action.(func(*cli.Context) error); ok {
+			command.Action = requireStructuredOutputSupport(action)
+		}
+		RequireStructuredOutputSupport(command.Subcommands)
+	}
+}
+
+// Wrap a command's action so it fails if a structured output format is selected but the command only prints text
+func requireStructuredOutputSupport(action func(*cli.Context) error) func(*cli.Context) error {
+	return func(c *cli.Context) error {
+		format := GetOutputFormat(c)
+		commandPath := GetCommandPath(c)
+		if format.IsStructured() && !SupportsStructuredOutput(commandPath) {
+			return fmt.Errorf("'%s' doesn't support the %s output format; run it without --output", commandPath, format)
+		}
+		return action(c)
+	}
+}
+
+// Check if the command should print its structured response instead of its usual output
+func IsStructuredOutput(c *cli.Context) bool {
+	return GetOutputFormat(c).IsStructured() || isCollectingFleetResponse(c)
+}
+
+// Print a command's structured response in the requested output format.
+// The response is always serialized with its JSON schema, so the YAML output uses the same field names and value encodings.
+func PrintStructuredResponse(c *cli.Context, response interface{}) error {
+	if collectFleetResponse(c, response) {
+		return nil
+	}
+
+	jsonBytes, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("error serializing response: %w", err)
+	}
+
+	switch GetOutputFormat(c) {
+	case api.OutputFormat_Json:
+		var buffer bytes.Buffer
+		err = json.Indent(&buffer, jsonBytes, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error formatting response: %w", err)
+		}
+		fmt.Println(buffer.String())
+
+	case api.OutputFormat_Yaml:
+		decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+		decoder.UseNumber()
+		var value interface{}
+		err = decoder.Decode(&value)
+		if err != nil {
+			return fmt.Errorf("error converting response: %w", err)
+		}
+		yamlBytes, err := yaml.Marshal(convertJsonNumbers(value))
+		if err != nil {
+			return fmt.Errorf("error serializing response: %w", err)
+		}
+		fmt.Print(string(yamlBytes))
+
+	default:
+		return fmt.Errorf("output format '%s' does not support structured responses", GetOutputFormat(c))
+	}
+
+	return nil
+}
+
+// Print an error in the requested structured output format
+func PrintStructuredError(c *cli.Context, err error) {
+	response := api.APIResponse{
+		Status: "error",
+		Error:  err.Error(),
+	}
+	if printErr := PrintStructuredResponse(c, response); printErr != nil {
+		PrettyPrintError(err)
+	}
+}
+
+// Replace JSON numbers with native integers or floats where they fit, so YAML doesn't quote them.
+// Numbers too large for an int64 / uint64 (e.g. wei amounts) are kept as strings so they don't lose precision.
+func convertJsonNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, element := range v {
+			v[key] = convertJsonNumbers(element)
+		}
+		return v
+	case []interface{}:
+		for i, element := range v {
+			v[i] = convertJsonNumbers(element)
+		}
+		return v
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return u
+		}
+		if strings.ContainsAny(string(v), ".eE") {
+			if f, err := strconv.ParseFloat(string(v), 64); err == nil {
+				return f
+			}
+		}
+		return string(v)
+	default:
+		return v
+	}
+}
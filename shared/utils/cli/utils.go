@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -45,6 +47,29 @@ func PrintMultiTransactionNonceWarning() {
 // Implementation of PrintTransactionHash and PrintTransactionHashNoCancel
 func printTransactionHashImpl(rp *rocketpool.Client, hash common.Hash, finalMessage string) {
 
+	// Deferred transactions are sent later by the node daemon
+	if rp.IsDeferred() {
+		whenGasBelow, notAfter := rp.GetDeferConditions()
+		fmt.Printf("The transaction has been queued as deferred transaction %d.\n", rp.GetDeferredTransactionID())
+		switch {
+		case whenGasBelow > 0 && !notAfter.IsZero():
+			fmt.Printf("The node daemon will send it once the network's max fee drops below %.2f gwei, or at %s if it hasn't by then.\n", whenGasBelow, notAfter.Format(time.RFC1123))
+		case whenGasBelow > 0:
+			fmt.Printf("The node daemon will send it once the network's max fee drops below %.2f gwei.\n", whenGasBelow)
+		default:
+			fmt.Printf("The node daemon will send it at %s.\n", notAfter.Format(time.RFC1123))
+		}
+		fmt.Printf("You can check on it or cancel it with `rocketpool node deferred-tx`.\n\n")
+		return
+	}
+
+	// Exported transactions haven't been sent yet
+	if rp.IsOfflineExport() {
+		fmt.Printf("The unsigned transaction has been written to %s.\n", rp.GetOfflineExportPath())
+		fmt.Printf("Copy it to your offline machine and sign it with `rocketpool wallet sign-tx %s`, then submit the signed transaction from this machine with `rocketpool node broadcast-tx`.\n\n", rp.GetOfflineExportPath())
+		return
+	}
+
 	cfg, isNew, err := rp.LoadConfig()
 	if err != nil {
 		fmt.Printf("Warning: couldn't read config file so the transaction URL will be unavailable (%s).\n", err)
@@ -147,3 +172,41 @@ func PrintNetwork(currentNetwork cfgtypes.Network, isNew bool) error {
 
 	return nil
 }
+
+// Print the result of simulating a transaction, so the user can check what it will do before confirming it
+func PrintTransactionSimulation(simulation *api.TransactionSimulation) {
+	if simulation == nil {
+		return
+	}
+	if simulation.Error != "" {
+		fmt.Printf("%sNOTE: this transaction couldn't be simulated, so its effects can't be shown (%s).%s\n\n", colorYellow, simulation.Error, colorReset)
+		return
+	}
+	if simulation.Reverted {
+		fmt.Printf("%sWARNING: this transaction reverted when it was simulated against the pending block: %s%s\n\n", colorRed, simulation.RevertReason, colorReset)
+		return
+	}
+	if !simulation.Traced {
+		fmt.Printf("%sThe transaction succeeded when simulated against the pending block. Your Execution client doesn't support call tracing, so its events and balance changes can't be shown.%s\n\n", colorGreen, colorReset)
+		return
+	}
+
+	fmt.Printf("%sSimulated against the pending block, this transaction will:%s\n", colorGreen, colorReset)
+	if len(simulation.BalanceChanges) == 0 {
+		fmt.Println("\tNot change the balances of your node or its withdrawal addresses.")
+	}
+	for _, change := range simulation.BalanceChanges {
+		fmt.Printf("\tChange the %s balance of the %s (%s) by %+.6f\n", change.Token, strings.ToLower(change.Label), change.Address.Hex(), eth.WeiToEth(change.Change))
+	}
+	if len(simulation.Events) > 0 {
+		fmt.Println("\tEmit these events:")
+	}
+	for _, event := range simulation.Events {
+		args := make([]string, len(event.Args))
+		for i, arg := range event.Args {
+			args[i] = fmt.Sprintf("%s=%s", arg.Name, arg.Value)
+		}
+		fmt.Printf("\t\t%s.%s(%s)\n", event.Contract, event.Name, strings.Join(args, ", "))
+	}
+	fmt.Println()
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
 )
 
@@ -171,6 +172,15 @@ func ValidateProposalType(name, value string) (string, error) {
 	return val, nil
 }
 
+// Validate an output format
+func ValidateOutputFormat(name, value string) (api.OutputFormat, error) {
+	val := api.OutputFormat(strings.ToLower(value))
+	if !(val == api.OutputFormat_Text || val == api.OutputFormat_Json || val == api.OutputFormat_Yaml) {
+		return "", fmt.Errorf("Invalid %s '%s' - valid formats are 'text', 'json', and 'yaml'", name, value)
+	}
+	return val, nil
+}
+
 //
 // Command specific types
 //
@@ -353,6 +363,28 @@ func ValidateDuration(name, value string) (time.Duration, error) {
 	return duration, nil
 }
 
+// Validate a point in time, either in RFC 3339 format or as a local date with an optional time
+func ValidateTime(name, value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("Invalid %s '%s' - must be an RFC 3339 timestamp (e.g. 2024-05-01T18:00:00Z) or a local time in the format 'YYYY-MM-DD HH:MM' or 'YYYY-MM-DD'", name, value)
+}
+
+// Validate a gas price in gwei
+func ValidateGasPrice(name, value string) (float64, error) {
+	val, err := strconv.ParseFloat(value, 64)
+	if err != nil || val < 0 {
+		return 0, fmt.Errorf("Invalid %s '%s' - must be a non-negative number of gwei", name, value)
+	}
+	return val, nil
+}
+
 // Validate a vote direction
 func ValidateVoteDirection(name, value string) (types.VoteDirection, error) {
 	switch value {
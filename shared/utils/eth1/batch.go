@@ -0,0 +1,111 @@
+package eth1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/multicall"
+)
+
+// The most calls to bundle into a single batch transaction, so it stays well under the block gas limit
+const MaxBatchCalls int = 50
+
+// A contract call that can be bundled into a batch transaction
+type BatchCall struct {
+	Target   common.Address
+	CallData []byte
+}
+
+// Create a batch call for a contract method
+func NewBatchCall(contract *rocketpool.Contract, method string, params ...interface{}) (BatchCall, error) {
+	callData, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return BatchCall{}, fmt.Errorf("error packing %s call for %s: %w", method, contract.Address.Hex(), err)
+	}
+	return BatchCall{
+		Target:   *contract.Address,
+		CallData: callData,
+	}, nil
+}
+
+// Check which calls succeed when the multicall contract makes them.
+// The multicall contract is the caller rather than the node, so only permissionless calls can be batched; the rest have to be sent individually.
+func GetBatchableCalls(client rocketpool.ExecutionClient, multicallAddress common.Address, calls []BatchCall) ([]bool, error) {
+	contract, err := GetBatchContract(client, multicallAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Run the calls in sequence like the batch transaction would, without letting a failed call revert the others
+	callData, err := contract.ABI.Pack("tryAggregate", false, GetMultiCalls(calls))
+	if err != nil {
+		return nil, fmt.Errorf("error packing batch check: %w", err)
+	}
+	response, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &multicallAddress, Data: callData}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error checking batch calls: %w", err)
+	}
+	results, err := contract.ABI.Unpack("tryAggregate", response)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding batch check: %w", err)
+	}
+	callResults, ok := results[0].([]struct {
+		Success    bool   `json:"success"`
+		ReturnData []byte `json:"returnData"`
+	})
+	if !ok || len(callResults) != len(calls) {
+		return nil, fmt.Errorf("unexpected batch check result for %d calls", len(calls))
+	}
+
+	batchable := make([]bool, len(calls))
+	for i, result := range callResults {
+		batchable[i] = result.Success
+	}
+	return batchable, nil
+}
+
+// Get a binding for the multicall contract that sends batch transactions
+func GetBatchContract(client rocketpool.ExecutionClient, multicallAddress common.Address) (*rocketpool.Contract, error) {
+	multicallAbi, err := abi.JSON(strings.NewReader(multicall.MulticallABI))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing multicall ABI: %w", err)
+	}
+	return &rocketpool.Contract{
+		Contract: bind.NewBoundContract(multicallAddress, multicallAbi, client, client, client),
+		Address:  &multicallAddress,
+		ABI:      &multicallAbi,
+		Client:   client,
+	}, nil
+}
+
+// Get the calls of a batch in the form the multicall contract's aggregate method takes
+func GetMultiCalls(calls []BatchCall) []multicall.MultiCall {
+	multiCalls := make([]multicall.MultiCall, len(calls))
+	for i, call := range calls {
+		multiCalls[i] = multicall.MultiCall{
+			Target:   call.Target,
+			CallData: call.CallData,
+		}
+	}
+	return multiCalls
+}
+
+// Estimate the gas of a batch transaction
+func EstimateBatchGas(contract *rocketpool.Contract, calls []BatchCall, opts *bind.TransactOpts) (rocketpool.GasInfo, error) {
+	return contract.GetTransactionGasInfo(opts, "aggregate", GetMultiCalls(calls))
+}
+
+// Send a batch transaction; it reverts if any of the calls fails, so the calls should be checked with GetBatchableCalls first
+func SendBatch(contract *rocketpool.Contract, calls []BatchCall, opts *bind.TransactOpts) (common.Hash, error) {
+	tx, err := contract.Transact(opts, "aggregate", GetMultiCalls(calls))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error sending batch transaction: %w", err)
+	}
+	return tx.Hash(), nil
+}
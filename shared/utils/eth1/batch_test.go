@@ -0,0 +1,91 @@
+package eth1
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// A client that answers the multicall contract's tryAggregate calls, letting only some targets succeed
+type testBatchClient struct {
+	rocketpool.ExecutionClient
+
+	// The targets whose calls succeed
+	allowed map[common.Address]bool
+
+	// Drop the last result, like a contract that doesn't behave as expected
+	truncate bool
+}
+
+func (c testBatchClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	contract, err := GetBatchContract(c, *call.To)
+	if err != nil {
+		return nil, err
+	}
+	method, err := contract.ABI.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name != "tryAggregate" {
+		return nil, fmt.Errorf("unexpected method %s", method.Name)
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	if args[0].(bool) {
+		return nil, fmt.Errorf("the batch check must not require every call to succeed")
+	}
+	calls := args[1].([]struct {
+		Target   common.Address `json:"target"`
+		CallData []byte         `json:"callData"`
+	})
+
+	type result struct {
+		Success    bool   `json:"success"`
+		ReturnData []byte `json:"returnData"`
+	}
+	results := []result{}
+	for _, call := range calls {
+		results = append(results, result{Success: c.allowed[call.Target], ReturnData: []byte{}})
+	}
+	if c.truncate {
+		results = results[:len(results)-1]
+	}
+	return method.Outputs.Pack(results)
+}
+
+func TestGetBatchableCalls(t *testing.T) {
+	multicallAddress := common.HexToAddress("0x5BA1e12693Dc8F9c48aAD8770482f4739bEeD696")
+	permissionless := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	ownerOnly := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	calls := []BatchCall{
+		{Target: permissionless, CallData: []byte{0x01}},
+		{Target: ownerOnly, CallData: []byte{0x02}},
+		{Target: permissionless, CallData: []byte{0x03}},
+	}
+
+	// Each call is matched with its own result, so one that can't be batched doesn't exclude the others
+	client := testBatchClient{allowed: map[common.Address]bool{permissionless: true}}
+	batchable, err := GetBatchableCalls(client, multicallAddress, calls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []bool{true, false, true}
+	for i := range expected {
+		if batchable[i] != expected[i] {
+			t.Errorf("call %d: expected batchable %t, got %t", i, expected[i], batchable[i])
+		}
+	}
+
+	// Results that can't be matched to the calls are an error rather than a guess
+	client.truncate = true
+	if _, err := GetBatchableCalls(client, multicallAddress, calls); err == nil {
+		t.Error("expected an error for a missing result")
+	}
+}
@@ -0,0 +1,27 @@
+package eth1
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// An execution client that drops transactions instead of sending them
+type noSendClient struct {
+	rocketpool.ExecutionClient
+}
+
+// Drop the transaction; it has already been exported for offline signing
+func (c *noSendClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return nil
+}
+
+// Get the client to pass to eth.SendTransaction, which doesn't respect the transactor's NoSend flag on its own
+func GetSendClient(ec rocketpool.ExecutionClient, opts *bind.TransactOpts) rocketpool.ExecutionClient {
+	if opts.NoSend {
+		return &noSendClient{ec}
+	}
+	return ec
+}
@@ -0,0 +1,449 @@
+package eth1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/storage"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Settings
+const (
+	simulationTimeout time.Duration = 30 * time.Second
+	simulationBlock   string        = "pending"
+	ethTokenSymbol    string        = "ETH"
+
+	// The JSON-RPC error code for calls that fail during execution
+	executionErrorCode int = 3
+)
+
+// The contracts whose events are decoded in a transaction simulation
+var simulationContractNames = []string{
+	"rocketTokenRPL",
+	"rocketTokenRPLFixedSupply",
+	"rocketTokenRETH",
+	"rocketVault",
+	"rocketDepositPool",
+	"rocketNodeManager",
+	"rocketNodeDeposit",
+	"rocketNodeStaking",
+	"rocketMinipoolManager",
+	"rocketMinipoolQueue",
+	"rocketRewardsPool",
+	"rocketMerkleDistributorMainnet",
+	"rocketNetworkVoting",
+	"rocketDAOProtocolProposal",
+	"rocketDAOProtocolVerifier",
+}
+
+// The tokens whose transfers are counted as balance changes
+var simulationTokens = map[string]string{
+	"rocketTokenRPL":            "RPL",
+	"rocketTokenRPLFixedSupply": "legacy RPL",
+	"rocketTokenRETH":           "rETH",
+}
+
+// The ERC20 Transfer event signature
+var transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// An address whose balance changes should be reported by a simulation
+type SimulationAddress struct {
+	Address common.Address
+	Label   string
+}
+
+// A call frame returned by the callTracer
+type simulationCallFrame struct {
+	Error        string                `json:"error"`
+	RevertReason string                `json:"revertReason"`
+	Logs         []simulationLog       `json:"logs"`
+	Calls        []simulationCallFrame `json:"calls"`
+}
+
+// A log emitted inside a call frame
+type simulationLog struct {
+	Address  common.Address `json:"address"`
+	Topics   []common.Hash  `json:"topics"`
+	Data     hexutil.Bytes  `json:"data"`
+	Position *hexutil.Uint  `json:"position"`
+}
+
+// The state diff returned by the prestateTracer in diff mode
+type simulationStateDiff struct {
+	Pre  map[common.Address]simulationAccount `json:"pre"`
+	Post map[common.Address]simulationAccount `json:"post"`
+}
+type simulationAccount struct {
+	Balance *hexutil.Big `json:"balance"`
+}
+
+// The client calls a simulation needs
+type simulationCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// A contract that can decode simulated events
+type simulationContract struct {
+	name string
+	abi  *abi.ABI
+}
+
+// Get the addresses of the node that a simulation should report balance changes for: the node itself and its withdrawal addresses
+func GetSimulationAddresses(rp *rocketpool.RocketPool, nodeAddress common.Address) []SimulationAddress {
+	addresses := []SimulationAddress{{Address: nodeAddress, Label: "Node"}}
+
+	withdrawalAddress, err := storage.GetNodeWithdrawalAddress(rp, nodeAddress, nil)
+	if err == nil && withdrawalAddress != nodeAddress {
+		addresses = append(addresses, SimulationAddress{Address: withdrawalAddress, Label: "Withdrawal address"})
+	}
+
+	// The RPL withdrawal address only exists after Houston, so ignore errors here
+	isRplWithdrawalAddressSet, err := node.GetNodeRPLWithdrawalAddressIsSet(rp, nodeAddress, nil)
+	if err == nil && isRplWithdrawalAddressSet {
+		rplWithdrawalAddress, err := node.GetNodeRPLWithdrawalAddress(rp, nodeAddress, nil)
+		if err == nil && rplWithdrawalAddress != nodeAddress && rplWithdrawalAddress != withdrawalAddress {
+			addresses = append(addresses, SimulationAddress{Address: rplWithdrawalAddress, Label: "RPL withdrawal address"})
+		}
+	}
+
+	return addresses
+}
+
+// Get the addresses a minipool transaction should report balance changes for: the minipools, the node and its withdrawal addresses
+func GetMinipoolSimulationAddresses(rp *rocketpool.RocketPool, nodeAddress common.Address, minipoolAddresses ...common.Address) []SimulationAddress {
+	addresses := make([]SimulationAddress, 0, len(minipoolAddresses))
+	for _, minipoolAddress := range minipoolAddresses {
+		addresses = append(addresses, SimulationAddress{Address: minipoolAddress, Label: "Minipool"})
+	}
+	return append(addresses, GetSimulationAddresses(rp, nodeAddress)...)
+}
+
+// Simulate calling a contract method against the pending block and decode the events and balance changes it would cause.
+// Failures are reported in the returned simulation rather than as an error, since a simulation is only informational.
+func SimulateTransaction(c *cli.Context, rp *rocketpool.RocketPool, opts *bind.TransactOpts, contract *rocketpool.Contract, addresses []SimulationAddress, method string, params ...interface{}) *api.TransactionSimulation {
+	data, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return &api.TransactionSimulation{
+			Error: fmt.Sprintf("error packing %s call: %s", method, err.Error()),
+		}
+	}
+	call := ethereum.CallMsg{
+		From:  opts.From,
+		To:    contract.Address,
+		Gas:   opts.GasLimit,
+		Value: opts.Value,
+		Data:  data,
+	}
+	return simulate(c, rp, call, contract, addresses)
+}
+
+// Simulate the transaction whose gas was just estimated with rp, so a command can show what it will do without repeating its arguments.
+// Returns nil if no transaction was estimated, e.g. because the command can't be run.
+func SimulateEstimatedTransaction(c *cli.Context, rp *rocketpool.RocketPool) *api.TransactionSimulation {
+	call, ok := services.TakeEstimatedCall(rp)
+	if !ok {
+		return nil
+	}
+	return simulate(c, rp, call, nil, GetSimulationAddresses(rp, call.From))
+}
+
+// Simulate the minipool transaction whose gas was just estimated with rp, including the minipool's events and balance changes.
+// Returns nil if no transaction was estimated, e.g. because the command can't be run.
+func SimulateEstimatedMinipoolTransaction(c *cli.Context, rp *rocketpool.RocketPool, mp minipool.Minipool) *api.TransactionSimulation {
+	call, ok := services.TakeEstimatedCall(rp)
+	if !ok {
+		return nil
+	}
+	return simulate(c, rp, call, mp.GetContract(), GetMinipoolSimulationAddresses(rp, call.From, mp.GetAddress()))
+}
+
+// Simulate a call with the execution client
+func simulate(c *cli.Context, rp *rocketpool.RocketPool, call ethereum.CallMsg, target *rocketpool.Contract, addresses []SimulationAddress) *api.TransactionSimulation {
+	simulation := &api.TransactionSimulation{}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		simulation.Error = fmt.Sprintf("error getting execution client: %s", err.Error())
+		return simulation
+	}
+
+	err = simulateCall(ec, getSimulationContracts(rp), call, target, addresses, simulation)
+	if err != nil {
+		simulation.Error = err.Error()
+	}
+	return simulation
+}
+
+// Run the simulation and fill in the results
+func simulateCall(caller simulationCaller, contracts map[common.Address]simulationContract, call ethereum.CallMsg, target *rocketpool.Contract, addresses []SimulationAddress, simulation *api.TransactionSimulation) error {
+	ctx, cancel := context.WithTimeout(context.Background(), simulationTimeout)
+	defer cancel()
+
+	callArgs := map[string]interface{}{
+		"from": call.From,
+		"to":   call.To,
+		"data": hexutil.Bytes(call.Data),
+	}
+	if call.Value != nil && call.Value.Sign() > 0 {
+		callArgs["value"] = (*hexutil.Big)(call.Value)
+	}
+	if call.Gas != 0 {
+		callArgs["gas"] = hexutil.Uint64(call.Gas)
+	}
+
+	// Trace the call; clients without the debug namespace can only tell us if it reverts
+	var frame simulationCallFrame
+	err := caller.CallContext(ctx, &frame, "debug_traceCall", callArgs, simulationBlock, map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"withLog": true},
+	})
+	if err != nil {
+		var result hexutil.Bytes
+		err = caller.CallContext(ctx, &result, "eth_call", callArgs, simulationBlock)
+		if err != nil {
+			if !isExecutionError(err) {
+				return fmt.Errorf("simulation unavailable: %w", err)
+			}
+			simulation.Reverted = true
+			simulation.RevertReason = err.Error()
+		}
+		return nil
+	}
+	simulation.Traced = true
+	if frame.Error != "" {
+		simulation.Reverted = true
+		simulation.RevertReason = frame.RevertReason
+		if simulation.RevertReason == "" {
+			simulation.RevertReason = frame.Error
+		}
+		return nil
+	}
+
+	// Decode the events
+	labels := map[common.Address]string{}
+	for _, address := range addresses {
+		labels[address.Address] = address.Label
+	}
+	logs := flattenSimulationLogs(frame)
+	simulation.Events = make([]api.SimulatedEvent, 0, len(logs))
+	for _, log := range logs {
+		simulation.Events = append(simulation.Events, decodeSimulatedEvent(log, contracts, target, labels))
+	}
+
+	// Get the ETH balance changes; this is best-effort since not every client supports the diff mode
+	simulation.BalanceChanges = []api.SimulatedBalanceChange{}
+	var diff simulationStateDiff
+	err = caller.CallContext(ctx, &diff, "debug_traceCall", callArgs, simulationBlock, map[string]interface{}{
+		"tracer":       "prestateTracer",
+		"tracerConfig": map[string]interface{}{"diffMode": true},
+	})
+	if err == nil {
+		for _, address := range addresses {
+			pre, preExists := diff.Pre[address.Address]
+			post, postExists := diff.Post[address.Address]
+			if !preExists || !postExists || pre.Balance == nil || post.Balance == nil {
+				continue
+			}
+			change := big.NewInt(0).Sub(post.Balance.ToInt(), pre.Balance.ToInt())
+			if change.Sign() != 0 {
+				simulation.BalanceChanges = append(simulation.BalanceChanges, api.SimulatedBalanceChange{
+					Address: address.Address,
+					Label:   address.Label,
+					Token:   ethTokenSymbol,
+					Change:  change,
+				})
+			}
+		}
+	}
+
+	// Get the token balance changes from the transfer events
+	tokens := map[common.Address]string{}
+	for name, symbol := range simulationTokens {
+		for address, contract := range contracts {
+			if contract.name == name {
+				tokens[address] = symbol
+			}
+		}
+	}
+	for _, address := range addresses {
+		changes := map[string]*big.Int{}
+		symbols := []string{}
+		for _, log := range logs {
+			symbol, isToken := tokens[log.Address]
+			if !isToken || len(log.Topics) != 3 || log.Topics[0] != transferEventTopic {
+				continue
+			}
+			from := common.BytesToAddress(log.Topics[1].Bytes())
+			to := common.BytesToAddress(log.Topics[2].Bytes())
+			if from != address.Address && to != address.Address {
+				continue
+			}
+			change, exists := changes[symbol]
+			if !exists {
+				change = big.NewInt(0)
+				changes[symbol] = change
+				symbols = append(symbols, symbol)
+			}
+			amount := big.NewInt(0).SetBytes(log.Data)
+			if from == address.Address {
+				change.Sub(change, amount)
+			}
+			if to == address.Address {
+				change.Add(change, amount)
+			}
+		}
+		for _, symbol := range symbols {
+			if changes[symbol].Sign() != 0 {
+				simulation.BalanceChanges = append(simulation.BalanceChanges, api.SimulatedBalanceChange{
+					Address: address.Address,
+					Label:   address.Label,
+					Token:   symbol,
+					Change:  changes[symbol],
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// Check if an eth_call error came from executing the call, rather than from the client or the connection to it
+func isExecutionError(err error) bool {
+	// Clients return code 3 with the revert data for reverts, but some older ones only set the message
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == executionErrorCode || strings.Contains(rpcErr.Error(), "execution reverted")
+	}
+	return false
+}
+
+// Get the Rocket Pool contracts that can decode simulated events, by address
+func getSimulationContracts(rp *rocketpool.RocketPool) map[common.Address]simulationContract {
+	contracts := map[common.Address]simulationContract{}
+	for _, name := range simulationContractNames {
+		// Some contracts don't exist on older deployments, so skip anything that can't be loaded
+		contract, err := rp.GetContract(name, nil)
+		if err != nil {
+			continue
+		}
+		contracts[*contract.Address] = simulationContract{
+			name: name,
+			abi:  contract.ABI,
+		}
+	}
+	return contracts
+}
+
+// Flatten the logs of a call tree into the order they were emitted in, skipping calls that reverted
+func flattenSimulationLogs(frame simulationCallFrame) []simulationLog {
+	logs := []simulationLog{}
+	if frame.Error != "" {
+		return logs
+	}
+
+	// A log's position is the number of subcalls made before it was emitted
+	nextLog := 0
+	for i, call := range frame.Calls {
+		for nextLog < len(frame.Logs) && frame.Logs[nextLog].Position != nil && int(*frame.Logs[nextLog].Position) <= i {
+			logs = append(logs, frame.Logs[nextLog])
+			nextLog++
+		}
+		logs = append(logs, flattenSimulationLogs(call)...)
+	}
+	logs = append(logs, frame.Logs[nextLog:]...)
+	return logs
+}
+
+// Decode a simulated event with the ABI of the contract that emitted it, if it's known
+func decodeSimulatedEvent(log simulationLog, contracts map[common.Address]simulationContract, target *rocketpool.Contract, labels map[common.Address]string) api.SimulatedEvent {
+	event := api.SimulatedEvent{
+		Address:  log.Address,
+		Contract: log.Address.Hex(),
+		Args:     []api.SimulatedEventArg{},
+	}
+
+	// Find the ABI to decode with
+	var contractAbi *abi.ABI
+	if contract, exists := contracts[log.Address]; exists {
+		event.Contract = contract.name
+		contractAbi = contract.abi
+	} else {
+		if label, exists := labels[log.Address]; exists {
+			event.Contract = label
+		}
+		if target != nil && log.Address == *target.Address {
+			contractAbi = target.ABI
+		}
+	}
+	if contractAbi == nil || len(log.Topics) == 0 {
+		event.Name = "unknown"
+		return event
+	}
+	abiEvent, err := contractAbi.EventByID(log.Topics[0])
+	if err != nil {
+		event.Name = "unknown"
+		return event
+	}
+	event.Name = abiEvent.Name
+
+	// Decode the arguments
+	values := map[string]interface{}{}
+	indexed := abi.Arguments{}
+	for _, input := range abiEvent.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, log.Topics[1:]); err != nil {
+		return event
+	}
+	if err := abiEvent.Inputs.NonIndexed().UnpackIntoMap(values, log.Data); err != nil {
+		return event
+	}
+	for i, input := range abiEvent.Inputs {
+		name := input.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		event.Args = append(event.Args, api.SimulatedEventArg{
+			Name:  name,
+			Value: formatSimulatedValue(values[input.Name]),
+		})
+	}
+	return event
+}
+
+// Format a decoded event argument for display
+func formatSimulatedValue(value interface{}) string {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	default:
+		return fmt.Sprint(v)
+	}
+}
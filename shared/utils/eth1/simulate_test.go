@@ -0,0 +1,196 @@
+package eth1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// A JSON-RPC error returned by a client
+type testRpcError struct {
+	code    int
+	message string
+}
+
+func (e testRpcError) Error() string  { return e.message }
+func (e testRpcError) ErrorCode() int { return e.code }
+
+func TestIsExecutionError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"revert with data", testRpcError{3, "execution reverted: Minipool is not staking"}, true},
+		{"revert without data", testRpcError{-32000, "execution reverted"}, true},
+		{"wrapped revert", fmt.Errorf("error calling: %w", testRpcError{3, "execution reverted"}), true},
+		{"other rpc error", testRpcError{-32601, "the method eth_call does not exist/is not available"}, false},
+		{"timeout", context.DeadlineExceeded, false},
+		{"cancelled", context.Canceled, false},
+		{"transport error", errors.New("dial tcp 127.0.0.1:8545: connect: connection refused"), false},
+	}
+	for _, test := range tests {
+		if result := isExecutionError(test.err); result != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, result)
+		}
+	}
+}
+
+// A client that answers simulation calls with canned tracer results
+type testSimulationCaller struct {
+	// The callTracer and prestateTracer results; empty if the client doesn't support call tracing
+	frame string
+	diff  string
+
+	// The error eth_call returns, if any
+	callErr error
+}
+
+func (c testSimulationCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	switch method {
+	case "debug_traceCall":
+		response := c.diff
+		if args[2].(map[string]interface{})["tracer"] == "callTracer" {
+			response = c.frame
+		}
+		if response == "" {
+			return testRpcError{-32601, "the method debug_traceCall does not exist/is not available"}
+		}
+		return json.Unmarshal([]byte(response), result)
+	case "eth_call":
+		if c.callErr != nil {
+			return c.callErr
+		}
+		return json.Unmarshal([]byte(`"0x"`), result)
+	}
+	return fmt.Errorf("unexpected method %s", method)
+}
+
+func TestSimulateCall(t *testing.T) {
+	nodeAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	stakingAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	rplAddress := common.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	erc20Abi, err := abi.JSON(strings.NewReader(`[{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}]`))
+	if err != nil {
+		t.Fatalf("error parsing ABI: %s", err.Error())
+	}
+	contracts := map[common.Address]simulationContract{
+		rplAddress: {name: "rocketTokenRPL", abi: &erc20Abi},
+	}
+	addresses := []SimulationAddress{{Address: nodeAddress, Label: "Node"}}
+	call := ethereum.CallMsg{
+		From: nodeAddress,
+		To:   &stakingAddress,
+		Data: []byte{0x01},
+	}
+
+	// A stake of 100 RPL that also costs the node 1 ETH
+	stakeAmount := eth.EthToWei(100)
+	transferLog := fmt.Sprintf(`{"address":"%s","topics":["%s","%s","%s"],"data":"%s","position":"0x0"}`,
+		rplAddress.Hex(), transferEventTopic.Hex(), common.BytesToHash(nodeAddress.Bytes()).Hex(), common.BytesToHash(stakingAddress.Bytes()).Hex(), hexutil.Encode(common.BigToHash(stakeAmount).Bytes()))
+	successFrame := fmt.Sprintf(`{"calls":[{"logs":[%s]}]}`, transferLog)
+	successDiff := fmt.Sprintf(`{"pre":{"%s":{"balance":"%s"}},"post":{"%s":{"balance":"%s"}}}`,
+		nodeAddress.Hex(), hexutil.EncodeBig(eth.EthToWei(10)), nodeAddress.Hex(), hexutil.EncodeBig(eth.EthToWei(9)))
+
+	t.Run("success", func(t *testing.T) {
+		simulation := &api.TransactionSimulation{}
+		err := simulateCall(testSimulationCaller{frame: successFrame, diff: successDiff}, contracts, call, nil, addresses, simulation)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if !simulation.Traced || simulation.Reverted {
+			t.Fatalf("expected a traced, successful simulation, got %+v", simulation)
+		}
+		if len(simulation.Events) != 1 || simulation.Events[0].Contract != "rocketTokenRPL" || simulation.Events[0].Name != "Transfer" {
+			t.Fatalf("expected one RPL transfer event, got %+v", simulation.Events)
+		}
+		expectedArgs := []api.SimulatedEventArg{
+			{Name: "from", Value: nodeAddress.Hex()},
+			{Name: "to", Value: stakingAddress.Hex()},
+			{Name: "value", Value: stakeAmount.String()},
+		}
+		if len(simulation.Events[0].Args) != len(expectedArgs) {
+			t.Fatalf("expected args %v, got %v", expectedArgs, simulation.Events[0].Args)
+		}
+		for i, arg := range expectedArgs {
+			if simulation.Events[0].Args[i] != arg {
+				t.Errorf("expected arg %v, got %v", arg, simulation.Events[0].Args[i])
+			}
+		}
+
+		expectedChanges := []api.SimulatedBalanceChange{
+			{Address: nodeAddress, Label: "Node", Token: ethTokenSymbol, Change: eth.EthToWei(-1)},
+			{Address: nodeAddress, Label: "Node", Token: "RPL", Change: new(big.Int).Neg(stakeAmount)},
+		}
+		if len(simulation.BalanceChanges) != len(expectedChanges) {
+			t.Fatalf("expected balance changes %v, got %v", expectedChanges, simulation.BalanceChanges)
+		}
+		for i, change := range expectedChanges {
+			actual := simulation.BalanceChanges[i]
+			if actual.Address != change.Address || actual.Label != change.Label || actual.Token != change.Token || actual.Change.Cmp(change.Change) != 0 {
+				t.Errorf("expected balance change %+v, got %+v", change, actual)
+			}
+		}
+	})
+
+	t.Run("success without tracing", func(t *testing.T) {
+		simulation := &api.TransactionSimulation{}
+		err := simulateCall(testSimulationCaller{}, contracts, call, nil, addresses, simulation)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if simulation.Traced || simulation.Reverted {
+			t.Errorf("expected an untraced, successful simulation, got %+v", simulation)
+		}
+	})
+
+	t.Run("reverted", func(t *testing.T) {
+		simulation := &api.TransactionSimulation{}
+		frame := `{"error":"execution reverted","revertReason":"Minipool is not staking","calls":[{"logs":[]}]}`
+		err := simulateCall(testSimulationCaller{frame: frame, diff: successDiff}, contracts, call, nil, addresses, simulation)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if !simulation.Reverted || simulation.RevertReason != "Minipool is not staking" {
+			t.Errorf("expected a revert with its reason, got %+v", simulation)
+		}
+		if len(simulation.Events) != 0 || len(simulation.BalanceChanges) != 0 {
+			t.Errorf("expected no events or balance changes for a revert, got %+v", simulation)
+		}
+	})
+
+	t.Run("reverted without tracing", func(t *testing.T) {
+		simulation := &api.TransactionSimulation{}
+		err := simulateCall(testSimulationCaller{callErr: testRpcError{3, "execution reverted: Minipool is not staking"}}, contracts, call, nil, addresses, simulation)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if simulation.Traced || !simulation.Reverted || simulation.RevertReason != "execution reverted: Minipool is not staking" {
+			t.Errorf("expected an untraced revert with its reason, got %+v", simulation)
+		}
+	})
+
+	t.Run("client unavailable", func(t *testing.T) {
+		simulation := &api.TransactionSimulation{}
+		err := simulateCall(testSimulationCaller{callErr: errors.New("dial tcp 127.0.0.1:8545: connect: connection refused")}, contracts, call, nil, addresses, simulation)
+		if err == nil {
+			t.Fatal("expected an error when the client can't simulate the call")
+		}
+		if simulation.Reverted {
+			t.Errorf("a client failure shouldn't be reported as a revert: %+v", simulation)
+		}
+	})
+}
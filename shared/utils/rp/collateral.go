@@ -0,0 +1,67 @@
+package rp
+
+import (
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+// A projection of a node's RPL collateral against changes in the RPL price
+type CollateralProjection struct {
+	// The current collateral ratios, as fractions of the node's borrowed and bonded ETH
+	BorrowedCollateralRatio float64
+	BondedCollateralRatio   float64
+
+	// The RPL price (in ETH wei) below which the node's stake falls under the minimum rewardable collateral.
+	// Nil if the node has borrowed ETH but no RPL staked, since no price would be high enough.
+	MinimumRplPrice *big.Int
+
+	// The RPL price (in ETH wei) above which the node's stake exceeds the maximum rewardable collateral.
+	// Nil if the node has no RPL staked.
+	MaximumRplPrice *big.Int
+
+	// The amount of RPL the node would have to stake to bring its borrowed collateral ratio up to the target
+	RplNeededForTarget *big.Int
+}
+
+// Project a node's collateral thresholds from its stake and matched ETH.
+// The fractions are 1e18-scaled as they are on chain, and the target ratio is a percentage of the borrowed ETH.
+func ProjectCollateral(rplStake *big.Int, borrowedEth *big.Int, bondedEth *big.Int, rplPrice *big.Int, minCollateralFraction *big.Int, maxCollateralFraction *big.Int, targetRatioPercent float64) CollateralProjection {
+
+	projection := CollateralProjection{
+		RplNeededForTarget: big.NewInt(0),
+	}
+
+	// Get the current ratios
+	stakeValue := eth.WeiToEth(rplStake) * eth.WeiToEth(rplPrice)
+	if borrowedEth.Sign() > 0 {
+		projection.BorrowedCollateralRatio = stakeValue / eth.WeiToEth(borrowedEth)
+	}
+	if bondedEth.Sign() > 0 {
+		projection.BondedCollateralRatio = stakeValue / eth.WeiToEth(bondedEth)
+	}
+
+	// Get the threshold prices; stake * price = ETH * fraction at each boundary
+	if rplStake.Sign() > 0 {
+		projection.MinimumRplPrice = big.NewInt(0).Mul(borrowedEth, minCollateralFraction)
+		projection.MinimumRplPrice.Div(projection.MinimumRplPrice, rplStake)
+		projection.MaximumRplPrice = big.NewInt(0).Mul(bondedEth, maxCollateralFraction)
+		projection.MaximumRplPrice.Div(projection.MaximumRplPrice, rplStake)
+	} else if borrowedEth.Sign() == 0 {
+		projection.MinimumRplPrice = big.NewInt(0)
+	}
+
+	// Get the RPL needed for the target ratio, working in basis points to keep fractional percentages
+	if rplPrice.Sign() > 0 && targetRatioPercent > 0 {
+		targetStake := big.NewInt(0).Mul(borrowedEth, big.NewInt(int64(targetRatioPercent*100)))
+		targetStake.Mul(targetStake, eth.EthToWei(1))
+		targetStake.Div(targetStake, big.NewInt(10000))
+		targetStake.Div(targetStake, rplPrice)
+		if targetStake.Cmp(rplStake) > 0 {
+			projection.RplNeededForTarget.Sub(targetStake, rplStake)
+		}
+	}
+
+	return projection
+
+}
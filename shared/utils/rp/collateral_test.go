@@ -0,0 +1,41 @@
+package rp
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+func TestProjectCollateral(t *testing.T) {
+	// Three 8-ETH minipools with 1000 RPL staked at 0.01 ETH per RPL
+	rplStake := eth.EthToWei(1000)
+	borrowedEth := eth.EthToWei(72)
+	bondedEth := eth.EthToWei(24)
+	rplPrice := eth.EthToWei(0.01)
+	minFraction := eth.EthToWei(0.1)
+	maxFraction := eth.EthToWei(1.5)
+
+	projection := ProjectCollateral(rplStake, borrowedEth, bondedEth, rplPrice, minFraction, maxFraction, 15)
+	if projection.MinimumRplPrice.Cmp(eth.EthToWei(0.0072)) != 0 {
+		t.Errorf("expected a minimum price of 0.0072 ETH, got %s", projection.MinimumRplPrice.String())
+	}
+	if projection.MaximumRplPrice.Cmp(eth.EthToWei(0.036)) != 0 {
+		t.Errorf("expected a maximum price of 0.036 ETH, got %s", projection.MaximumRplPrice.String())
+	}
+	if projection.RplNeededForTarget.Cmp(eth.EthToWei(80)) != 0 {
+		t.Errorf("expected 80 RPL to be needed for the target, got %s", projection.RplNeededForTarget.String())
+	}
+
+	// Nothing is needed once the target is met
+	projection = ProjectCollateral(rplStake, borrowedEth, bondedEth, rplPrice, minFraction, maxFraction, 10)
+	if projection.RplNeededForTarget.Sign() != 0 {
+		t.Errorf("expected no RPL to be needed for the target, got %s", projection.RplNeededForTarget.String())
+	}
+
+	// Without any stake there's no price that satisfies the minimum
+	projection = ProjectCollateral(big.NewInt(0), borrowedEth, bondedEth, rplPrice, minFraction, maxFraction, 10)
+	if projection.MinimumRplPrice != nil || projection.MaximumRplPrice != nil {
+		t.Error("expected no threshold prices for a node without any stake")
+	}
+}
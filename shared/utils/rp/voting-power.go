@@ -0,0 +1,27 @@
+package rp
+
+import (
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+// Calculate a node's voting power the same way RocketNetworkVoting does: the square root of its RPL stake,
+// capped at the maximum fraction of its matched ETH. The stake, price and fraction are all 1e18-scaled.
+func CalculateVotingPower(rplStake *big.Int, ethMatched *big.Int, rplPrice *big.Int, maxStakeFraction *big.Int) *big.Int {
+
+	// Cap the stake at the maximum
+	stake := big.NewInt(0).Set(rplStake)
+	if rplPrice.Sign() > 0 {
+		maxStake := big.NewInt(0).Mul(ethMatched, maxStakeFraction)
+		maxStake.Div(maxStake, rplPrice)
+		if stake.Cmp(maxStake) > 0 {
+			stake = maxStake
+		}
+	}
+
+	// Voting power is sqrt(stake * 1e18), which keeps it 1e18-scaled
+	stake.Mul(stake, eth.EthToWei(1))
+	return stake.Sqrt(stake)
+
+}
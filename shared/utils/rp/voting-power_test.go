@@ -0,0 +1,31 @@
+package rp
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+func TestCalculateVotingPower(t *testing.T) {
+	rplPrice := eth.EthToWei(0.01)
+	maxFraction := eth.EthToWei(1.5)
+
+	// 900 RPL against three 8-ETH minipools is under the 10800 RPL cap
+	votingPower := CalculateVotingPower(eth.EthToWei(900), eth.EthToWei(72), rplPrice, maxFraction)
+	if votingPower.Cmp(eth.EthToWei(30)) != 0 {
+		t.Errorf("expected a voting power of 30, got %s", votingPower.String())
+	}
+
+	// 5000 RPL against one 8-ETH minipool is capped at 3600 RPL
+	votingPower = CalculateVotingPower(eth.EthToWei(5000), eth.EthToWei(24), rplPrice, maxFraction)
+	if votingPower.Cmp(eth.EthToWei(60)) != 0 {
+		t.Errorf("expected a voting power of 60, got %s", votingPower.String())
+	}
+
+	// A node without any matched ETH has no voting power
+	votingPower = CalculateVotingPower(eth.EthToWei(5000), big.NewInt(0), rplPrice, maxFraction)
+	if votingPower.Sign() != 0 {
+		t.Errorf("expected no voting power, got %s", votingPower.String())
+	}
+}
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/shared/types/eth2"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 
@@ -11,9 +12,25 @@ import (
 // Get deposit data & root for a given validator key and withdrawal credentials
 func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (eth2.DepositData, common.Hash, error) {
 
+	// Get the unsigned deposit data and its signing root
+	pubkey := types.BytesToValidatorPubkey(validatorKey.PublicKey().Marshal())
+	dd, srHash, err := GetDepositDataSigningRoot(pubkey, withdrawalCredentials, eth2Config, depositAmount)
+	if err != nil {
+		return eth2.DepositData{}, common.Hash{}, err
+	}
+
+	// Sign the deposit data
+	signature := types.BytesToValidatorSignature(validatorKey.Sign(srHash[:]).Marshal())
+	return GetSignedDepositData(dd, signature)
+
+}
+
+// Get the unsigned deposit data for a validator and the root that must be signed for it
+func GetDepositDataSigningRoot(pubkey types.ValidatorPubkey, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config, depositAmount uint64) (eth2.DepositDataNoSignature, [32]byte, error) {
+
 	// Build deposit data
 	dd := eth2.DepositDataNoSignature{
-		PublicKey:             validatorKey.PublicKey().Marshal(),
+		PublicKey:             pubkey.Bytes(),
 		WithdrawalCredentials: withdrawalCredentials[:],
 		Amount:                depositAmount,
 	}
@@ -21,7 +38,7 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 	// Get signing root
 	or, err := dd.HashTreeRoot()
 	if err != nil {
-		return eth2.DepositData{}, common.Hash{}, err
+		return eth2.DepositDataNoSignature{}, [32]byte{}, err
 	}
 
 	sr := eth2.SigningRoot{
@@ -32,15 +49,23 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 	// Get signing root with domain
 	srHash, err := sr.HashTreeRoot()
 	if err != nil {
-		return eth2.DepositData{}, common.Hash{}, err
+		return eth2.DepositDataNoSignature{}, [32]byte{}, err
 	}
 
+	// Return
+	return dd, srHash, nil
+
+}
+
+// Attach a signature to unsigned deposit data and get the deposit data root
+func GetSignedDepositData(dd eth2.DepositDataNoSignature, signature types.ValidatorSignature) (eth2.DepositData, common.Hash, error) {
+
 	// Build deposit data struct (with signature)
 	var depositData = eth2.DepositData{
 		PublicKey:             dd.PublicKey,
 		WithdrawalCredentials: dd.WithdrawalCredentials,
 		Amount:                dd.Amount,
-		Signature:             validatorKey.Sign(srHash[:]).Marshal(),
+		Signature:             signature.Bytes(),
 	}
 
 	// Get deposit data root
@@ -12,10 +12,27 @@ import (
 // Get a voluntary exit message signature for a given validator key and index
 func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex string, epoch uint64, signatureDomain []byte) (types.ValidatorSignature, error) {
 
+	// Get the signing root
+	_, srHash, err := GetExitMessageSigningRoot(validatorIndex, epoch, signatureDomain)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	// Sign message
+	signature := validatorKey.Sign(srHash[:]).Marshal()
+
+	// Return
+	return types.BytesToValidatorSignature(signature), nil
+
+}
+
+// Get a voluntary exit message and the root that must be signed for it
+func GetExitMessageSigningRoot(validatorIndex string, epoch uint64, signatureDomain []byte) (eth2.VoluntaryExit, [32]byte, error) {
+
 	// Parse the validator index
 	indexNum, err := strconv.ParseUint(validatorIndex, 10, 64)
 	if err != nil {
-		return types.ValidatorSignature{}, fmt.Errorf("error parsing validator index (%s): %w", validatorIndex, err)
+		return eth2.VoluntaryExit{}, [32]byte{}, fmt.Errorf("error parsing validator index (%s): %w", validatorIndex, err)
 	}
 
 	// Build voluntary exit message
@@ -27,7 +44,7 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 	// Get object root
 	or, err := exitMessage.HashTreeRoot()
 	if err != nil {
-		return types.ValidatorSignature{}, err
+		return eth2.VoluntaryExit{}, [32]byte{}, err
 	}
 
 	// Get signing root
@@ -38,13 +55,10 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 
 	srHash, err := sr.HashTreeRoot()
 	if err != nil {
-		return types.ValidatorSignature{}, err
+		return eth2.VoluntaryExit{}, [32]byte{}, err
 	}
 
-	// Sign message
-	signature := validatorKey.Sign(srHash[:]).Marshal()
-
 	// Return
-	return types.BytesToValidatorSignature(signature), nil
+	return exitMessage, srHash, nil
 
 }